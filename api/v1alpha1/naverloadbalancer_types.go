@@ -0,0 +1,347 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// NaverLoadBalancer 조건 타입
+const (
+	// ConditionReady는 로드밸런서, 타겟 그룹, 리스너가 모두 준비되었는지를 나타냅니다
+	ConditionReady = "Ready"
+)
+
+// NaverLoadBalancer 조건 사유
+const (
+	ReasonProvisioning       = "Provisioning"
+	ReasonAvailable          = "Available"
+	ReasonReconcileFailed    = "ReconcileFailed"
+	ReasonMigratedAnnotation = "MigratedFromAnnotations"
+)
+
+// NaverLoadBalancer 프로비저닝 단계
+const (
+	// PhaseTargetGroupsCreated는 Service 포트별 타겟 그룹을 만든 단계입니다
+	PhaseTargetGroupsCreated = "TargetGroupsCreated"
+	// PhaseLBCreating은 로드밸런서 생성을 요청하고 준비되기를 기다리는 단계입니다
+	PhaseLBCreating = "LBCreating"
+	// PhaseListenersCreated는 모든 Service 포트의 리스너를 만든 단계입니다
+	PhaseListenersCreated = "ListenersCreated"
+	// PhaseTargetsRegistered는 타겟 그룹에 노드를 등록한 단계입니다
+	PhaseTargetsRegistered = "TargetsRegistered"
+	// PhaseReady는 로드밸런서를 사용할 수 있는 단계입니다
+	PhaseReady = "Ready"
+	// PhaseDeleting은 Service 삭제로 리소스를 정리하는 단계입니다
+	PhaseDeleting = "Deleting"
+)
+
+// NaverLoadBalancerPort는 로드밸런서로 노출되는 Service 포트입니다
+type NaverLoadBalancerPort struct {
+	// Name은 Service 포트 이름입니다
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Protocol은 Service 포트 프로토콜입니다
+	// +kubebuilder:default=TCP
+	Protocol corev1.Protocol `json:"protocol,omitempty"`
+
+	// Port는 로드밸런서 리스너 포트입니다
+	Port int32 `json:"port"`
+
+	// NodePort는 타겟 그룹이 전달하는 노드 포트입니다
+	// +optional
+	NodePort int32 `json:"nodePort,omitempty"`
+}
+
+// NaverLoadBalancerSpec은 Service에서 도출한 로드밸런서의 원하는 상태입니다
+type NaverLoadBalancerSpec struct {
+	// ServiceName은 같은 네임스페이스의 LoadBalancer 타입 Service 이름입니다
+	ServiceName string `json:"serviceName"`
+
+	// ServiceUID는 이 리소스를 소유한 Service의 UID입니다
+	// +optional
+	ServiceUID types.UID `json:"serviceUID,omitempty"`
+
+	// Ports는 로드밸런서로 노출되는 Service 포트 목록입니다
+	// +optional
+	Ports []NaverLoadBalancerPort `json:"ports,omitempty"`
+}
+
+// TargetGroupStatus는 Service 포트별로 생성된 타겟 그룹입니다
+type TargetGroupStatus struct {
+	// Port는 타겟 그룹이 연결된 Service 포트입니다
+	Port int32 `json:"port"`
+
+	// Protocol은 Service 포트 프로토콜입니다
+	// +optional
+	Protocol corev1.Protocol `json:"protocol,omitempty"`
+
+	// NodePort는 타겟 그룹이 트래픽을 전달하는 노드 포트입니다
+	// +optional
+	NodePort int32 `json:"nodePort,omitempty"`
+
+	// HealthCheckPort는 타겟 그룹의 헬스 체크 포트입니다 (externalTrafficPolicy: Local이면 HealthCheckNodePort)
+	// +optional
+	HealthCheckPort int32 `json:"healthCheckPort,omitempty"`
+
+	// TargetGroupNo는 네이버 클라우드 타겟 그룹 번호입니다
+	TargetGroupNo string `json:"targetGroupNo"`
+
+	// TargetGroupName은 네이버 클라우드 타겟 그룹 이름입니다
+	// +optional
+	TargetGroupName string `json:"targetGroupName,omitempty"`
+}
+
+// ListenerStatus는 로드밸런서에 생성된 리스너입니다
+type ListenerStatus struct {
+	// Port는 리스너 포트입니다
+	Port int32 `json:"port"`
+
+	// Protocol은 리스너 프로토콜입니다
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+
+	// ListenerNo는 네이버 클라우드 리스너 번호입니다
+	// +optional
+	ListenerNo string `json:"listenerNo,omitempty"`
+
+	// TargetGroupNo는 리스너가 전달하는 타겟 그룹 번호입니다
+	// +optional
+	TargetGroupNo string `json:"targetGroupNo,omitempty"`
+}
+
+// CertificateStatus는 TLS Secret에서 Certificate Manager로 가져온 인증서입니다
+type CertificateStatus struct {
+	// SecretName은 인증서를 가져온 TLS Secret 이름입니다
+	SecretName string `json:"secretName"`
+
+	// Fingerprint는 가져온 인증서와 개인 키의 SHA-256 해시입니다
+	// +optional
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// CertificateNo는 Certificate Manager 인증서 번호입니다
+	CertificateNo string `json:"certificateNo"`
+
+	// CertificateName은 Certificate Manager 인증서 이름입니다
+	// +optional
+	CertificateName string `json:"certificateName,omitempty"`
+}
+
+// NetworkACLRuleStatus는 loadBalancerSourceRanges를 적용하기 위해 로드밸런서 서브넷의 Network ACL에 추가한 인바운드 규칙입니다
+type NetworkACLRuleStatus struct {
+	// NetworkACLNo는 규칙을 추가한 Network ACL 번호입니다
+	NetworkACLNo string `json:"networkAclNo"`
+
+	// Priority는 규칙 우선순위입니다 (작을수록 먼저 적용)
+	Priority int32 `json:"priority"`
+
+	// Protocol은 규칙 프로토콜입니다 (TCP, UDP)
+	Protocol string `json:"protocol"`
+
+	// IPBlock은 규칙을 적용할 소스 CIDR입니다
+	IPBlock string `json:"ipBlock"`
+
+	// PortRange는 규칙을 적용할 리스너 포트입니다
+	PortRange string `json:"portRange"`
+
+	// Action은 규칙 동작입니다 (ALLOW, DROP)
+	Action string `json:"action"`
+}
+
+// AdoptionStatus는 Service가 채택한 기존 네이버 클라우드 리소스입니다 (Service 삭제 시 기본적으로 남겨 둠)
+type AdoptionStatus struct {
+	// LoadBalancerNo는 채택한 로드밸런서 번호입니다
+	LoadBalancerNo string `json:"loadBalancerNo"`
+
+	// ListenerNos는 채택 시점에 이미 있던 리스너 번호입니다
+	// +optional
+	ListenerNos []string `json:"listenerNos,omitempty"`
+
+	// TargetGroupNos는 채택한 타겟 그룹 번호입니다
+	// +optional
+	TargetGroupNos []string `json:"targetGroupNos,omitempty"`
+}
+
+// ReservedPublicIPStatus는 로드밸런서에 연결한 예약 공인 IP입니다
+type ReservedPublicIPStatus struct {
+	// PublicIPInstanceNo는 공인 IP 인스턴스 번호입니다
+	PublicIPInstanceNo string `json:"publicIpInstanceNo"`
+
+	// PublicIP는 공인 IP 주소입니다
+	PublicIP string `json:"publicIp"`
+}
+
+// NodeAccessRuleStatus는 로드밸런서 트래픽과 헬스 체크가 NodePort에 도달하도록 워커 노드 ACG에 추가한 인바운드 규칙입니다
+type NodeAccessRuleStatus struct {
+	// AccessControlGroupNo는 규칙을 추가한 ACG 번호입니다
+	AccessControlGroupNo string `json:"accessControlGroupNo"`
+
+	// Protocol은 규칙 프로토콜입니다 (TCP, UDP)
+	Protocol string `json:"protocol"`
+
+	// IPBlock은 규칙을 적용할 소스 CIDR입니다
+	IPBlock string `json:"ipBlock"`
+
+	// PortRange는 규칙을 적용할 노드 포트입니다
+	PortRange string `json:"portRange"`
+}
+
+// NaverLoadBalancerStatus는 네이버 클라우드에 실제로 생성된 리소스 상태입니다
+type NaverLoadBalancerStatus struct {
+	// ObservedGeneration은 마지막으로 조정이 완료된 spec의 generation입니다
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Phase는 프로비저닝 단계입니다. 조정은 단계마다 클라우드 상태를 기다리지 않고 다시 시도합니다
+	// +optional
+	// +kubebuilder:validation:Enum=TargetGroupsCreated;LBCreating;ListenersCreated;TargetsRegistered;Ready;Deleting
+	Phase string `json:"phase,omitempty"`
+
+	// PhaseTransitionTime은 현재 단계로 바뀐 시각입니다
+	// +optional
+	PhaseTransitionTime *metav1.Time `json:"phaseTransitionTime,omitempty"`
+
+	// LoadBalancerNo는 네이버 클라우드 로드밸런서 인스턴스 번호입니다
+	// +optional
+	LoadBalancerNo string `json:"loadBalancerNo,omitempty"`
+
+	// LoadBalancerName은 네이버 클라우드 로드밸런서 이름입니다
+	// +optional
+	LoadBalancerName string `json:"loadBalancerName,omitempty"`
+
+	// LoadBalancerType은 로드밸런서 타입 코드입니다 (NETWORK, NETWORK_PROXY, APPLICATION)
+	// +optional
+	LoadBalancerType string `json:"loadBalancerType,omitempty"`
+
+	// NetworkType은 로드밸런서 네트워크 타입 코드입니다 (PUBLIC, PRIVATE)
+	// +optional
+	NetworkType string `json:"networkType,omitempty"`
+
+	// SubnetNos는 로드밸런서가 배치된 서브넷 번호 목록입니다
+	// +optional
+	SubnetNos []string `json:"subnetNos,omitempty"`
+
+	// Domain은 로드밸런서 도메인입니다
+	// +optional
+	Domain string `json:"domain,omitempty"`
+
+	// IPs는 로드밸런서에 할당된 IP 목록입니다
+	// +optional
+	IPs []string `json:"ips,omitempty"`
+
+	// TargetGroups는 Service 포트별 타겟 그룹 목록입니다
+	// +optional
+	TargetGroups []TargetGroupStatus `json:"targetGroups,omitempty"`
+
+	// Listeners는 로드밸런서 리스너 목록입니다
+	// +optional
+	Listeners []ListenerStatus `json:"listeners,omitempty"`
+
+	// Targets는 타겟 그룹에 등록한 워커 노드 인스턴스 번호입니다 (구성 드리프트 감지에 사용)
+	// +optional
+	Targets []string `json:"targets,omitempty"`
+
+	// Certificate는 TLS 리스너를 위해 TLS Secret에서 가져온 인증서입니다 (로드밸런서 삭제 시 함께 삭제)
+	// +optional
+	Certificate *CertificateStatus `json:"certificate,omitempty"`
+
+	// Adoption은 생성하지 않고 채택한 기존 로드밸런서와 타겟 그룹입니다
+	// +optional
+	Adoption *AdoptionStatus `json:"adoption,omitempty"`
+
+	// ReservedPublicIP는 로드밸런서에 연결한 예약 공인 IP입니다 (컨트롤러가 할당하지 않았으므로 삭제 시 반환하지 않음)
+	// +optional
+	ReservedPublicIP *ReservedPublicIPStatus `json:"reservedPublicIP,omitempty"`
+
+	// SourceRangeRules는 loadBalancerSourceRanges를 위해 추가한 Network ACL 규칙입니다 (로드밸런서 삭제 시 함께 삭제)
+	// +optional
+	SourceRangeRules []NetworkACLRuleStatus `json:"sourceRangeRules,omitempty"`
+
+	// NodeAccessRules는 워커 노드 ACG에 추가한 NodePort 인바운드 규칙입니다 (다른 로드밸런서가 쓰지 않으면 삭제 시 함께 삭제)
+	// +optional
+	NodeAccessRules []NodeAccessRuleStatus `json:"nodeAccessRules,omitempty"`
+
+	// Conditions는 NaverLoadBalancer의 현재 상태 조건입니다
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=nlb
+// +kubebuilder:printcolumn:name="Service",type=string,JSONPath=`.spec.serviceName`
+// +kubebuilder:printcolumn:name="LB-No",type=string,JSONPath=`.status.loadBalancerNo`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Domain",type=string,JSONPath=`.status.domain`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// NaverLoadBalancer는 LoadBalancer 타입 Service 하나에 대응하는 네이버 클라우드 리소스를 추적합니다
+type NaverLoadBalancer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NaverLoadBalancerSpec   `json:"spec,omitempty"`
+	Status NaverLoadBalancerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NaverLoadBalancerList contains a list of NaverLoadBalancer
+type NaverLoadBalancerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NaverLoadBalancer `json:"items"`
+}
+
+// FindTargetGroup은 포트와 프로토콜에 해당하는 타겟 그룹 상태를 반환합니다
+func (s *NaverLoadBalancerStatus) FindTargetGroup(port int32, protocol corev1.Protocol) *TargetGroupStatus {
+	if protocol == "" {
+		protocol = corev1.ProtocolTCP
+	}
+	for i := range s.TargetGroups {
+		tg := &s.TargetGroups[i]
+		tgProtocol := tg.Protocol
+		if tgProtocol == "" {
+			tgProtocol = corev1.ProtocolTCP
+		}
+		if tg.Port == port && tgProtocol == protocol {
+			return tg
+		}
+	}
+	return nil
+}
+
+// TargetGroupNos는 상태에 기록된 모든 타겟 그룹 번호를 반환합니다
+func (s *NaverLoadBalancerStatus) TargetGroupNos() []string {
+	nos := make([]string, 0, len(s.TargetGroups))
+	for _, tg := range s.TargetGroups {
+		if tg.TargetGroupNo != "" {
+			nos = append(nos, tg.TargetGroupNo)
+		}
+	}
+	return nos
+}
+
+func init() {
+	SchemeBuilder.Register(&NaverLoadBalancer{}, &NaverLoadBalancerList{})
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// fakencp는 로컬 개발을 위해 네이버 클라우드 API를 흉내 내는 서버를 실행합니다.
+//
+//	go run ./cmd/fakencp --access-key test --secret-key test --server 1001=10.0.1.10 --subnet 6000=10.0.100.0/24=7000
+//
+// 컨트롤러는 --naver-cloud-api-endpoint=http://127.0.0.1:9480 으로 이 서버를 사용합니다.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud/fake"
+)
+
+func main() {
+	var bindAddr, accessKey, secretKey, vpcNo string
+	var provisioningSteps int
+	var servers, subnets, acgs []string
+
+	flag.StringVar(&bindAddr, "bind-address", "127.0.0.1:9480", "The address the fake Naver Cloud API binds to.")
+	flag.StringVar(&accessKey, "access-key", os.Getenv("NAVER_CLOUD_API_KEY"), "The access key accepted by the fake API.")
+	flag.StringVar(&secretKey, "secret-key", os.Getenv("NAVER_CLOUD_API_SECRET"), "The secret key used to verify request signatures.")
+	flag.StringVar(&vpcNo, "vpc-no", os.Getenv("NAVER_CLOUD_VPC_NO"), "The VPC number assigned to seeded server instances.")
+	flag.IntVar(&provisioningSteps, "provisioning-steps", fake.DefaultProvisioningSteps,
+		"The number of API requests a load balancer create, change or delete takes to complete.")
+	flag.Func("server", "A server instance to seed, as instanceNo=ip or instanceNo=ip=name. May be repeated.", func(v string) error {
+		servers = append(servers, v)
+		return nil
+	})
+	flag.Func("subnet", "A load balancer subnet to seed, as subnetNo=cidr=networkAclNo or subnetNo=cidr=networkAclNo=zone. May be repeated.", func(v string) error {
+		subnets = append(subnets, v)
+		return nil
+	})
+	flag.Func("access-control-group", "An access control group number to seed. May be repeated.", func(v string) error {
+		acgs = append(acgs, v)
+		return nil
+	})
+	flag.Parse()
+
+	if accessKey == "" || secretKey == "" {
+		log.Fatal("--access-key and --secret-key are required")
+	}
+
+	server := fake.NewServer(accessKey, secretKey)
+	server.ProvisioningSteps = provisioningSteps
+
+	for _, spec := range servers {
+		parts := strings.Split(spec, "=")
+		if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+			log.Fatalf("invalid --server value %q, expected instanceNo=ip[=name]", spec)
+		}
+		name := fmt.Sprintf("server-%s", parts[0])
+		if len(parts) > 2 && parts[2] != "" {
+			name = parts[2]
+		}
+		server.AddServerInstance(parts[0], name, parts[1], vpcNo)
+	}
+
+	for _, spec := range subnets {
+		parts := strings.Split(spec, "=")
+		if len(parts) < 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+			log.Fatalf("invalid --subnet value %q, expected subnetNo=cidr=networkAclNo[=zone]", spec)
+		}
+		zone := "KR-1"
+		if len(parts) > 3 && parts[3] != "" {
+			zone = parts[3]
+		}
+		server.AddSubnet(parts[0], vpcNo, zone, parts[1], "LOADB", parts[2])
+	}
+
+	for _, acgNo := range acgs {
+		server.AddAccessControlGroup(acgNo)
+	}
+
+	log.Printf("fake Naver Cloud API listening on %s", bindAddr)
+	if err := http.ListenAndServe(bindAddr, server); err != nil {
+		log.Fatal(err)
+	}
+}
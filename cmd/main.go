@@ -21,6 +21,7 @@ import (
 	"flag"
 	"os"
 	"path/filepath"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -37,7 +38,9 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
+	naverv1alpha1 "github.com/suslmk-lee/kube-controller01/api/v1alpha1"
 	"github.com/suslmk-lee/kube-controller01/internal/controller"
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -49,6 +52,7 @@ var (
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
+	utilruntime.Must(naverv1alpha1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -61,6 +65,13 @@ func main() {
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var endpointProfile, apiEndpoint string
+	var defaultDeletionPolicy string
+	var defaultDriftPolicy string
+	var driftCheckInterval time.Duration
+	var clusterName string
+	var orphanGCInterval, orphanGCGracePeriod time.Duration
+	var orphanGCDryRun bool
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -79,6 +90,30 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.StringVar(&endpointProfile, "naver-cloud-endpoint-profile", os.Getenv("NAVER_CLOUD_ENDPOINT_PROFILE"),
+		"The Naver Cloud API endpoint profile: public, gov, fin or custom. Defaults to gov when unset.")
+	flag.StringVar(&apiEndpoint, "naver-cloud-api-endpoint", os.Getenv("NAVER_CLOUD_API_ENDPOINT"),
+		"A custom Naver Cloud API gateway base URL. Overrides the endpoint profile when set.")
+	flag.StringVar(&defaultDeletionPolicy, "default-deletion-policy", os.Getenv("DEFAULT_DELETION_POLICY"),
+		"What to do with Naver Cloud resources when a Service without the naver.k-paas.org/deletion-policy annotation "+
+			"is deleted: Delete or Retain. Defaults to Delete when unset.")
+	flag.StringVar(&defaultDriftPolicy, "default-drift-policy", os.Getenv("DEFAULT_DRIFT_POLICY"),
+		"What to do when the load balancer of a Service without the naver.k-paas.org/drift-policy annotation "+
+			"no longer matches what the controller configured: Repair or Report. Defaults to Repair when unset.")
+	flag.DurationVar(&driftCheckInterval, "drift-check-interval", 10*time.Minute,
+		"How often to compare each load balancer with its Service to detect changes made outside the controller. "+
+			"Set to 0 to disable.")
+	flag.StringVar(&clusterName, "cluster-name", os.Getenv("CLUSTER_NAME"),
+		"A name that identifies this cluster in the descriptions of the load balancers and target groups it creates. "+
+			"Give every cluster that shares a VPC a different name so that none adopts or deletes another's resources.")
+	flag.DurationVar(&orphanGCInterval, "orphan-gc-interval", 10*time.Minute,
+		"How often to look for load balancers and target groups created by the controller that no Service uses. "+
+			"Set to 0 to disable.")
+	flag.DurationVar(&orphanGCGracePeriod, "orphan-gc-grace-period", 30*time.Minute,
+		"How long an unused load balancer or target group must stay unused before it is deleted.")
+	flag.BoolVar(&orphanGCDryRun, "orphan-gc-dry-run", true,
+		"If set, orphaned load balancers and target groups are only logged, not deleted. "+
+			"Turning it off requires --cluster-name.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -207,6 +242,55 @@ func main() {
 		Region:    os.Getenv("NAVER_CLOUD_REGION"),
 		VpcNo:     os.Getenv("NAVER_CLOUD_VPC_NO"),
 		SubnetNo:  os.Getenv("NAVER_CLOUD_SUBNET_NO"),
+
+		PrivateSubnetNo: os.Getenv("NAVER_CLOUD_PRIVATE_SUBNET_NO"),
+		EndpointProfile: endpointProfile,
+		APIEndpoint:     apiEndpoint,
+
+		NodeAccessControlGroupNo: os.Getenv("NAVER_CLOUD_NODE_ACG_NO"),
+	}
+
+	// 기본 삭제 정책이 명시된 경우 시작 시점에 검증합니다
+	if defaultDeletionPolicy != "" {
+		defaultDeletionPolicy, err = controller.ParseDeletionPolicy(defaultDeletionPolicy)
+		if err != nil {
+			setupLog.Error(err, "invalid default deletion policy")
+			os.Exit(1)
+		}
+		setupLog.Info("Using default deletion policy", "policy", defaultDeletionPolicy)
+	}
+
+	// 기본 드리프트 정책이 명시된 경우 시작 시점에 검증합니다
+	if defaultDriftPolicy != "" {
+		defaultDriftPolicy, err = controller.ParseDriftPolicy(defaultDriftPolicy)
+		if err != nil {
+			setupLog.Error(err, "invalid default drift policy")
+			os.Exit(1)
+		}
+		setupLog.Info("Using default drift policy", "policy", defaultDriftPolicy)
+	}
+
+	if err := controller.ValidateClusterName(clusterName); err != nil {
+		setupLog.Error(err, "invalid cluster name")
+		os.Exit(1)
+	}
+	if clusterName == "" {
+		setupLog.Info("No cluster name set; load balancers and target groups cannot be told apart from those of other clusters in the same VPC")
+		// 이름 없는 클러스터끼리는 서로의 리소스를 자기 것으로 보므로 삭제를 허용하지 않음
+		if orphanGCInterval > 0 && !orphanGCDryRun {
+			setupLog.Error(nil, "--orphan-gc-dry-run=false requires --cluster-name")
+			os.Exit(1)
+		}
+	}
+
+	// 엔드포인트 설정이 명시된 경우 시작 시점에 검증합니다
+	if endpointProfile != "" || apiEndpoint != "" {
+		baseURL, err := navercloud.ResolveEndpoint(endpointProfile, apiEndpoint)
+		if err != nil {
+			setupLog.Error(err, "invalid Naver Cloud API endpoint configuration")
+			os.Exit(1)
+		}
+		setupLog.Info("Using Naver Cloud API endpoint", "profile", endpointProfile, "baseURL", baseURL)
 	}
 
 	// 만약 Region이 설정되지 않았다면 기본값을 사용합니다
@@ -242,16 +326,35 @@ func main() {
 		controllerNamespace = "k-paas-system" // 기본값
 	}
 
-	if err = (&controller.ServiceReconciler{
+	serviceReconciler := &controller.ServiceReconciler{
 		Client:              mgr.GetClient(),
 		Scheme:              mgr.GetScheme(),
 		NaverCloudConfig:    naverCloudConfig,
 		SecretConfig:        secretConfig,
 		ControllerNamespace: controllerNamespace,
-	}).SetupWithManager(mgr); err != nil {
+		Recorder:            mgr.GetEventRecorderFor("naver-lb-controller"),
+
+		DefaultDeletionPolicy: defaultDeletionPolicy,
+		ClusterName:           clusterName,
+		DefaultDriftPolicy:    defaultDriftPolicy,
+		DriftCheckInterval:    driftCheckInterval,
+	}
+	if err = serviceReconciler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Service")
 		os.Exit(1)
 	}
+
+	if orphanGCInterval > 0 {
+		if err := mgr.Add(&controller.OrphanCollector{
+			Reconciler:  serviceReconciler,
+			Interval:    orphanGCInterval,
+			GracePeriod: orphanGCGracePeriod,
+			DryRun:      orphanGCDryRun,
+		}); err != nil {
+			setupLog.Error(err, "unable to add orphan collector to manager")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if metricsCertWatcher != nil {
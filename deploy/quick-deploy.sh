@@ -105,6 +105,9 @@ kubectl create secret generic naver-cloud-credentials \
 log_success "Secret 생성 완료"
 
 # 5. 컨트롤러 배포
+log_info "NaverLoadBalancer CRD 설치 중..."
+kubectl apply -f "$(dirname "$0")/../config/crd/bases/naver.k-paas.org_naverloadbalancers.yaml"
+
 log_info "컨트롤러 배포 중..."
 kubectl apply -f "$(dirname "$0")/kebe-controller-complete.yaml"
 log_success "컨트롤러 배포 완료"
@@ -0,0 +1,333 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	naverv1alpha1 "github.com/suslmk-lee/kube-controller01/api/v1alpha1"
+)
+
+// 기존 리소스 채택 어노테이션
+const (
+	// existingLoadBalancerAnnotation은 새로 만들지 않고 사용할 기존 로드밸런서 인스턴스 번호입니다
+	existingLoadBalancerAnnotation = "naver.k-paas.org/existing-lb-no"
+	// existingTargetGroupsAnnotation은 포트별로 사용할 기존 타겟 그룹입니다 ("<포트 이름 또는 번호>=<타겟 그룹 번호>,...")
+	existingTargetGroupsAnnotation = "naver.k-paas.org/existing-target-groups"
+	// deleteAdoptedResourcesAnnotation이 "true"이면 Service 삭제 시 채택한 리소스도 삭제합니다 (기본값: 남겨 둠)
+	deleteAdoptedResourcesAnnotation = "naver.k-paas.org/delete-adopted-resources"
+)
+
+// adoptionRequest는 어노테이션으로 지정한 채택 대상입니다
+type adoptionRequest struct {
+	LoadBalancerNo string
+	// TargetGroupNos는 Service 포트 순서의 기존 타겟 그룹 번호입니다 (지정하지 않은 포트는 빈 문자열)
+	TargetGroupNos []string
+}
+
+// parseAdoptionRequest는 채택 어노테이션을 읽습니다. 기존 로드밸런서를 지정하지 않으면 nil을 반환합니다
+func parseAdoptionRequest(service *corev1.Service) (*adoptionRequest, error) {
+	lbNo := strings.TrimSpace(service.Annotations[existingLoadBalancerAnnotation])
+	tgValue := strings.TrimSpace(service.Annotations[existingTargetGroupsAnnotation])
+	if lbNo == "" {
+		if tgValue != "" {
+			return nil, fmt.Errorf("%s 어노테이션은 %s 어노테이션과 함께 사용해야 함", existingTargetGroupsAnnotation, existingLoadBalancerAnnotation)
+		}
+		return nil, nil
+	}
+
+	adoption := &adoptionRequest{LoadBalancerNo: lbNo, TargetGroupNos: make([]string, len(service.Spec.Ports))}
+	if tgValue == "" {
+		return adoption, nil
+	}
+
+	seen := make(map[string]bool)
+	for _, entry := range strings.Split(tgValue, ",") {
+		portValue, tgNo, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		portValue, tgNo = strings.TrimSpace(portValue), strings.TrimSpace(tgNo)
+		if !ok || portValue == "" || tgNo == "" {
+			return nil, fmt.Errorf("%s 어노테이션 값이 올바르지 않음: %q (<포트 이름 또는 번호>=<타겟 그룹 번호>)", existingTargetGroupsAnnotation, entry)
+		}
+		if seen[tgNo] {
+			return nil, fmt.Errorf("%s 어노테이션에 같은 타겟 그룹이 여러 번 지정됨: %s", existingTargetGroupsAnnotation, tgNo)
+		}
+		seen[tgNo] = true
+
+		number, numErr := strconv.ParseInt(portValue, 10, 32)
+		matched := -1
+		for i, port := range service.Spec.Ports {
+			if port.Name != portValue && (numErr != nil || int64(port.Port) != number) {
+				continue
+			}
+			if matched >= 0 {
+				return nil, fmt.Errorf("%s 어노테이션의 포트 %s가 여러 Service 포트와 일치함 (포트 이름으로 지정하세요)", existingTargetGroupsAnnotation, portValue)
+			}
+			matched = i
+		}
+		if matched < 0 {
+			return nil, fmt.Errorf("%s 어노테이션의 포트 %s를 Service에서 찾을 수 없음", existingTargetGroupsAnnotation, portValue)
+		}
+		adoption.TargetGroupNos[matched] = tgNo
+	}
+	return adoption, nil
+}
+
+// deleteAdoptedResources는 Service 삭제 시 채택한 리소스도 삭제해야 하는지 반환합니다
+func deleteAdoptedResources(service *corev1.Service) bool {
+	return strings.EqualFold(strings.TrimSpace(service.Annotations[deleteAdoptedResourcesAnnotation]), "true")
+}
+
+// adoptedTargetGroups는 상태에 기록된 채택 타겟 그룹 번호 집합을 반환합니다
+func adoptedTargetGroups(status *naverv1alpha1.NaverLoadBalancerStatus) map[string]bool {
+	adopted := make(map[string]bool)
+	if status.Adoption != nil {
+		for _, tgNo := range status.Adoption.TargetGroupNos {
+			adopted[tgNo] = true
+		}
+	}
+	return adopted
+}
+
+// adoptLoadBalancer는 기존 로드밸런서와 타겟 그룹이 Service와 호환되는지 확인한 뒤 상태에 기록합니다.
+// 채택 시점에 이미 있던 리스너는 기록해 두어 Service 포트가 아니면 건드리지 않습니다
+func (r *ServiceReconciler) adoptLoadBalancer(ctx context.Context, client NaverCloudClient, credentials *NaverCloudCredentials, service *corev1.Service, nlb *naverv1alpha1.NaverLoadBalancer, adoption *adoptionRequest, lbType, networkType string) error {
+	logger := log.FromContext(ctx).WithValues("lb-id", adoption.LoadBalancerNo)
+
+	// 1. 다른 Service가 이미 사용하는 리소스인지 확인
+	var nlbList naverv1alpha1.NaverLoadBalancerList
+	if err := r.List(ctx, &nlbList); err != nil {
+		return fmt.Errorf("NaverLoadBalancer 목록 조회 실패: %w", err)
+	}
+	for _, other := range nlbList.Items {
+		if other.Namespace == nlb.Namespace && other.Name == nlb.Name {
+			continue
+		}
+		if other.Status.LoadBalancerNo == adoption.LoadBalancerNo {
+			return fmt.Errorf("로드밸런서 %s를 Service %s/%s가 사용 중", adoption.LoadBalancerNo, other.Namespace, other.Spec.ServiceName)
+		}
+		for _, tgNo := range adoption.TargetGroupNos {
+			if tgNo != "" && containsString(other.Status.TargetGroupNos(), tgNo) {
+				return fmt.Errorf("타겟 그룹 %s를 Service %s/%s가 사용 중", tgNo, other.Namespace, other.Spec.ServiceName)
+			}
+		}
+	}
+
+	// 2. 소유 클러스터, 로드밸런서 타입, 네트워크 타입, VPC, 서브넷 확인
+	detailResp, err := client.GetLoadBalancerInstanceDetail(&vloadbalancer.GetLoadBalancerInstanceDetailRequest{
+		RegionCode:             ncloud.String(credentials.Region),
+		LoadBalancerInstanceNo: ncloud.String(adoption.LoadBalancerNo),
+	})
+	if err != nil {
+		return fmt.Errorf("채택할 로드밸런서 조회 실패: %w", err)
+	}
+	if detailResp == nil || len(detailResp.LoadBalancerInstanceList) == 0 {
+		return fmt.Errorf("채택할 로드밸런서 %s를 찾을 수 없음", adoption.LoadBalancerNo)
+	}
+	lb := detailResp.LoadBalancerInstanceList[0]
+
+	if err := r.checkAdoptable("로드밸런서", adoption.LoadBalancerNo, ncloud.StringValue(lb.LoadBalancerDescription)); err != nil {
+		return err
+	}
+	if currentType := commonCodeValue(lb.LoadBalancerType); currentType != lbType {
+		return fmt.Errorf("채택할 로드밸런서 타입이 다름: %s (Service: %s)", currentType, lbType)
+	}
+	if currentNetworkType := commonCodeValue(lb.LoadBalancerNetworkType); currentNetworkType != networkType {
+		return fmt.Errorf("채택할 로드밸런서 네트워크 타입이 다름: %s (Service: %s)", currentNetworkType, networkType)
+	}
+	if vpcNo := ncloud.StringValue(lb.VpcNo); credentials.VpcNo != "" && vpcNo != credentials.VpcNo {
+		return fmt.Errorf("채택할 로드밸런서가 다른 VPC에 있음: %s (클러스터: %s)", vpcNo, credentials.VpcNo)
+	}
+	subnetNos := ncloud.StringListValue(lb.SubnetNoList)
+	subnets, err := r.lookupLoadBalancerSubnets(client, credentials, subnetNos)
+	if err != nil {
+		return err
+	}
+	for _, subnet := range subnets {
+		if vpcNo := ncloud.StringValue(subnet.VpcNo); credentials.VpcNo != "" && vpcNo != credentials.VpcNo {
+			return fmt.Errorf("채택할 로드밸런서의 서브넷 %s가 다른 VPC에 있음: %s", ncloud.StringValue(subnet.SubnetNo), vpcNo)
+		}
+	}
+
+	// 3. 타겟 그룹 소유 클러스터, 프로토콜, 포트, VPC, 연결된 로드밸런서 확인
+	var targetGroups []naverv1alpha1.TargetGroupStatus
+	var targetGroupNos []string
+	for i, port := range service.Spec.Ports {
+		tgNo := adoption.TargetGroupNos[i]
+		if tgNo == "" {
+			continue
+		}
+		tgResp, err := client.GetTargetGroupDetail(&vloadbalancer.GetTargetGroupDetailRequest{
+			RegionCode:    ncloud.String(credentials.Region),
+			TargetGroupNo: ncloud.String(tgNo),
+		})
+		if err != nil {
+			return fmt.Errorf("채택할 타겟 그룹 조회 실패: %w", err)
+		}
+		if tgResp == nil || len(tgResp.TargetGroupList) == 0 {
+			return fmt.Errorf("채택할 타겟 그룹 %s를 찾을 수 없음", tgNo)
+		}
+		tg := tgResp.TargetGroupList[0]
+
+		if err := r.checkAdoptable("타겟 그룹", tgNo, ncloud.StringValue(tg.TargetGroupDescription)); err != nil {
+			return err
+		}
+		if protocol, want := commonCodeValue(tg.TargetGroupProtocolType), targetGroupProtocolForPort(lbType, port); protocol != want {
+			return fmt.Errorf("채택할 타겟 그룹 %s의 프로토콜이 다름: %s (포트 %d: %s)", tgNo, protocol, port.Port, want)
+		}
+		if tgPort := ncloud.Int32Value(tg.TargetGroupPort); tgPort != port.NodePort {
+			return fmt.Errorf("채택할 타겟 그룹 %s의 포트가 NodePort와 다름: %d (포트 %d: %d)", tgNo, tgPort, port.Port, port.NodePort)
+		}
+		if vpcNo := ncloud.StringValue(tg.VpcNo); credentials.VpcNo != "" && vpcNo != "" && vpcNo != credentials.VpcNo {
+			return fmt.Errorf("채택할 타겟 그룹 %s가 다른 VPC에 있음: %s", tgNo, vpcNo)
+		}
+		if attached := ncloud.StringValue(tg.LoadBalancerInstanceNo); attached != "" && attached != adoption.LoadBalancerNo {
+			return fmt.Errorf("채택할 타겟 그룹 %s가 다른 로드밸런서 %s에 연결되어 있음", tgNo, attached)
+		}
+
+		targetGroups = append(targetGroups, targetGroupStatusForPort(service, port, tgNo, ncloud.StringValue(tg.TargetGroupName)))
+		targetGroupNos = append(targetGroupNos, tgNo)
+	}
+
+	// 4. 채택 시점의 리스너 기록
+	listenerResp, err := client.GetLoadBalancerListenerList(&vloadbalancer.GetLoadBalancerListenerListRequest{
+		RegionCode:             ncloud.String(credentials.Region),
+		LoadBalancerInstanceNo: ncloud.String(adoption.LoadBalancerNo),
+	})
+	if err != nil {
+		return fmt.Errorf("리스너 목록 조회 실패: %w", err)
+	}
+	var listenerNos []string
+	if listenerResp != nil {
+		for _, listener := range listenerResp.LoadBalancerListenerList {
+			if listenerNo := ncloud.StringValue(listener.LoadBalancerListenerNo); listenerNo != "" {
+				listenerNos = append(listenerNos, listenerNo)
+			}
+		}
+	}
+
+	if err := r.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+		latest.Status.LoadBalancerNo = adoption.LoadBalancerNo
+		latest.Status.LoadBalancerName = ncloud.StringValue(lb.LoadBalancerName)
+		latest.Status.LoadBalancerType = lbType
+		latest.Status.NetworkType = networkType
+		latest.Status.SubnetNos = subnetNos
+		latest.Status.TargetGroups = targetGroups
+		latest.Status.Adoption = &naverv1alpha1.AdoptionStatus{
+			LoadBalancerNo: adoption.LoadBalancerNo,
+			ListenerNos:    listenerNos,
+			TargetGroupNos: targetGroupNos,
+		}
+	}); err != nil {
+		return err
+	}
+
+	logger.Info("기존 로드밸런서 채택", "lb-name", ncloud.StringValue(lb.LoadBalancerName),
+		"target-groups", targetGroupNos, "existing-listeners", len(listenerNos))
+	return nil
+}
+
+// adoptedTargetGroupTargets는 채택한 타겟 그룹에서 클러스터 노드가 아닌 기존 타겟을 유지하도록 원하는 타겟 목록에 더합니다.
+// 클러스터 노드의 인스턴스 번호를 모두 알 수 없으면 어떤 타겟도 제거하지 않도록 complete를 false로 반환합니다
+func (r *ServiceReconciler) adoptedTargetGroupTargets(ctx context.Context, client NaverCloudClient, credentials *NaverCloudCredentials, targetGroupNo string, instanceNos []string, complete bool) ([]string, bool, error) {
+	var nodeList corev1.NodeList
+	if err := r.List(ctx, &nodeList); err != nil {
+		return nil, false, fmt.Errorf("노드 목록 조회 실패: %w", err)
+	}
+	// 등록할 노드가 하나도 없으면 기존 동작과 같이 타겟을 제거하지 않음
+	if len(instanceNos) == 0 {
+		complete = false
+	}
+	clusterInstances := make(map[string]bool, len(nodeList.Items))
+	for i := range nodeList.Items {
+		instanceNo := r.getNaverCloudInstanceNo(&nodeList.Items[i])
+		if instanceNo == "" {
+			complete = false
+			continue
+		}
+		clusterInstances[instanceNo] = true
+	}
+
+	targetResp, err := client.GetTargetList(&vloadbalancer.GetTargetListRequest{
+		RegionCode:    ncloud.String(credentials.Region),
+		TargetGroupNo: ncloud.String(targetGroupNo),
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("타겟 목록 조회 실패: %w", err)
+	}
+
+	targets := append([]string(nil), instanceNos...)
+	if targetResp != nil {
+		for _, target := range targetResp.TargetList {
+			targetNo := ncloud.StringValue(target.TargetNo)
+			if targetNo != "" && !clusterInstances[targetNo] && !containsString(targets, targetNo) {
+				targets = append(targets, targetNo)
+			}
+		}
+	}
+	return targets, complete, nil
+}
+
+// releaseAdoptedLoadBalancer는 채택한 로드밸런서를 남겨 두고 컨트롤러가 추가한 리스너만 삭제합니다
+func (r *ServiceReconciler) releaseAdoptedLoadBalancer(ctx context.Context, client NaverCloudClient, credentials *NaverCloudCredentials, nlb *naverv1alpha1.NaverLoadBalancer) error {
+	logger := log.FromContext(ctx).WithValues("lb-id", nlb.Status.LoadBalancerNo)
+
+	var listenerNos []*string
+	for _, listener := range nlb.Status.Listeners {
+		if listener.ListenerNo != "" && !containsString(nlb.Status.Adoption.ListenerNos, listener.ListenerNo) {
+			listenerNos = append(listenerNos, ncloud.String(listener.ListenerNo))
+		}
+	}
+	if len(listenerNos) > 0 {
+		if err := r.checkLoadBalancerReady(client, credentials, nlb.Status.LoadBalancerNo); err != nil {
+			return err
+		}
+		if _, err := client.DeleteLoadBalancerListeners(&vloadbalancer.DeleteLoadBalancerListenersRequest{
+			RegionCode:                 ncloud.String(credentials.Region),
+			LoadBalancerListenerNoList: listenerNos,
+		}); err != nil {
+			return fmt.Errorf("채택한 로드밸런서의 리스너 삭제 실패: %w", err)
+		}
+		logger.Info("채택한 로드밸런서의 리스너 삭제 성공", "count", len(listenerNos))
+	}
+
+	if err := r.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+		latest.Status.LoadBalancerNo = ""
+		latest.Status.Listeners = nil
+		latest.Status.Domain = ""
+		latest.Status.IPs = nil
+	}); err != nil {
+		logger.Error(err, "NaverLoadBalancer 상태에서 로드밸런서 제거 실패")
+	}
+	logger.Info("채택한 로드밸런서는 삭제하지 않음")
+	return nil
+}
+
+// commonCodeValue는 공통 코드 값을 반환합니다 (없으면 빈 문자열)
+func commonCodeValue(code *vloadbalancer.CommonCode) string {
+	if code == nil {
+		return ""
+	}
+	return ncloud.StringValue(code.Code)
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("Adoption Tests", func() {
+	var (
+		reconciler *ServiceReconciler
+		mockClient *navercloud.MockClient
+		recorder   *record.FakeRecorder
+		ctx        context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockClient = navercloud.NewMockClient()
+		mockClient.AddMockSubnet("subnet-67890", "vpc-12345", "KR-1", "PRIVATE", "LOADB")
+		mockClient.LoadBalancers = append(mockClient.LoadBalancers, vloadbalancer.LoadBalancerInstance{
+			LoadBalancerInstanceNo:     ncloud.String("lb-existing"),
+			LoadBalancerName:           ncloud.String("existing-lb"),
+			LoadBalancerType:           &vloadbalancer.CommonCode{Code: ncloud.String(lbTypeNetworkProxy)},
+			LoadBalancerNetworkType:    &vloadbalancer.CommonCode{Code: ncloud.String(lbNetworkTypePublic)},
+			LoadBalancerInstanceStatus: &vloadbalancer.CommonCode{Code: ncloud.String("USED")},
+			VpcNo:                      ncloud.String("vpc-12345"),
+			SubnetNoList:               []*string{ncloud.String("subnet-67890")},
+		})
+		mockClient.Listeners = append(mockClient.Listeners, vloadbalancer.LoadBalancerListener{
+			LoadBalancerInstanceNo: ncloud.String("lb-existing"),
+			LoadBalancerListenerNo: ncloud.String("listener-existing"),
+			Port:                   ncloud.Int32(8080),
+		})
+		recorder = record.NewFakeRecorder(10)
+
+		reconciler = &ServiceReconciler{
+			Client: k8sClient,
+			NaverCloudConfig: NaverCloudConfig{
+				APIKey:    "test-api-key",
+				APISecret: "test-api-secret",
+				Region:    "KR",
+				VpcNo:     "vpc-12345",
+				SubnetNo:  "subnet-67890",
+			},
+			NaverClient: mockClient,
+			Recorder:    recorder,
+		}
+	})
+
+	Context("When parsing adoption annotations", func() {
+		It("should map target groups to Service ports by name or number", func() {
+			service := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+					existingLoadBalancerAnnotation: "lb-existing",
+					existingTargetGroupsAnnotation: "https=tg-2, 80=tg-1",
+				}},
+				Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{
+					{Name: "http", Port: 80}, {Name: "https", Port: 443}, {Name: "admin", Port: 9000},
+				}},
+			}
+			adoption, err := parseAdoptionRequest(service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adoption.LoadBalancerNo).To(Equal("lb-existing"))
+			Expect(adoption.TargetGroupNos).To(Equal([]string{"tg-1", "tg-2", ""}))
+
+			By("Rejecting unknown ports, duplicates and target groups without a load balancer")
+			service.Annotations[existingTargetGroupsAnnotation] = "8080=tg-1"
+			_, err = parseAdoptionRequest(service)
+			Expect(err).To(HaveOccurred())
+			service.Annotations[existingTargetGroupsAnnotation] = "http=tg-1,https=tg-1"
+			_, err = parseAdoptionRequest(service)
+			Expect(err).To(HaveOccurred())
+			delete(service.Annotations, existingLoadBalancerAnnotation)
+			_, err = parseAdoptionRequest(service)
+			Expect(err).To(HaveOccurred())
+
+			Expect(parseAdoptionRequest(&corev1.Service{})).To(BeNil())
+		})
+	})
+
+	Context("When an existing load balancer is adopted", func() {
+		It("should reuse it and leave adopted resources behind on delete", func() {
+			service := newService(ctx, "adoption-service", map[string]string{
+				lbTypeAnnotation:               lbTypeNetworkProxy,
+				existingLoadBalancerAnnotation: "lb-existing",
+				existingTargetGroupsAnnotation: "80=tg-existing",
+			}, 80)
+			defer cleanupService(ctx, service)
+			mockClient.TargetGroups = append(mockClient.TargetGroups, vloadbalancer.TargetGroup{
+				TargetGroupNo:           ncloud.String("tg-existing"),
+				TargetGroupName:         ncloud.String("existing-tg"),
+				TargetGroupProtocolType: &vloadbalancer.CommonCode{Code: ncloud.String(targetGroupProtocolForPort(lbTypeNetworkProxy, service.Spec.Ports[0]))},
+				TargetGroupPort:         ncloud.Int32(service.Spec.Ports[0].NodePort),
+				VpcNo:                   ncloud.String("vpc-12345"),
+			})
+			mockClient.Targets["tg-existing"] = []string{"external-1"}
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.CreateLBCalled).To(Equal(0))
+			Expect(mockClient.CreateTGCalled).To(Equal(0))
+			Expect(mockClient.Listeners).To(HaveLen(2))
+			Expect(mockClient.ListenerTargets).To(ContainElement("tg-existing"))
+
+			nlb, err := reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Status.LoadBalancerNo).To(Equal("lb-existing"))
+			Expect(nlb.Status.Adoption).NotTo(BeNil())
+			Expect(nlb.Status.Adoption.ListenerNos).To(Equal([]string{"listener-existing"}))
+			Expect(nlb.Status.Adoption.TargetGroupNos).To(Equal([]string{"tg-existing"}))
+
+			By("Refusing to switch to a different load balancer")
+			service.Annotations[existingLoadBalancerAnnotation] = "lb-other"
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).To(HaveOccurred())
+			Expect(recorder.Events).To(Receive(ContainSubstring("InvalidAdoption")))
+
+			By("Removing only the listener the controller added")
+			Expect(reconciler.deleteNaverCloudLB(ctx, service)).To(Succeed())
+			Expect(mockClient.DeleteLBCalled).To(Equal(0))
+			Expect(mockClient.DeleteTGCalled).To(Equal(0))
+			Expect(mockClient.LoadBalancers).To(HaveLen(1))
+			Expect(mockClient.Listeners).To(HaveLen(1))
+			Expect(mockClient.Listeners[0].LoadBalancerListenerNo).To(HaveValue(Equal("listener-existing")))
+			Expect(mockClient.Targets["tg-existing"]).To(ContainElement("external-1"))
+		})
+
+		It("should not adopt a target group that does not match the Service port", func() {
+			service := newService(ctx, "adoption-mismatch-service", map[string]string{
+				lbTypeAnnotation:               lbTypeNetworkProxy,
+				existingLoadBalancerAnnotation: "lb-existing",
+				existingTargetGroupsAnnotation: "80=tg-mismatch",
+			}, 80)
+			defer cleanupService(ctx, service)
+			mockClient.TargetGroups = append(mockClient.TargetGroups, vloadbalancer.TargetGroup{
+				TargetGroupNo:           ncloud.String("tg-mismatch"),
+				TargetGroupProtocolType: &vloadbalancer.CommonCode{Code: ncloud.String(targetGroupProtocolForPort(lbTypeNetworkProxy, service.Spec.Ports[0]))},
+				TargetGroupPort:         ncloud.Int32(service.Spec.Ports[0].NodePort + 1),
+			})
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).To(MatchError(ContainSubstring("NodePort")))
+			Expect(recorder.Events).To(Receive(ContainSubstring("InvalidAdoption")))
+			Expect(mockClient.CreateLBCalled).To(Equal(0))
+			Expect(mockClient.Listeners).To(HaveLen(1))
+		})
+
+		It("should not adopt a load balancer owned by another cluster", func() {
+			service := newService(ctx, "adoption-foreign-service", map[string]string{
+				lbTypeAnnotation:               lbTypeNetworkProxy,
+				existingLoadBalancerAnnotation: "lb-existing",
+			}, 80)
+			defer cleanupService(ctx, service)
+			reconciler.ClusterName = "cluster-a"
+			other := &ServiceReconciler{ClusterName: "cluster-b"}
+			mockClient.LoadBalancers[0].LoadBalancerDescription = ncloud.String(other.loadBalancerDescription(service))
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).To(MatchError(ContainSubstring("cluster-b")))
+			Expect(recorder.Events).To(Receive(ContainSubstring("InvalidAdoption")))
+			Expect(mockClient.Listeners).To(HaveLen(1))
+		})
+	})
+})
@@ -82,6 +82,26 @@ var _ = Describe("Business Logic Tests", func() {
 			Expect(strings.Contains(name, "$")).To(BeFalse())
 			Expect(strings.Contains(name, "%")).To(BeFalse())
 		})
+
+		It("should keep truncated resource names unique with a hash suffix", func() {
+			service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "payments-gateway-service"}}
+
+			By("Keeping a readable prefix within 30 characters")
+			tg0 := reconciler.resourceName("tg", service, "0")
+			tg1 := reconciler.resourceName("tg", service, "1")
+			Expect(tg0).To(HavePrefix("tg-team-a-payments-"))
+			Expect(len(tg0)).To(BeNumerically("<=", 30))
+			Expect(tg0).To(MatchRegexp("^[a-z][a-z0-9-]*[a-z0-9]$"))
+
+			By("Separating ports whose legacy names collapse into one")
+			Expect(reconciler.generateValidName("tg", "team-a", "payments-gateway-service", "0")).To(Equal(reconciler.generateValidName("tg", "team-a", "payments-gateway-service", "1")))
+			Expect(tg0).NotTo(Equal(tg1))
+
+			By("Separating clusters and staying deterministic")
+			other := &ServiceReconciler{ClusterName: "cluster-b"}
+			Expect(other.resourceName("tg", service, "0")).NotTo(Equal(tg0))
+			Expect(reconciler.resourceName("tg", service, "0")).To(Equal(tg0))
+		})
 	})
 
 	Context("When testing service type detection", func() {
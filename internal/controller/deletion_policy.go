@@ -0,0 +1,111 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	naverv1alpha1 "github.com/suslmk-lee/kube-controller01/api/v1alpha1"
+)
+
+// deletionPolicyAnnotation은 Service 삭제 시 Naver Cloud 리소스 처리 방식입니다 (Retain 또는 Delete)
+const deletionPolicyAnnotation = "naver.k-paas.org/deletion-policy"
+
+// 삭제 정책
+const (
+	// DeletionPolicyDelete는 Service 삭제 시 로드밸런서와 타겟 그룹을 삭제합니다 (기본값)
+	DeletionPolicyDelete = "Delete"
+	// DeletionPolicyRetain은 Service 삭제 시 로드밸런서와 타겟 그룹을 남겨 둡니다
+	DeletionPolicyRetain = "Retain"
+)
+
+// ParseDeletionPolicy는 삭제 정책 값을 검증하고 정규화합니다 (대소문자 구분 없음)
+func ParseDeletionPolicy(value string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "delete":
+		return DeletionPolicyDelete, nil
+	case "retain":
+		return DeletionPolicyRetain, nil
+	default:
+		return "", fmt.Errorf("지원하지 않는 삭제 정책: %q (Retain 또는 Delete)", value)
+	}
+}
+
+// deletionPolicy는 Service 어노테이션 또는 컨트롤러 기본값에 따른 삭제 정책을 반환합니다
+func (r *ServiceReconciler) deletionPolicy(service *corev1.Service) (string, error) {
+	if value, ok := service.Annotations[deletionPolicyAnnotation]; ok {
+		policy, err := ParseDeletionPolicy(value)
+		if err != nil {
+			return "", fmt.Errorf("%s 어노테이션 값이 올바르지 않음: %w", deletionPolicyAnnotation, err)
+		}
+		return policy, nil
+	}
+	if r.DefaultDeletionPolicy == "" {
+		return DeletionPolicyDelete, nil
+	}
+	return ParseDeletionPolicy(r.DefaultDeletionPolicy)
+}
+
+// retainNaverCloudLB는 Retain 정책에 따라 로드밸런서와 타겟 그룹을 삭제하지 않고 남겨 둡니다.
+// 남긴 리소스의 설명에 원래 Service를 기록하고 이벤트로 알린 뒤 NaverLoadBalancer만 정리합니다.
+// 설명 기록에 실패하면 남긴 리소스를 식별할 수 없으므로 오류를 반환하여 finalizer를 유지하고 다음 조정에서 재시도합니다.
+// 리스너, 인증서, Network ACL 및 ACG 규칙도 트래픽이 계속 흐르도록 그대로 둡니다
+func (r *ServiceReconciler) retainNaverCloudLB(ctx context.Context, client NaverCloudClient, credentials *NaverCloudCredentials, service *corev1.Service, nlb *naverv1alpha1.NaverLoadBalancer, lbID string, targetGroupIDs []string) error {
+	logger := log.FromContext(ctx).WithValues("service", service.Namespace+"/"+service.Name)
+
+	description := r.retainedResourceDescription(service, time.Now())
+	if lbID != "" {
+		if _, err := client.SetLoadBalancerDescription(&vloadbalancer.SetLoadBalancerDescriptionRequest{
+			RegionCode:              ncloud.String(credentials.Region),
+			LoadBalancerInstanceNo:  ncloud.String(lbID),
+			LoadBalancerDescription: ncloud.String(description),
+		}); err != nil {
+			return fmt.Errorf("남겨 둔 로드밸런서 %s 설명 변경 실패: %w", lbID, err)
+		}
+	}
+	for _, tgID := range targetGroupIDs {
+		if _, err := client.SetTargetGroupDescription(&vloadbalancer.SetTargetGroupDescriptionRequest{
+			RegionCode:             ncloud.String(credentials.Region),
+			TargetGroupNo:          ncloud.String(tgID),
+			TargetGroupDescription: ncloud.String(description),
+		}); err != nil {
+			return fmt.Errorf("남겨 둔 타겟 그룹 %s 설명 변경 실패: %w", tgID, err)
+		}
+	}
+
+	logger.Info("삭제 정책에 따라 Naver Cloud 리소스를 남겨 둠, 수동 정리 필요", "lb-id", lbID, "target-groups", targetGroupIDs)
+	if r.Recorder != nil {
+		r.Recorder.Eventf(service, corev1.EventTypeWarning, "ResourcesRetained",
+			"삭제 정책 %s에 따라 Naver Cloud 리소스를 남겨 둠 (로드밸런서: %s, 타겟 그룹: %s), 필요 없으면 수동으로 삭제하세요",
+			DeletionPolicyRetain, lbID, strings.Join(targetGroupIDs, ","))
+	}
+	return r.deleteNaverLoadBalancer(ctx, nlb)
+}
+
+// retainedResourceDescription은 남겨 둔 리소스에 기록할 설명을 만듭니다
+func (r *ServiceReconciler) retainedResourceDescription(service *corev1.Service, at time.Time) string {
+	return fmt.Sprintf("Retained by K-PaaS controller (deletion-policy=%s): cluster=%s service %s/%s uid=%s deleted at %s",
+		DeletionPolicyRetain, r.ClusterName, service.Namespace, service.Name, service.UID, at.UTC().Format(time.RFC3339))
+}
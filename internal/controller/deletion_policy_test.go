@@ -0,0 +1,137 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("Deletion Policy Tests", func() {
+	var (
+		reconciler *ServiceReconciler
+		mockClient *navercloud.MockClient
+		recorder   *record.FakeRecorder
+		ctx        context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockClient = navercloud.NewMockClient()
+		recorder = record.NewFakeRecorder(10)
+
+		reconciler = &ServiceReconciler{
+			Client: k8sClient,
+			NaverCloudConfig: NaverCloudConfig{
+				APIKey:    "test-api-key",
+				APISecret: "test-api-secret",
+				Region:    "KR",
+				VpcNo:     "vpc-12345",
+				SubnetNo:  "subnet-67890",
+			},
+			NaverClient: mockClient,
+			Recorder:    recorder,
+		}
+	})
+
+	Context("When resolving the deletion policy", func() {
+		It("should prefer the annotation over the controller default", func() {
+			service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{}}}
+			Expect(reconciler.deletionPolicy(service)).To(Equal(DeletionPolicyDelete))
+
+			reconciler.DefaultDeletionPolicy = DeletionPolicyRetain
+			Expect(reconciler.deletionPolicy(service)).To(Equal(DeletionPolicyRetain))
+
+			service.Annotations[deletionPolicyAnnotation] = "delete"
+			Expect(reconciler.deletionPolicy(service)).To(Equal(DeletionPolicyDelete))
+
+			service.Annotations[deletionPolicyAnnotation] = "Orphan"
+			_, err := reconciler.deletionPolicy(service)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("When a Service with the Retain policy is deleted", func() {
+		It("should leave the load balancer and target groups and tag them", func() {
+			service := newService(ctx, "retain-service", map[string]string{
+				deletionPolicyAnnotation: DeletionPolicyRetain,
+			}, 80)
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.LoadBalancers).To(HaveLen(1))
+			Expect(mockClient.TargetGroups).To(HaveLen(1))
+
+			Expect(reconciler.deleteNaverCloudLB(ctx, service)).To(Succeed())
+			Expect(mockClient.DeleteLBCalled).To(Equal(0))
+			Expect(mockClient.DeleteTGCalled).To(Equal(0))
+			Expect(mockClient.DeleteListenerCalled).To(Equal(0))
+			Expect(mockClient.LoadBalancers[0].LoadBalancerDescription).To(HaveValue(ContainSubstring("default/retain-service")))
+			Expect(mockClient.TargetGroups[0].TargetGroupDescription).To(HaveValue(ContainSubstring("deletion-policy=Retain")))
+			Expect(recorder.Events).To(Receive(ContainSubstring("ResourcesRetained")))
+
+			nlb, err := reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb).To(BeNil())
+		})
+
+		It("should keep the NaverLoadBalancer until the retained resources are tagged", func() {
+			service := newService(ctx, "retain-tag-failure-service", map[string]string{
+				deletionPolicyAnnotation: DeletionPolicyRetain,
+			}, 80)
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Failing while the target group description cannot be changed")
+			targetGroups := mockClient.TargetGroups
+			mockClient.TargetGroups = nil
+			Expect(reconciler.deleteNaverCloudLB(ctx, service)).To(MatchError(ContainSubstring("설명 변경 실패")))
+			nlb, err := reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb).NotTo(BeNil())
+
+			By("Finishing once the description is recorded")
+			mockClient.TargetGroups = targetGroups
+			Expect(reconciler.deleteNaverCloudLB(ctx, service)).To(Succeed())
+			Expect(mockClient.TargetGroups[0].TargetGroupDescription).To(HaveValue(ContainSubstring("deletion-policy=Retain")))
+			nlb, err = reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb).To(BeNil())
+		})
+
+		It("should reject an invalid policy before creating anything", func() {
+			service := newService(ctx, "invalid-policy-service", map[string]string{
+				deletionPolicyAnnotation: "Keep",
+			}, 80)
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).To(HaveOccurred())
+			Expect(recorder.Events).To(Receive(ContainSubstring("InvalidDeletionPolicy")))
+			Expect(mockClient.CreateLBCalled).To(Equal(0))
+		})
+	})
+})
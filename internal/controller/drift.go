@@ -0,0 +1,345 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	naverv1alpha1 "github.com/suslmk-lee/kube-controller01/api/v1alpha1"
+)
+
+// driftPolicyAnnotation은 로드밸런서 구성이 콘솔 변경이나 삭제로 기록과 달라졌을 때(드리프트) 처리 방식입니다 (Repair 또는 Report)
+const driftPolicyAnnotation = "naver.k-paas.org/drift-policy"
+
+// 드리프트 정책
+const (
+	// DriftPolicyRepair는 드리프트를 감지하면 이벤트를 남기고 Service에 맞게 복구하거나 다시 만듭니다 (기본값)
+	DriftPolicyRepair = "Repair"
+	// DriftPolicyReport는 드리프트를 감지하면 이벤트만 남기고 네이버 클라우드 리소스를 변경하지 않습니다
+	DriftPolicyReport = "Report"
+)
+
+// ParseDriftPolicy는 드리프트 정책 값을 검증하고 정규화합니다 (대소문자 구분 없음)
+func ParseDriftPolicy(value string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "repair":
+		return DriftPolicyRepair, nil
+	case "report":
+		return DriftPolicyReport, nil
+	default:
+		return "", fmt.Errorf("지원하지 않는 드리프트 정책: %q (Repair 또는 Report)", value)
+	}
+}
+
+// driftPolicy는 Service 어노테이션 또는 컨트롤러 기본값에 따른 드리프트 정책을 반환합니다
+func (r *ServiceReconciler) driftPolicy(service *corev1.Service) (string, error) {
+	if value, ok := service.Annotations[driftPolicyAnnotation]; ok {
+		policy, err := ParseDriftPolicy(value)
+		if err != nil {
+			return "", fmt.Errorf("%s 어노테이션 값이 올바르지 않음: %w", driftPolicyAnnotation, err)
+		}
+		return policy, nil
+	}
+	if r.DefaultDriftPolicy == "" {
+		return DriftPolicyRepair, nil
+	}
+	return ParseDriftPolicy(r.DefaultDriftPolicy)
+}
+
+// driftReport는 상태에 기록된 구성과 실제 네이버 클라우드 리소스의 차이입니다
+type driftReport struct {
+	// LoadBalancerMissing은 로드밸런서가 삭제되었거나 삭제 중인지 여부입니다
+	LoadBalancerMissing bool
+	// MissingTargetGroups는 삭제된 타겟 그룹 번호입니다
+	MissingTargetGroups map[string]bool
+	// ChangedTargetGroups는 프로토콜이나 포트가 바뀌었거나 다른 로드밸런서에 연결된 타겟 그룹 번호입니다
+	ChangedTargetGroups map[string]bool
+	// Differences는 이벤트와 로그에 남길 차이 설명입니다
+	Differences []string
+}
+
+func (d *driftReport) add(format string, args ...interface{}) {
+	d.Differences = append(d.Differences, fmt.Sprintf(format, args...))
+}
+
+// detected는 차이가 하나라도 있는지 확인합니다
+func (d *driftReport) detected() bool {
+	return len(d.Differences) > 0
+}
+
+func (d *driftReport) String() string {
+	return strings.Join(d.Differences, "; ")
+}
+
+// detectDrift는 로드밸런서, 리스너, 타겟 그룹과 등록된 타겟을 조회하여 상태에 기록된 구성과 비교합니다.
+// Service 변경은 정상적인 조정 대상이므로 Service가 아니라 마지막으로 조정을 끝낸 기록과 비교합니다.
+// 리스너가 연결된 타겟 그룹은 API로 조회할 수 없어 비교하지 않습니다
+func (r *ServiceReconciler) detectDrift(client NaverCloudClient, credentials *NaverCloudCredentials, service *corev1.Service, nlb *naverv1alpha1.NaverLoadBalancer, settings *listenerSettings) (*driftReport, error) {
+	report := &driftReport{
+		MissingTargetGroups: make(map[string]bool),
+		ChangedTargetGroups: make(map[string]bool),
+	}
+	status := &nlb.Status
+	lbID := status.LoadBalancerNo
+
+	// 1. 로드밸런서 (삭제 중인 로드밸런서도 없는 것으로 간주)
+	lb, err := r.lookupLoadBalancer(client, credentials, lbID)
+	if err != nil {
+		return nil, err
+	}
+	if lb == nil {
+		report.LoadBalancerMissing = true
+		report.add("로드밸런서 %s 없음", lbID)
+	} else if code, _ := loadBalancerInstanceStatus(lb); code == "TERMINATING" {
+		report.LoadBalancerMissing = true
+		report.add("로드밸런서 %s 삭제 중", lbID)
+	}
+
+	// 2. 리스너 (기록된 리스너가 없거나, 기록되지 않았고 Service 포트도 아닌 리스너가 있으면 드리프트)
+	if !report.LoadBalancerMissing {
+		listenerResp, err := client.GetLoadBalancerListenerList(&vloadbalancer.GetLoadBalancerListenerListRequest{
+			RegionCode:             ncloud.String(credentials.Region),
+			LoadBalancerInstanceNo: ncloud.String(lbID),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("리스너 목록 조회 실패: %w", err)
+		}
+
+		expected := make(map[string]bool, len(status.Listeners)+len(service.Spec.Ports))
+		for _, listener := range status.Listeners {
+			expected[listenerKey(listener.Port, listener.Protocol)] = true
+		}
+		for _, port := range service.Spec.Ports {
+			expected[settings.key(port)] = true
+		}
+		var adoptedListeners []string
+		if status.Adoption != nil {
+			adoptedListeners = status.Adoption.ListenerNos
+		}
+
+		actual := make(map[string]bool)
+		if listenerResp != nil {
+			for _, listener := range listenerResp.LoadBalancerListenerList {
+				if listener.Port == nil || listener.LoadBalancerListenerNo == nil {
+					continue
+				}
+				key := apiListenerKey(listener)
+				actual[key] = true
+				if !expected[key] && !containsString(adoptedListeners, *listener.LoadBalancerListenerNo) {
+					report.add("예상하지 않은 리스너 %s (%s)", key, *listener.LoadBalancerListenerNo)
+				}
+			}
+		}
+		for _, listener := range status.Listeners {
+			if key := listenerKey(listener.Port, listener.Protocol); !actual[key] {
+				report.add("리스너 %s 없음 (%s)", key, listener.ListenerNo)
+			}
+		}
+	}
+
+	// 3. Service 포트에 연결된 타겟 그룹의 프로토콜, 포트, 연결된 로드밸런서와 등록된 타겟
+	adopted := adoptedTargetGroups(status)
+	lbType := recordedLoadBalancerType(status)
+	for _, port := range service.Spec.Ports {
+		tg := status.FindTargetGroup(port.Port, port.Protocol)
+		if tg == nil || tg.TargetGroupNo == "" || report.MissingTargetGroups[tg.TargetGroupNo] || report.ChangedTargetGroups[tg.TargetGroupNo] {
+			continue
+		}
+
+		detailResp, err := client.GetTargetGroupDetail(&vloadbalancer.GetTargetGroupDetailRequest{
+			RegionCode:    ncloud.String(credentials.Region),
+			TargetGroupNo: ncloud.String(tg.TargetGroupNo),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("타겟 그룹 상세 정보 조회 실패: %w", err)
+		}
+		if detailResp == nil || len(detailResp.TargetGroupList) == 0 {
+			report.MissingTargetGroups[tg.TargetGroupNo] = true
+			report.add("타겟 그룹 %s 없음 (port %d)", tg.TargetGroupNo, tg.Port)
+			continue
+		}
+		// 채택한 타겟 그룹은 원래 설정과 다른 타겟을 그대로 둠
+		if adopted[tg.TargetGroupNo] {
+			continue
+		}
+		current := detailResp.TargetGroupList[0]
+
+		if current.TargetGroupProtocolType != nil && current.TargetGroupProtocolType.Code != nil {
+			expectedProtocol := targetGroupProtocolForPort(lbType, corev1.ServicePort{Protocol: tg.Protocol})
+			if protocol := *current.TargetGroupProtocolType.Code; protocol != expectedProtocol {
+				report.ChangedTargetGroups[tg.TargetGroupNo] = true
+				report.add("타겟 그룹 %s 프로토콜 %s (예상 %s)", tg.TargetGroupNo, protocol, expectedProtocol)
+				continue
+			}
+		}
+		if tg.NodePort != 0 && current.TargetGroupPort != nil && *current.TargetGroupPort != tg.NodePort {
+			report.ChangedTargetGroups[tg.TargetGroupNo] = true
+			report.add("타겟 그룹 %s 포트 %d (예상 %d)", tg.TargetGroupNo, *current.TargetGroupPort, tg.NodePort)
+			continue
+		}
+		if attached := ncloud.StringValue(current.LoadBalancerInstanceNo); attached != "" && !report.LoadBalancerMissing && attached != lbID {
+			report.ChangedTargetGroups[tg.TargetGroupNo] = true
+			report.add("타겟 그룹 %s가 다른 로드밸런서 %s에 연결됨", tg.TargetGroupNo, attached)
+			continue
+		}
+
+		// 등록한 타겟을 기록하기 전의 상태는 비교하지 않음
+		if status.Targets == nil {
+			continue
+		}
+		targetResp, err := client.GetTargetList(&vloadbalancer.GetTargetListRequest{
+			RegionCode:    ncloud.String(credentials.Region),
+			TargetGroupNo: ncloud.String(tg.TargetGroupNo),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("타겟 목록 조회 실패: %w", err)
+		}
+		var registered []string
+		if targetResp != nil {
+			for _, target := range targetResp.TargetList {
+				if target.TargetNo != nil {
+					registered = append(registered, *target.TargetNo)
+				}
+			}
+		}
+		for _, targetNo := range status.Targets {
+			if !containsString(registered, targetNo) {
+				report.add("타겟 그룹 %s에서 타겟 %s 제거됨", tg.TargetGroupNo, targetNo)
+			}
+		}
+		for _, targetNo := range registered {
+			if !containsString(status.Targets, targetNo) {
+				report.add("타겟 그룹 %s에 예상하지 않은 타겟 %s", tg.TargetGroupNo, targetNo)
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// reconcileDrift는 구성을 끝낸 로드밸런서의 드리프트를 확인하고, 감지하면 DriftDetected 이벤트를 남긴 뒤 정책에 따라 처리합니다.
+// Repair 정책에서는 삭제된 타겟 그룹을 기록에서 지우고(포트 조정에서 새로 생성), 설정이 바뀐 타겟 그룹은 replace로 반환하여 교체하며,
+// 리스너와 타겟은 이어지는 포트·타겟 조정이 복구합니다. 로드밸런서가 삭제되었으면 기록을 지워 다음 조정에서 새로 만듭니다.
+// skip이 true이면 이번 조정에서 로드밸런서를 더 변경하지 않습니다 (Report 정책 또는 로드밸런서 재생성 대기)
+func (r *ServiceReconciler) reconcileDrift(ctx context.Context, client NaverCloudClient, credentials *NaverCloudCredentials, service *corev1.Service, nlb *naverv1alpha1.NaverLoadBalancer, settings *listenerSettings) (replace map[string]bool, skip bool, err error) {
+	logger := log.FromContext(ctx).WithValues("lb-id", nlb.Status.LoadBalancerNo)
+
+	// 마이그레이션 직후나 채택 직후처럼 아직 구성을 끝내지 않은 기록은 비교하지 않음
+	if nlb.Status.Phase != naverv1alpha1.PhaseReady {
+		return nil, false, nil
+	}
+	policy, err := r.driftPolicy(service)
+	if err != nil {
+		return nil, false, err
+	}
+
+	report, err := r.detectDrift(client, credentials, service, nlb, settings)
+	if err != nil {
+		return nil, false, fmt.Errorf("구성 드리프트 확인 실패: %w", err)
+	}
+	if !report.detected() {
+		return nil, false, nil
+	}
+
+	logger.Info("로드밸런서 구성 드리프트 감지", "policy", policy, "differences", report.Differences)
+	r.recordWarning(service, "DriftDetected", "로드밸런서 구성이 Service와 다름 (%s 정책): %s", policy, report)
+
+	if policy == DriftPolicyReport {
+		if report.LoadBalancerMissing {
+			return nil, false, fmt.Errorf("로드밸런서 %s가 삭제됨 (%s 정책이라 다시 만들지 않음)", nlb.Status.LoadBalancerNo, policy)
+		}
+		return nil, true, nil
+	}
+
+	if report.LoadBalancerMissing {
+		// 채택한 로드밸런서는 컨트롤러가 다시 만들 수 없음
+		if nlb.Status.Adoption != nil {
+			return nil, false, fmt.Errorf("채택한 로드밸런서 %s가 삭제됨 (Service를 다시 생성하세요)", nlb.Status.LoadBalancerNo)
+		}
+		logger.Info("삭제된 로드밸런서 기록 제거, 다음 조정에서 다시 생성")
+		if err := r.forgetLoadBalancer(ctx, service, nlb, report.MissingTargetGroups); err != nil {
+			return nil, false, err
+		}
+		return nil, true, nil
+	}
+
+	if len(report.MissingTargetGroups) > 0 {
+		logger.Info("삭제된 타겟 그룹 기록 제거, 포트 조정에서 다시 생성", "targetGroups", report.MissingTargetGroups)
+		if err := r.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+			latest.Status.TargetGroups = withoutTargetGroups(latest.Status.TargetGroups, report.MissingTargetGroups)
+		}); err != nil {
+			return nil, false, err
+		}
+	}
+	return report.ChangedTargetGroups, false, nil
+}
+
+// forgetLoadBalancer는 삭제된 로드밸런서의 기록을 지워 다음 조정에서 새로 만들도록 합니다.
+// 남아 있는 타겟 그룹은 재사용하고, Service의 lb-id 어노테이션과 외부 주소도 제거합니다
+func (r *ServiceReconciler) forgetLoadBalancer(ctx context.Context, service *corev1.Service, nlb *naverv1alpha1.NaverLoadBalancer, missingTargetGroups map[string]bool) error {
+	if err := r.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+		latest.Status.LoadBalancerNo = ""
+		latest.Status.LoadBalancerName = ""
+		latest.Status.Domain = ""
+		latest.Status.IPs = nil
+		latest.Status.Listeners = nil
+		latest.Status.TargetGroups = withoutTargetGroups(latest.Status.TargetGroups, missingTargetGroups)
+		latest.Status.ObservedGeneration = 0
+		setPhase(latest, naverv1alpha1.PhaseTargetGroupsCreated)
+		setReadyCondition(latest, metav1.ConditionFalse, naverv1alpha1.ReasonProvisioning, "삭제된 로드밸런서를 다시 생성하는 중")
+	}); err != nil {
+		return err
+	}
+
+	latest := &corev1.Service{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: service.Namespace, Name: service.Name}, latest); err != nil {
+		return fmt.Errorf("최신 Service 객체 조회 실패: %w", err)
+	}
+	if _, ok := latest.Annotations["naver.k-paas.org/lb-id"]; ok {
+		delete(latest.Annotations, "naver.k-paas.org/lb-id")
+		if err := r.Update(ctx, latest); err != nil {
+			return fmt.Errorf("서비스 어노테이션 업데이트 실패: %w", err)
+		}
+	}
+	if len(latest.Status.LoadBalancer.Ingress) > 0 {
+		latest.Status.LoadBalancer = corev1.LoadBalancerStatus{}
+		if err := r.Status().Update(ctx, latest); err != nil {
+			return fmt.Errorf("서비스 상태 업데이트 실패: %w", err)
+		}
+	}
+	return nil
+}
+
+// withoutTargetGroups는 targetGroupNos에 있는 타겟 그룹을 뺀 목록을 반환합니다
+func withoutTargetGroups(targetGroups []naverv1alpha1.TargetGroupStatus, targetGroupNos map[string]bool) []naverv1alpha1.TargetGroupStatus {
+	var kept []naverv1alpha1.TargetGroupStatus
+	for _, tg := range targetGroups {
+		if !targetGroupNos[tg.TargetGroupNo] {
+			kept = append(kept, tg)
+		}
+	}
+	return kept
+}
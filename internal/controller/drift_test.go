@@ -0,0 +1,255 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	naverv1alpha1 "github.com/suslmk-lee/kube-controller01/api/v1alpha1"
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("Drift Detection Tests", func() {
+	var (
+		reconciler *ServiceReconciler
+		mockClient *navercloud.MockClient
+		recorder   *record.FakeRecorder
+		ctx        context.Context
+		node       *corev1.Node
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockClient = navercloud.NewMockClient()
+		recorder = record.NewFakeRecorder(10)
+
+		reconciler = &ServiceReconciler{
+			Client: k8sClient,
+			NaverCloudConfig: NaverCloudConfig{
+				APIKey:    "test-api-key",
+				APISecret: "test-api-secret",
+				Region:    "KR",
+				VpcNo:     "vpc-12345",
+				SubnetNo:  "subnet-67890",
+			},
+			NaverClient: mockClient,
+			Recorder:    recorder,
+		}
+
+		node = &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "drift-worker-1"},
+			Spec:       corev1.NodeSpec{ProviderID: "ncloud:///KR-1/1001"},
+		}
+		Expect(k8sClient.Create(ctx, node)).To(Succeed())
+		node.Status.Addresses = []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: "10.0.1.10"}}
+		node.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}
+		Expect(k8sClient.Status().Update(ctx, node)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, node)).To(Succeed())
+	})
+
+	// provision은 로드밸런서를 끝까지 구성하고 생성 호출 횟수를 확인합니다
+	provision := func(name string, annotations map[string]string) *corev1.Service {
+		service := newService(ctx, name, annotations, 80)
+		lbStatus, err := reconciler.reconcileNaverCloudLB(ctx, service)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lbStatus.ProvisioningStatus).To(Equal("ACTIVE"))
+		Expect(mockClient.CreateListenerCalled).To(Equal(1))
+		Expect(mockClient.Targets["tg-12345"]).To(ConsistOf("1001"))
+		Expect(recorder.Events).NotTo(Receive())
+		return service
+	}
+
+	getStatus := func(service *corev1.Service) *naverv1alpha1.NaverLoadBalancerStatus {
+		nlb, err := reconciler.getNaverLoadBalancer(ctx, service)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nlb).NotTo(BeNil())
+		return &nlb.Status
+	}
+
+	Context("When resolving the drift policy", func() {
+		It("should prefer the annotation over the controller default", func() {
+			service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{}}}
+			Expect(reconciler.driftPolicy(service)).To(Equal(DriftPolicyRepair))
+
+			reconciler.DefaultDriftPolicy = "report"
+			Expect(reconciler.driftPolicy(service)).To(Equal(DriftPolicyReport))
+
+			service.Annotations[driftPolicyAnnotation] = "Repair"
+			Expect(reconciler.driftPolicy(service)).To(Equal(DriftPolicyRepair))
+
+			service.Annotations[driftPolicyAnnotation] = "Ignore"
+			_, err := reconciler.driftPolicy(service)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("When a listener or target is removed outside the controller", func() {
+		It("should report the drift and restore them", func() {
+			service := provision("drift-listener-service", nil)
+			defer cleanupService(ctx, service)
+			Expect(getStatus(service).Targets).To(Equal([]string{"1001"}))
+
+			mockClient.Listeners = nil
+			mockClient.Targets["tg-12345"] = nil
+
+			lbStatus, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lbStatus.ProvisioningStatus).To(Equal("ACTIVE"))
+			Expect(recorder.Events).To(Receive(ContainSubstring("DriftDetected")))
+			Expect(mockClient.CreateListenerCalled).To(Equal(2))
+			Expect(mockClient.ListenerTargets).To(HaveKeyWithValue("listener-12346", "tg-12345"))
+			Expect(mockClient.Targets["tg-12345"]).To(ConsistOf("1001"))
+
+			By("Staying quiet once the configuration matches again")
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorder.Events).NotTo(Receive())
+			Expect(mockClient.CreateListenerCalled).To(Equal(2))
+		})
+
+		It("should only report the drift under the Report policy", func() {
+			service := provision("drift-report-service", map[string]string{driftPolicyAnnotation: DriftPolicyReport})
+			defer cleanupService(ctx, service)
+
+			mockClient.Listeners = nil
+
+			lbStatus, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lbStatus.ProvisioningStatus).To(Equal("ACTIVE"))
+			Expect(recorder.Events).To(Receive(ContainSubstring("DriftDetected")))
+			Expect(mockClient.CreateListenerCalled).To(Equal(1))
+			Expect(mockClient.Listeners).To(BeEmpty())
+		})
+	})
+
+	Context("When some node instances cannot be resolved", func() {
+		It("should not report the targets added meanwhile as drift", func() {
+			service := provision("drift-partial-nodes-service", map[string]string{driftPolicyAnnotation: DriftPolicyReport})
+			defer cleanupService(ctx, service)
+
+			addNode := func(name, providerID, ip string) *corev1.Node {
+				added := &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+					Spec:       corev1.NodeSpec{ProviderID: providerID},
+				}
+				Expect(k8sClient.Create(ctx, added)).To(Succeed())
+				added.Status.Addresses = []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: ip}}
+				added.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}
+				Expect(k8sClient.Status().Update(ctx, added)).To(Succeed())
+				return added
+			}
+			resolved := addNode("drift-worker-2", "ncloud:///KR-1/1002", "10.0.1.11")
+			defer func() { Expect(k8sClient.Delete(ctx, resolved)).To(Succeed()) }()
+			unresolved := addNode("drift-worker-unknown", "", "10.0.1.99")
+
+			By("Registering the new node without removing any target")
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.Targets["tg-12345"]).To(ConsistOf("1001", "1002"))
+			Expect(getStatus(service).Targets).To(BeEmpty())
+
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorder.Events).NotTo(Receive())
+
+			By("Recording the targets again once every node is resolved")
+			Expect(k8sClient.Delete(ctx, unresolved)).To(Succeed())
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getStatus(service).Targets).To(ConsistOf("1001", "1002"))
+			Expect(recorder.Events).NotTo(Receive())
+		})
+	})
+
+	Context("When a target group is deleted outside the controller", func() {
+		It("should create a new target group and reconnect the listener", func() {
+			service := provision("drift-tg-service", nil)
+			defer cleanupService(ctx, service)
+
+			mockClient.TargetGroups = nil
+			delete(mockClient.Targets, "tg-12345")
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorder.Events).To(Receive(ContainSubstring("DriftDetected")))
+			Expect(mockClient.CreateTGCalled).To(Equal(2))
+			Expect(mockClient.DeleteListenerCalled).To(Equal(1))
+			Expect(mockClient.CreateListenerCalled).To(Equal(2))
+			Expect(mockClient.ListenerTargets).To(HaveKeyWithValue("listener-12346", "tg-12346"))
+			Expect(mockClient.Targets["tg-12346"]).To(ConsistOf("1001"))
+			Expect(getStatus(service).TargetGroupNos()).To(Equal([]string{"tg-12346"}))
+		})
+	})
+
+	Context("When the load balancer is deleted outside the controller", func() {
+		It("should forget the stale load balancer and create a new one", func() {
+			service := provision("drift-lb-service", nil)
+			defer cleanupService(ctx, service)
+			key := types.NamespacedName{Namespace: service.Namespace, Name: service.Name}
+
+			mockClient.LoadBalancers = nil
+			mockClient.Listeners = nil
+
+			lbStatus, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lbStatus.ProvisioningStatus).To(Equal("PENDING"))
+			Expect(recorder.Events).To(Receive(ContainSubstring("DriftDetected")))
+
+			latest := &corev1.Service{}
+			Expect(k8sClient.Get(ctx, key, latest)).To(Succeed())
+			Expect(latest.Annotations).NotTo(HaveKey("naver.k-paas.org/lb-id"))
+			status := getStatus(service)
+			Expect(status.LoadBalancerNo).To(BeEmpty())
+			Expect(status.TargetGroupNos()).To(Equal([]string{"tg-12345"}))
+
+			By("Recreating the load balancer with the remaining target group")
+			lbStatus, err = reconciler.reconcileNaverCloudLB(ctx, latest)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lbStatus.ProvisioningStatus).To(Equal("ACTIVE"))
+			Expect(lbStatus.LBID).To(Equal("lb-12346"))
+			Expect(mockClient.CreateLBCalled).To(Equal(2))
+			Expect(mockClient.CreateTGCalled).To(Equal(1))
+			Expect(mockClient.ListenerTargets).To(HaveKeyWithValue("listener-12346", "tg-12345"))
+
+			Expect(k8sClient.Get(ctx, key, latest)).To(Succeed())
+			Expect(latest.Annotations["naver.k-paas.org/lb-id"]).To(Equal("lb-12346"))
+			Expect(getStatus(service).Phase).To(Equal(naverv1alpha1.PhaseReady))
+		})
+
+		It("should not recreate the load balancer under the Report policy", func() {
+			service := provision("drift-lb-report-service", map[string]string{driftPolicyAnnotation: DriftPolicyReport})
+			defer cleanupService(ctx, service)
+
+			mockClient.LoadBalancers = nil
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).To(MatchError(ContainSubstring("lb-12345")))
+			Expect(recorder.Events).To(Receive(ContainSubstring("DriftDetected")))
+			Expect(mockClient.CreateLBCalled).To(Equal(1))
+			Expect(getStatus(service).LoadBalancerNo).To(Equal("lb-12345"))
+		})
+	})
+})
@@ -0,0 +1,82 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud"
+)
+
+var _ = Describe("API Endpoint Profile", func() {
+	Context("When resolving an endpoint profile", func() {
+		It("should default to the gov endpoint", func() {
+			baseURL, err := navercloud.ResolveEndpoint("", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(baseURL).To(Equal("https://ncloud.apigw.gov-ntruss.com"))
+		})
+
+		It("should resolve the public and fin profiles", func() {
+			baseURL, err := navercloud.ResolveEndpoint("public", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(baseURL).To(Equal("https://ncloud.apigw.ntruss.com"))
+
+			baseURL, err = navercloud.ResolveEndpoint("FIN", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(baseURL).To(Equal("https://fin-ncloud.apigw.fin-ntruss.com"))
+		})
+
+		It("should prefer a custom URL over the profile", func() {
+			baseURL, err := navercloud.ResolveEndpoint("gov", "http://127.0.0.1:8080/")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(baseURL).To(Equal("http://127.0.0.1:8080"))
+		})
+
+		It("should reject invalid configurations", func() {
+			_, err := navercloud.ResolveEndpoint("custom", "")
+			Expect(err).To(HaveOccurred())
+
+			_, err = navercloud.ResolveEndpoint("moon", "")
+			Expect(err).To(HaveOccurred())
+
+			_, err = navercloud.ResolveEndpoint("", "ncloud.apigw.ntruss.com")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("When building credentials from the controller config", func() {
+		It("should carry the endpoint settings into the credentials", func() {
+			reconciler := &ServiceReconciler{
+				Client: k8sClient,
+				NaverCloudConfig: NaverCloudConfig{
+					APIKey:          "test-api-key",
+					APISecret:       "test-api-secret",
+					Region:          "KR",
+					EndpointProfile: "public",
+					APIEndpoint:     "http://127.0.0.1:8080",
+				},
+			}
+
+			credentials, err := reconciler.getCredentials(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(credentials.EndpointProfile).To(Equal("public"))
+			Expect(credentials.APIEndpoint).To(Equal("http://127.0.0.1:8080"))
+		})
+	})
+})
@@ -0,0 +1,386 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http/httptest"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud"
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ = Describe("Fake Naver Cloud API Server", func() {
+	const (
+		accessKey = "fake-access-key"
+		secretKey = "fake-secret-key"
+		vpcNo     = "5000"
+	)
+
+	var (
+		fakeAPI    *fake.Server
+		httpServer *httptest.Server
+		client     navercloud.Client
+	)
+
+	BeforeEach(func() {
+		fakeAPI = fake.NewServer(accessKey, secretKey)
+		fakeAPI.ProvisioningSteps = 1
+		fakeAPI.AddServerInstance("1001", "worker-1", "10.0.1.10", vpcNo)
+		httpServer = httptest.NewServer(fakeAPI)
+		client = navercloud.NewRealClientWithAPIKey(accessKey, secretKey, httpServer.URL)
+	})
+
+	AfterEach(func() {
+		httpServer.Close()
+	})
+
+	createTargetGroup := func(name string) string {
+		resp, err := client.CreateTargetGroup(&vloadbalancer.CreateTargetGroupRequest{
+			VpcNo:                       ncloud.String(vpcNo),
+			TargetGroupName:             ncloud.String(name),
+			TargetGroupProtocolTypeCode: ncloud.String("PROXY_TCP"),
+			TargetGroupPort:             ncloud.Int32(30080),
+			HealthCheckProtocolTypeCode: ncloud.String("TCP"),
+			HealthCheckPort:             ncloud.Int32(30080),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.TargetGroupList).To(HaveLen(1))
+		return *resp.TargetGroupList[0].TargetGroupNo
+	}
+
+	createLoadBalancer := func(name string) (*vloadbalancer.CreateLoadBalancerInstanceResponse, error) {
+		return client.CreateLoadBalancerInstance(&vloadbalancer.CreateLoadBalancerInstanceRequest{
+			VpcNo:                ncloud.String(vpcNo),
+			LoadBalancerName:     ncloud.String(name),
+			LoadBalancerTypeCode: ncloud.String("NETWORK_PROXY"),
+			SubnetNoList:         []*string{ncloud.String("6000")},
+		})
+	}
+
+	lbDetail := func(lbNo string) *vloadbalancer.LoadBalancerInstance {
+		resp, err := client.GetLoadBalancerInstanceDetail(&vloadbalancer.GetLoadBalancerInstanceDetailRequest{
+			LoadBalancerInstanceNo: ncloud.String(lbNo),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.LoadBalancerInstanceList).To(HaveLen(1))
+		return resp.LoadBalancerInstanceList[0]
+	}
+
+	lbStatus := func(lbNo string) string {
+		return *lbDetail(lbNo).LoadBalancerInstanceStatus.Code
+	}
+
+	lbStatusName := func(lbNo string) string {
+		return *lbDetail(lbNo).LoadBalancerInstanceStatusName
+	}
+
+	Context("When driving the API through the real SDK client", func() {
+		It("should move a load balancer through INIT, CREATING and USED", func() {
+			resp, err := createLoadBalancer("fake-lb")
+			Expect(err).NotTo(HaveOccurred())
+			lbNo := *resp.LoadBalancerInstanceList[0].LoadBalancerInstanceNo
+			Expect(*resp.LoadBalancerInstanceList[0].LoadBalancerInstanceStatus.Code).To(Equal(fake.LBStatusInit))
+
+			Expect(lbStatus(lbNo)).To(Equal(fake.LBStatusCreating))
+			Expect(lbStatus(lbNo)).To(Equal(fake.LBStatusUsed))
+		})
+
+		It("should reject listeners until the load balancer is running", func() {
+			tgNo := createTargetGroup("fake-tg")
+			resp, err := createLoadBalancer("fake-lb")
+			Expect(err).NotTo(HaveOccurred())
+			lbNo := *resp.LoadBalancerInstanceList[0].LoadBalancerInstanceNo
+
+			listenerReq := &vloadbalancer.CreateLoadBalancerListenerRequest{
+				LoadBalancerInstanceNo: ncloud.String(lbNo),
+				ProtocolTypeCode:       ncloud.String("TCP"),
+				Port:                   ncloud.Int32(80),
+				TargetGroupNo:          ncloud.String(tgNo),
+			}
+			_, err = client.CreateLoadBalancerListener(listenerReq)
+			Expect(err).To(HaveOccurred())
+
+			Eventually(func() string { return lbStatus(lbNo) }).Should(Equal(fake.LBStatusUsed))
+			_, err = client.CreateLoadBalancerListener(listenerReq)
+			Expect(err).NotTo(HaveOccurred())
+
+			listeners, err := client.GetLoadBalancerListenerList(&vloadbalancer.GetLoadBalancerListenerListRequest{
+				LoadBalancerInstanceNo: ncloud.String(lbNo),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(listeners.LoadBalancerListenerList).To(HaveLen(1))
+
+			detail, err := client.GetTargetGroupDetail(&vloadbalancer.GetTargetGroupDetailRequest{TargetGroupNo: ncloud.String(tgNo)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*detail.TargetGroupList[0].LoadBalancerInstanceNo).To(Equal(lbNo))
+		})
+
+		It("should return 1200013 for duplicate names", func() {
+			_, err := createLoadBalancer("fake-lb")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = createLoadBalancer("fake-lb")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(fake.CodeDuplicateName))
+		})
+
+		It("should return 1200059 while a target group is attached to a load balancer", func() {
+			// 삭제 중인 상태를 관찰할 수 있도록 전이에 두 번의 요청이 필요하게 설정
+			fakeAPI.ProvisioningSteps = 2
+
+			tgNo := createTargetGroup("fake-tg")
+			resp, err := createLoadBalancer("fake-lb")
+			Expect(err).NotTo(HaveOccurred())
+			lbNo := *resp.LoadBalancerInstanceList[0].LoadBalancerInstanceNo
+
+			Eventually(func() string { return lbStatus(lbNo) }).Should(Equal(fake.LBStatusUsed))
+			_, err = client.CreateLoadBalancerListener(&vloadbalancer.CreateLoadBalancerListenerRequest{
+				LoadBalancerInstanceNo: ncloud.String(lbNo),
+				ProtocolTypeCode:       ncloud.String("TCP"),
+				Port:                   ncloud.Int32(80),
+				TargetGroupNo:          ncloud.String(tgNo),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(func() string { return lbStatusName(lbNo) }).Should(Equal("Running"))
+
+			_, err = client.DeleteLoadBalancerInstances(&vloadbalancer.DeleteLoadBalancerInstancesRequest{
+				LoadBalancerInstanceNoList: []*string{ncloud.String(lbNo)},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			deleteReq := &vloadbalancer.DeleteTargetGroupsRequest{TargetGroupNoList: []*string{ncloud.String(tgNo)}}
+			_, err = client.DeleteTargetGroups(deleteReq)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(fake.CodeTargetGroupInUse))
+
+			// 로드밸런서 삭제가 완료되면 타겟 그룹을 삭제할 수 있음
+			Eventually(func() error {
+				_, err := client.DeleteTargetGroups(deleteReq)
+				return err
+			}).Should(Succeed())
+		})
+
+		It("should reject requests signed with the wrong secret", func() {
+			badClient := navercloud.NewRealClientWithAPIKey(accessKey, "wrong-secret", httpServer.URL)
+			_, err := badClient.GetLoadBalancerInstanceList(&vloadbalancer.GetLoadBalancerInstanceListRequest{})
+			Expect(err).To(HaveOccurred())
+			Expect(fakeAPI.RequestCount("getLoadBalancerInstanceList")).To(Equal(0))
+		})
+	})
+
+	Context("When driving the network and certificate APIs through the real SDK client", func() {
+		It("should keep Network ACL and ACG rules per number", func() {
+			fakeAPI.AddSubnet("6000", vpcNo, "KR-1", "10.0.100.0/24", loadBalancerSubnetUsageType, "7000")
+			fakeAPI.AddAccessControlGroup("8000")
+
+			subnets, err := client.GetSubnetList(&vpc.GetSubnetListRequest{
+				VpcNo:         ncloud.String(vpcNo),
+				UsageTypeCode: ncloud.String(loadBalancerSubnetUsageType),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(subnets.SubnetList).To(HaveLen(1))
+			Expect(*subnets.SubnetList[0].NetworkAclNo).To(Equal("7000"))
+
+			_, err = client.AddNetworkAclInboundRule(&vpc.AddNetworkAclInboundRuleRequest{
+				NetworkAclNo: ncloud.String("7000"),
+				NetworkAclRuleList: []*vpc.AddNetworkAclRuleParameter{{
+					Priority: ncloud.Int32(10), ProtocolTypeCode: ncloud.String("TCP"), IpBlock: ncloud.String("203.0.113.0/24"),
+					PortRange: ncloud.String("80"), RuleActionCode: ncloud.String("ALLOW"),
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			rules, err := client.GetNetworkAclRuleList(&vpc.GetNetworkAclRuleListRequest{NetworkAclNo: ncloud.String("7000")})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rules.NetworkAclRuleList).To(HaveLen(1))
+			Expect(*rules.NetworkAclRuleList[0].Priority).To(Equal(int32(10)))
+
+			_, err = client.AddAccessControlGroupInboundRule(&vserver.AddAccessControlGroupInboundRuleRequest{
+				AccessControlGroupNo: ncloud.String("8000"),
+				VpcNo:                ncloud.String(vpcNo),
+				AccessControlGroupRuleList: []*vserver.AddAccessControlGroupRuleParameter{{
+					ProtocolTypeCode: ncloud.String("TCP"), IpBlock: ncloud.String("10.0.100.0/24"), PortRange: ncloud.String("30080"),
+				}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			acgRules, err := client.GetAccessControlGroupRuleList(&vserver.GetAccessControlGroupRuleListRequest{AccessControlGroupNo: ncloud.String("8000")})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(acgRules.AccessControlGroupRuleList).To(HaveLen(1))
+			Expect(*acgRules.AccessControlGroupRuleList[0].ProtocolType.Code).To(Equal("TCP"))
+
+			_, err = client.GetNetworkAclRuleList(&vpc.GetNetworkAclRuleListRequest{NetworkAclNo: ncloud.String("unknown")})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should import, list and delete certificates", func() {
+			cert, err := client.ImportCertificate(&navercloud.ImportCertificateRequest{
+				CertificateName: "fake-cert", PrivateKey: "key", PublicKeyCertificate: "cert",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			certificates, err := client.GetCertificateList()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(certificates).To(ConsistOf(HaveField("CertificateName", "fake-cert")))
+
+			Expect(client.DeleteCertificate(cert.CertificateNo)).To(Succeed())
+			certificates, err = client.GetCertificateList()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(certificates).To(BeEmpty())
+		})
+
+		It("should fail loudly for APIs it does not implement", func() {
+			realClient, ok := client.(*navercloud.RealClient)
+			Expect(ok).To(BeTrue())
+			_, err := realClient.VLoadBalancerClient.V2Api.GetLoadBalancerRuleList(&vloadbalancer.GetLoadBalancerRuleListRequest{
+				LoadBalancerListenerNo: ncloud.String("1"),
+			})
+			Expect(err).To(MatchError(ContainSubstring("501")))
+		})
+	})
+
+	Context("When reconciling a Service against the fake API", func() {
+		It("should create and delete the load balancer through the SDK path", func() {
+			ctx := context.Background()
+
+			reconciler := &ServiceReconciler{
+				Client: k8sClient,
+				NaverCloudConfig: NaverCloudConfig{
+					APIKey:      accessKey,
+					APISecret:   secretKey,
+					Region:      "KR",
+					VpcNo:       vpcNo,
+					SubnetNo:    "6000",
+					APIEndpoint: httpServer.URL,
+				},
+			}
+
+			node := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-api-worker-1"},
+				Spec:       corev1.NodeSpec{ProviderID: "ncloud:///KR-1/1001"},
+			}
+			Expect(k8sClient.Create(ctx, node)).To(Succeed())
+			defer func() {
+				Expect(k8sClient.Delete(ctx, node)).To(Succeed())
+			}()
+			node.Status.Addresses = []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: "10.0.1.10"}}
+			node.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}
+			Expect(k8sClient.Status().Update(ctx, node)).To(Succeed())
+
+			service := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-api-service", Namespace: "default"},
+				Spec: corev1.ServiceSpec{
+					Type: corev1.ServiceTypeLoadBalancer,
+					Ports: []corev1.ServicePort{
+						{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080), Protocol: corev1.ProtocolTCP},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, service)).To(Succeed())
+			key := types.NamespacedName{Namespace: service.Namespace, Name: service.Name}
+			Expect(k8sClient.Get(ctx, key, service)).To(Succeed())
+
+			// 조정은 로드밸런서 상태를 기다리지 않으므로 ACTIVE가 될 때까지 반복
+			var status LoadBalancerStatus
+			Eventually(func(g Gomega) {
+				var err error
+				status, err = reconciler.reconcileNaverCloudLB(ctx, service)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(status.ProvisioningStatus).To(Equal("ACTIVE"))
+			}).Should(Succeed())
+			Expect(status.ExternalIP).To(HaveSuffix(".kr.lb.naverncp.com"))
+			Expect(fakeAPI.RequestCount("createLoadBalancerInstance")).To(Equal(1))
+			Expect(fakeAPI.RequestCount("createLoadBalancerListener")).To(Equal(1))
+			Expect(fakeAPI.RequestCount("addTarget")).To(BeNumerically(">=", 1))
+
+			latest := &corev1.Service{}
+			Expect(k8sClient.Get(ctx, key, latest)).To(Succeed())
+			Eventually(func() error {
+				return reconciler.deleteNaverCloudLB(ctx, latest)
+			}).Should(Succeed())
+
+			remaining, err := client.GetTargetGroupList(&vloadbalancer.GetTargetGroupListRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(remaining.TargetGroupList).To(BeEmpty())
+
+			Expect(k8sClient.Delete(ctx, latest)).To(Succeed())
+		})
+
+		It("should not reuse a same-named load balancer created by another cluster", func() {
+			ctx := context.Background()
+
+			reconciler := &ServiceReconciler{
+				Client: k8sClient,
+				NaverCloudConfig: NaverCloudConfig{
+					APIKey:      accessKey,
+					APISecret:   secretKey,
+					Region:      "KR",
+					VpcNo:       vpcNo,
+					SubnetNo:    "6000",
+					APIEndpoint: httpServer.URL,
+				},
+				ClusterName: "cluster-a",
+			}
+			other := &ServiceReconciler{ClusterName: "cluster-b"}
+
+			// 생성 API가 1200013을 반환하도록 같은 이름의 로드밸런서를 미리 생성
+			createNamedLoadBalancer := func(service *corev1.Service, description string) string {
+				resp, err := client.CreateLoadBalancerInstance(&vloadbalancer.CreateLoadBalancerInstanceRequest{
+					VpcNo:                   ncloud.String(vpcNo),
+					LoadBalancerName:        ncloud.String(reconciler.resourceName("k8s-lb", service, "")),
+					LoadBalancerDescription: ncloud.String(description),
+					LoadBalancerTypeCode:    ncloud.String(lbTypeNetworkProxy),
+					SubnetNoList:            []*string{ncloud.String("6000")},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				return *resp.LoadBalancerInstanceList[0].LoadBalancerInstanceNo
+			}
+
+			By("Refusing the load balancer of the same Service in another cluster")
+			foreign := newService(ctx, "fake-api-foreign", nil, 80)
+			defer cleanupService(ctx, foreign)
+			createNamedLoadBalancer(foreign, other.loadBalancerDescription(foreign))
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, foreign)
+			Expect(err).To(MatchError(ContainSubstring("이 Service가 만든 것이 아님")))
+			Expect(fakeAPI.RequestCount("createLoadBalancerInstance")).To(Equal(2))
+			nlb, err := reconciler.getNaverLoadBalancer(ctx, foreign)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Status.LoadBalancerNo).To(BeEmpty())
+
+			By("Reusing a load balancer this cluster created for the Service")
+			own := newService(ctx, "fake-api-own", nil, 80)
+			defer cleanupService(ctx, own)
+			lbNo := createNamedLoadBalancer(own, reconciler.loadBalancerDescription(own))
+
+			_, err = reconciler.reconcileNaverCloudLB(ctx, own)
+			Expect(err).NotTo(HaveOccurred())
+			nlb, err = reconciler.getNaverLoadBalancer(ctx, own)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Status.LoadBalancerNo).To(Equal(lbNo))
+		})
+	})
+})
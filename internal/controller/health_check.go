@@ -0,0 +1,213 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// 헬스 체크 어노테이션. 어노테이션 이름 뒤에 ".<포트 이름 또는 번호>"를 붙이면 해당 포트에만 적용됩니다
+// (예: naver.k-paas.org/health-check-path.https). 포트별 값이 전체 값보다 우선합니다
+const (
+	// healthCheckProtocolAnnotation은 헬스 체크 프로토콜입니다 (TCP, HTTP, HTTPS)
+	healthCheckProtocolAnnotation = "naver.k-paas.org/health-check-protocol"
+	// healthCheckPortAnnotation은 헬스 체크 포트입니다 (TCP Service 포트 이름/번호이면 해당 NodePort, 그 외에는 노드 포트 번호)
+	healthCheckPortAnnotation = "naver.k-paas.org/health-check-port"
+	// healthCheckPathAnnotation은 HTTP/HTTPS 헬스 체크 경로입니다
+	healthCheckPathAnnotation = "naver.k-paas.org/health-check-path"
+	// healthCheckHTTPMethodAnnotation은 HTTP/HTTPS 헬스 체크 메서드입니다 (GET, HEAD)
+	healthCheckHTTPMethodAnnotation = "naver.k-paas.org/health-check-http-method"
+	// healthCheckIntervalAnnotation은 헬스 체크 주기(초)입니다 (5-300)
+	healthCheckIntervalAnnotation = "naver.k-paas.org/health-check-interval"
+	// healthCheckHealthyThresholdAnnotation은 정상 판정까지의 연속 성공 횟수입니다 (2-10)
+	healthCheckHealthyThresholdAnnotation = "naver.k-paas.org/health-check-healthy-threshold"
+	// healthCheckUnhealthyThresholdAnnotation은 비정상 판정까지의 연속 실패 횟수입니다 (2-10)
+	healthCheckUnhealthyThresholdAnnotation = "naver.k-paas.org/health-check-unhealthy-threshold"
+)
+
+// healthCheckAnnotation은 포트별 어노테이션(이름, 번호 순)을 먼저 찾고 없으면 전체 어노테이션 값을 반환합니다
+func healthCheckAnnotation(service *corev1.Service, port corev1.ServicePort, annotation string) string {
+	if port.Name != "" {
+		if value, ok := service.Annotations[annotation+"."+port.Name]; ok {
+			return strings.TrimSpace(value)
+		}
+	}
+	if value, ok := service.Annotations[fmt.Sprintf("%s.%d", annotation, port.Port)]; ok {
+		return strings.TrimSpace(value)
+	}
+	return strings.TrimSpace(service.Annotations[annotation])
+}
+
+// resolveHealthCheckPort는 헬스 체크 포트 어노테이션 값을 노드 포트로 바꿉니다.
+// TCP Service 포트 이름 또는 번호이면 해당 NodePort, 그 외에는 1-65535 포트 번호를 그대로 사용합니다
+func resolveHealthCheckPort(service *corev1.Service, annotation, value string) (int32, error) {
+	number, err := strconv.ParseInt(value, 10, 32)
+	for _, candidate := range service.Spec.Ports {
+		if servicePortProtocol(candidate) != corev1.ProtocolTCP {
+			continue
+		}
+		if candidate.Name == value || (err == nil && int64(candidate.Port) == number) {
+			return candidate.NodePort, nil
+		}
+	}
+	if err != nil || number < 1 || number > 65535 {
+		return 0, fmt.Errorf("%s 어노테이션 값이 올바르지 않음: %q (TCP Service 포트 이름 또는 1-65535 포트 번호)", annotation, value)
+	}
+	return int32(number), nil
+}
+
+// parseHealthCheckNumber는 범위가 정해진 숫자 헬스 체크 어노테이션을 읽습니다 (비어 있으면 0)
+func parseHealthCheckNumber(service *corev1.Service, port corev1.ServicePort, annotation string, minValue, maxValue int32) (int32, error) {
+	value := healthCheckAnnotation(service, port, annotation)
+	if value == "" {
+		return 0, nil
+	}
+	number, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || int32(number) < minValue || int32(number) > maxValue {
+		return 0, fmt.Errorf("%s 어노테이션 값이 올바르지 않음: %q (%d-%d)", annotation, value, minValue, maxValue)
+	}
+	return int32(number), nil
+}
+
+// resolveHealthCheck는 기본 헬스 체크 설정에 어노테이션을 적용합니다.
+// externalTrafficPolicy: Local이면 kube-proxy 헬스 체크를 유지하기 위해 주기와 임계값만 적용합니다
+func resolveHealthCheck(service *corev1.Service, lbType string, port corev1.ServicePort) (healthCheckConfig, error) {
+	config := defaultHealthCheck(service, lbType, port)
+
+	if !isLocalTrafficPolicy(service) || service.Spec.HealthCheckNodePort == 0 {
+		if value := healthCheckAnnotation(service, port, healthCheckProtocolAnnotation); value != "" {
+			protocol := strings.ToUpper(value)
+			switch {
+			case protocol != "TCP" && protocol != "HTTP" && protocol != "HTTPS":
+				return config, fmt.Errorf("지원하지 않는 헬스 체크 프로토콜: %s (TCP, HTTP, HTTPS 중 선택)", value)
+			case servicePortProtocol(port) == corev1.ProtocolUDP && protocol != "TCP":
+				return config, fmt.Errorf("UDP 포트 %d는 TCP 헬스 체크만 지원함: %s", port.Port, protocol)
+			case lbType == lbTypeApplication && protocol == "TCP":
+				return config, fmt.Errorf("APPLICATION 로드밸런서는 HTTP/HTTPS 헬스 체크만 지원함: port %d", port.Port)
+			}
+			config.Protocol = protocol
+			if protocol == "TCP" {
+				config.URLPath, config.HTTPMethod = "", ""
+			} else if config.URLPath == "" {
+				config.URLPath, config.HTTPMethod = "/", "GET"
+			}
+		}
+		if value := healthCheckAnnotation(service, port, healthCheckPortAnnotation); value != "" {
+			healthCheckPort, err := resolveHealthCheckPort(service, healthCheckPortAnnotation, value)
+			if err != nil {
+				return config, err
+			}
+			config.Port = healthCheckPort
+		}
+		// 경로와 메서드는 HTTP/HTTPS 헬스 체크에만 적용
+		if config.Protocol != "TCP" {
+			if value := healthCheckAnnotation(service, port, healthCheckPathAnnotation); value != "" {
+				if !strings.HasPrefix(value, "/") {
+					return config, fmt.Errorf("%s 어노테이션 값은 /로 시작해야 함: %q", healthCheckPathAnnotation, value)
+				}
+				config.URLPath = value
+			}
+			if value := healthCheckAnnotation(service, port, healthCheckHTTPMethodAnnotation); value != "" {
+				method := strings.ToUpper(value)
+				if method != "GET" && method != "HEAD" {
+					return config, fmt.Errorf("지원하지 않는 헬스 체크 메서드: %s (GET, HEAD 중 선택)", value)
+				}
+				config.HTTPMethod = method
+			}
+		}
+	}
+
+	var err error
+	if config.Interval, err = parseHealthCheckNumber(service, port, healthCheckIntervalAnnotation, 5, 300); err != nil {
+		return config, err
+	}
+	if config.HealthyThreshold, err = parseHealthCheckNumber(service, port, healthCheckHealthyThresholdAnnotation, 2, 10); err != nil {
+		return config, err
+	}
+	if config.UnhealthyThreshold, err = parseHealthCheckNumber(service, port, healthCheckUnhealthyThresholdAnnotation, 2, 10); err != nil {
+		return config, err
+	}
+	return config, nil
+}
+
+// validateHealthCheckAnnotations는 모든 포트의 헬스 체크 어노테이션이 올바른지 확인합니다
+func validateHealthCheckAnnotations(service *corev1.Service, lbType string) error {
+	for _, port := range service.Spec.Ports {
+		if _, err := resolveHealthCheck(service, lbType, port); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncTargetGroupHealthCheck는 기존 타겟 그룹의 헬스 체크 설정을 원하는 설정으로 변경합니다.
+// 헬스 체크 프로토콜은 변경할 수 없으므로 프로토콜이 다르면 타겟 그룹을 새로 만들도록 true를 반환합니다
+func (r *ServiceReconciler) syncTargetGroupHealthCheck(ctx context.Context, client NaverCloudClient, credentials *NaverCloudCredentials, current *vloadbalancer.TargetGroup, desired healthCheckConfig) (bool, error) {
+	if current.HealthCheckProtocolType != nil {
+		if protocol := ncloud.StringValue(current.HealthCheckProtocolType.Code); protocol != "" && protocol != desired.Protocol {
+			return true, nil
+		}
+	}
+
+	var currentMethod string
+	if current.HealthCheckHttpMethodType != nil {
+		currentMethod = ncloud.StringValue(current.HealthCheckHttpMethodType.Code)
+	}
+	changed := ncloud.Int32Value(current.HealthCheckPort) != desired.Port ||
+		(desired.URLPath != "" && ncloud.StringValue(current.HealthCheckUrlPath) != desired.URLPath) ||
+		(desired.HTTPMethod != "" && currentMethod != desired.HTTPMethod) ||
+		(desired.Interval != 0 && ncloud.Int32Value(current.HealthCheckCycle) != desired.Interval) ||
+		(desired.HealthyThreshold != 0 && ncloud.Int32Value(current.HealthCheckUpThreshold) != desired.HealthyThreshold) ||
+		(desired.UnhealthyThreshold != 0 && ncloud.Int32Value(current.HealthCheckDownThreshold) != desired.UnhealthyThreshold)
+	if !changed {
+		return false, nil
+	}
+
+	// 지정하지 않은 값은 현재 설정을 그대로 보냄
+	req := &vloadbalancer.ChangeTargetGroupHealthCheckConfigurationRequest{
+		RegionCode:               ncloud.String(credentials.Region),
+		TargetGroupNo:            current.TargetGroupNo,
+		HealthCheckPort:          ncloud.Int32(desired.Port),
+		HealthCheckCycle:         current.HealthCheckCycle,
+		HealthCheckUpThreshold:   current.HealthCheckUpThreshold,
+		HealthCheckDownThreshold: current.HealthCheckDownThreshold,
+	}
+	if desired.URLPath != "" {
+		req.HealthCheckUrlPath = ncloud.String(desired.URLPath)
+		req.HealthCheckHttpMethodTypeCode = ncloud.String(desired.HTTPMethod)
+	}
+	if desired.Interval != 0 {
+		req.HealthCheckCycle = ncloud.Int32(desired.Interval)
+	}
+	if desired.HealthyThreshold != 0 {
+		req.HealthCheckUpThreshold = ncloud.Int32(desired.HealthyThreshold)
+	}
+	if desired.UnhealthyThreshold != 0 {
+		req.HealthCheckDownThreshold = ncloud.Int32(desired.UnhealthyThreshold)
+	}
+	if _, err := client.ChangeTargetGroupHealthCheckConfiguration(req); err != nil {
+		return false, fmt.Errorf("타겟 그룹 헬스 체크 설정 변경 실패: %w", err)
+	}
+	return false, nil
+}
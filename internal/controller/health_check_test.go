@@ -0,0 +1,194 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("Health Check Tests", func() {
+	var (
+		reconciler *ServiceReconciler
+		mockClient *navercloud.MockClient
+		ctx        context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockClient = navercloud.NewMockClient()
+
+		reconciler = &ServiceReconciler{
+			Client: k8sClient,
+			NaverCloudConfig: NaverCloudConfig{
+				APIKey:    "test-api-key",
+				APISecret: "test-api-secret",
+				Region:    "KR",
+				VpcNo:     "vpc-12345",
+				SubnetNo:  "subnet-67890",
+			},
+			NaverClient: mockClient,
+		}
+	})
+
+	Context("When reading the health check annotations", func() {
+		http := corev1.ServicePort{Name: "http", Port: 80, NodePort: 30080, Protocol: corev1.ProtocolTCP}
+		admin := corev1.ServicePort{Name: "admin", Port: 8080, NodePort: 30880, Protocol: corev1.ProtocolTCP}
+		dns := corev1.ServicePort{Name: "dns", Port: 53, NodePort: 30053, Protocol: corev1.ProtocolUDP}
+		service := func(annotations map[string]string) *corev1.Service {
+			return &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+				Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{http, admin, dns}},
+			}
+		}
+
+		It("should apply per-port annotations over the global ones", func() {
+			svc := service(map[string]string{
+				healthCheckProtocolAnnotation:           "http",
+				healthCheckPathAnnotation:               "/healthz",
+				healthCheckIntervalAnnotation:           "10",
+				healthCheckHealthyThresholdAnnotation:   "3",
+				healthCheckUnhealthyThresholdAnnotation: "4",
+				healthCheckPathAnnotation + ".admin":    "/ready",
+				healthCheckPortAnnotation + ".8080":     "http",
+				healthCheckProtocolAnnotation + ".dns":  "TCP",
+			})
+
+			config, err := resolveHealthCheck(svc, lbTypeNetwork, http)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config).To(Equal(healthCheckConfig{
+				Protocol: "HTTP", Port: 30080, URLPath: "/healthz", HTTPMethod: "GET",
+				Interval: 10, HealthyThreshold: 3, UnhealthyThreshold: 4,
+			}))
+
+			config, err = resolveHealthCheck(svc, lbTypeNetwork, admin)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.URLPath).To(Equal("/ready"))
+			Expect(config.Port).To(Equal(int32(30080)))
+			Expect(healthCheckPortForPort(svc, admin)).To(Equal(int32(30080)))
+
+			config, err = resolveHealthCheck(svc, lbTypeNetwork, dns)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Protocol).To(Equal("TCP"))
+			Expect(config.URLPath).To(BeEmpty())
+			Expect(validateHealthCheckAnnotations(svc, lbTypeNetwork)).To(Succeed())
+		})
+
+		It("should keep the kube-proxy health check for Local services", func() {
+			svc := service(map[string]string{
+				healthCheckProtocolAnnotation: "TCP",
+				healthCheckPathAnnotation:     "/custom",
+				healthCheckIntervalAnnotation: "15",
+			})
+			svc.Spec.ExternalTrafficPolicy = corev1.ServiceExternalTrafficPolicyLocal
+			svc.Spec.HealthCheckNodePort = 32000
+
+			config, err := resolveHealthCheck(svc, lbTypeNetworkProxy, http)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config).To(Equal(healthCheckConfig{
+				Protocol: "HTTP", Port: 32000, URLPath: localHealthCheckPath, HTTPMethod: "GET", Interval: 15,
+			}))
+		})
+
+		It("should reject invalid health check settings", func() {
+			invalid := []struct {
+				lbType      string
+				annotations map[string]string
+			}{
+				{lbTypeNetworkProxy, map[string]string{healthCheckProtocolAnnotation: "UDP"}},
+				{lbTypeNetwork, map[string]string{healthCheckProtocolAnnotation: "HTTP"}},
+				{lbTypeApplication, map[string]string{healthCheckProtocolAnnotation + ".http": "TCP"}},
+				{lbTypeNetworkProxy, map[string]string{healthCheckPortAnnotation: "metrics"}},
+				{lbTypeApplication, map[string]string{healthCheckPathAnnotation: "healthz"}},
+				{lbTypeApplication, map[string]string{healthCheckHTTPMethodAnnotation: "POST"}},
+				{lbTypeNetworkProxy, map[string]string{healthCheckIntervalAnnotation: "1"}},
+				{lbTypeNetworkProxy, map[string]string{healthCheckHealthyThresholdAnnotation: "11"}},
+				{lbTypeNetworkProxy, map[string]string{healthCheckUnhealthyThresholdAnnotation: "x"}},
+			}
+			for _, tc := range invalid {
+				svc := service(tc.annotations)
+				svc.Spec.Ports = []corev1.ServicePort{http, dns}
+				Expect(validateHealthCheckAnnotations(svc, tc.lbType)).NotTo(Succeed(), "annotations: %v", tc.annotations)
+			}
+		})
+	})
+
+	Context("When the health check annotations change", func() {
+		It("should update the target group in place and replace it only for a new protocol", func() {
+			service := newService(ctx, "health-check-service", map[string]string{
+				healthCheckProtocolAnnotation: "HTTP",
+				healthCheckPathAnnotation:     "/healthz",
+				healthCheckIntervalAnnotation: "10",
+			}, 80)
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.TargetGroups).To(HaveLen(1))
+			tg := mockClient.TargetGroups[0]
+			Expect(ncloud.StringValue(tg.HealthCheckProtocolType.Code)).To(Equal("HTTP"))
+			Expect(ncloud.StringValue(tg.HealthCheckUrlPath)).To(Equal("/healthz"))
+			Expect(ncloud.Int32Value(tg.HealthCheckCycle)).To(Equal(int32(10)))
+
+			By("Leaving an unchanged target group alone")
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.ChangeHealthCheckCalled).To(Equal(0))
+
+			By("Changing the path and thresholds through the health check API")
+			service.Annotations[healthCheckPathAnnotation+".port-80"] = "/ready"
+			service.Annotations[healthCheckUnhealthyThresholdAnnotation] = "5"
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.ChangeHealthCheckCalled).To(Equal(1))
+			Expect(mockClient.CreateTGCalled).To(Equal(1))
+			tg = mockClient.TargetGroups[0]
+			Expect(ncloud.StringValue(tg.HealthCheckUrlPath)).To(Equal("/ready"))
+			Expect(ncloud.Int32Value(tg.HealthCheckDownThreshold)).To(Equal(int32(5)))
+			Expect(ncloud.Int32Value(tg.HealthCheckCycle)).To(Equal(int32(10)))
+
+			By("Replacing the target group when the protocol changes")
+			service.Annotations[healthCheckProtocolAnnotation] = "TCP"
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.CreateTGCalled).To(Equal(2))
+			Expect(mockClient.DeleteTGCalled).To(Equal(1))
+			Expect(mockClient.TargetGroups).To(HaveLen(1))
+			Expect(ncloud.StringValue(mockClient.TargetGroups[0].HealthCheckProtocolType.Code)).To(Equal("TCP"))
+		})
+
+		It("should record an Event for invalid health check annotations", func() {
+			recorder := record.NewFakeRecorder(10)
+			reconciler.Recorder = recorder
+
+			service := newService(ctx, "health-check-invalid-service", map[string]string{healthCheckIntervalAnnotation: "1000"}, 80)
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).To(HaveOccurred())
+			Expect(mockClient.CreateTGCalled).To(Equal(0))
+			Expect(recorder.Events).To(Receive(ContainSubstring("InvalidHealthCheck")))
+		})
+	})
+})
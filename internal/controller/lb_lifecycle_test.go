@@ -0,0 +1,196 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	naverv1alpha1 "github.com/suslmk-lee/kube-controller01/api/v1alpha1"
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ = Describe("LoadBalancer Lifecycle Tests", func() {
+	var (
+		reconciler *ServiceReconciler
+		mockClient *navercloud.MockClient
+		ctx        context.Context
+		node       *corev1.Node
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockClient = navercloud.NewMockClient()
+
+		reconciler = &ServiceReconciler{
+			Client: k8sClient,
+			NaverCloudConfig: NaverCloudConfig{
+				APIKey:    "test-api-key",
+				APISecret: "test-api-secret",
+				Region:    "KR",
+				VpcNo:     "vpc-12345",
+				SubnetNo:  "subnet-67890",
+			},
+			NaverClient: mockClient,
+		}
+
+		By("Creating a worker node registered as a Naver Cloud instance")
+		node = &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "lifecycle-worker-1",
+			},
+			Spec: corev1.NodeSpec{
+				ProviderID: "ncloud:///KR-1/1001",
+			},
+		}
+		Expect(k8sClient.Create(ctx, node)).To(Succeed())
+
+		node.Status.Addresses = []corev1.NodeAddress{
+			{Type: corev1.NodeInternalIP, Address: "10.0.1.10"},
+		}
+		node.Status.Conditions = []corev1.NodeCondition{
+			{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+		}
+		Expect(k8sClient.Status().Update(ctx, node)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, node)).To(Succeed())
+	})
+
+	Context("When running a full create/delete cycle against the mock client", func() {
+		It("should route every cloud call through the injected client", func() {
+			By("Creating a LoadBalancer service")
+			service := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "lifecycle-service",
+					Namespace: "default",
+				},
+				Spec: corev1.ServiceSpec{
+					Type: corev1.ServiceTypeLoadBalancer,
+					Ports: []corev1.ServicePort{
+						{
+							Name:       "http",
+							Port:       80,
+							TargetPort: intstr.FromInt(8080),
+							Protocol:   corev1.ProtocolTCP,
+						},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, service)).To(Succeed())
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: service.Namespace, Name: service.Name}, service)).To(Succeed())
+
+			By("Reconciling the Naver Cloud load balancer")
+			lbStatus, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lbStatus.ProvisioningStatus).To(Equal("ACTIVE"))
+			Expect(lbStatus.LBID).To(Equal("lb-12345"))
+			Expect(lbStatus.ExternalIP).To(Equal("lb-12345.mock.ncloud.com"))
+
+			Expect(mockClient.CreateTGCalled).To(Equal(1))
+			Expect(mockClient.CreateLBCalled).To(Equal(1))
+			Expect(mockClient.CreateListenerCalled).To(Equal(1))
+			Expect(mockClient.AddTargetCalled).To(BeNumerically(">=", 1))
+			Expect(mockClient.Targets["tg-12345"]).To(ConsistOf("1001"))
+
+			By("Checking the NaverLoadBalancer status")
+			key := types.NamespacedName{Namespace: service.Namespace, Name: service.Name}
+			latest := &corev1.Service{}
+			Expect(k8sClient.Get(ctx, key, latest)).To(Succeed())
+			Expect(latest.Annotations["naver.k-paas.org/lb-id"]).To(Equal("lb-12345"))
+			Expect(latest.Annotations).NotTo(HaveKey("naver.k-paas.org/target-groups"))
+
+			nlb := &naverv1alpha1.NaverLoadBalancer{}
+			Expect(k8sClient.Get(ctx, key, nlb)).To(Succeed())
+			Expect(nlb.Status.LoadBalancerNo).To(Equal("lb-12345"))
+			Expect(nlb.Status.TargetGroups).To(ConsistOf(HaveField("TargetGroupNo", "tg-12345")))
+			Expect(nlb.Status.Listeners).To(ConsistOf(naverv1alpha1.ListenerStatus{
+				Port: 80, Protocol: "TCP", ListenerNo: "listener-12345", TargetGroupNo: "tg-12345",
+			}))
+			Expect(nlb.Status.Domain).To(Equal("lb-12345.mock.ncloud.com"))
+			Expect(nlb.Status.ObservedGeneration).To(Equal(nlb.Generation))
+			Expect(nlb.Status.Phase).To(Equal(naverv1alpha1.PhaseReady))
+			Expect(nlb.Status.PhaseTransitionTime).NotTo(BeNil())
+			Expect(meta.IsStatusConditionTrue(nlb.Status.Conditions, naverv1alpha1.ConditionReady)).To(BeTrue())
+
+			By("Deleting the Naver Cloud load balancer")
+			Expect(reconciler.deleteNaverCloudLB(ctx, latest)).To(Succeed())
+			Expect(mockClient.DeleteLBCalled).To(Equal(1))
+			Expect(mockClient.DeleteTGCalled).To(Equal(1))
+			Expect(mockClient.TargetGroups).To(BeEmpty())
+			Expect(mockClient.Listeners).To(BeEmpty())
+			Expect(errors.IsNotFound(k8sClient.Get(ctx, key, &naverv1alpha1.NaverLoadBalancer{}))).To(BeTrue())
+
+			// Cleanup
+			Expect(k8sClient.Delete(ctx, latest)).To(Succeed())
+		})
+
+		It("should requeue instead of waiting while the load balancer is changing", func() {
+			service := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "lifecycle-busy-service",
+					Namespace: "default",
+				},
+				Spec: corev1.ServiceSpec{
+					Type: corev1.ServiceTypeLoadBalancer,
+					Ports: []corev1.ServicePort{
+						{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080), Protocol: corev1.ProtocolTCP},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, service)).To(Succeed())
+			defer cleanupService(ctx, service)
+			key := types.NamespacedName{Namespace: service.Namespace, Name: service.Name}
+			Expect(k8sClient.Get(ctx, key, service)).To(Succeed())
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Adding a port while the load balancer is changing")
+			mockClient.LoadBalancers[0].LoadBalancerInstanceStatus.CodeName = ncloud.String("Changing")
+			Expect(k8sClient.Get(ctx, key, service)).To(Succeed())
+			service.Spec.Ports = append(service.Spec.Ports, corev1.ServicePort{
+				Name: "https", Port: 443, TargetPort: intstr.FromInt(8443), Protocol: corev1.ProtocolTCP,
+			})
+			Expect(k8sClient.Update(ctx, service)).To(Succeed())
+
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(isLoadBalancerBusy(err)).To(BeTrue())
+			Expect(mockClient.CreateListenerCalled).To(Equal(1))
+
+			By("Creating the listener once the load balancer is running again")
+			mockClient.LoadBalancers[0].LoadBalancerInstanceStatus.CodeName = ncloud.String("Running")
+			lbStatus, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lbStatus.ProvisioningStatus).To(Equal("ACTIVE"))
+			Expect(mockClient.CreateListenerCalled).To(Equal(2))
+
+			nlb := &naverv1alpha1.NaverLoadBalancer{}
+			Expect(k8sClient.Get(ctx, key, nlb)).To(Succeed())
+			Expect(nlb.Status.Phase).To(Equal(naverv1alpha1.PhaseReady))
+		})
+	})
+})
@@ -0,0 +1,222 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// 로드밸런서 동작 어노테이션. 지정하지 않은 값은 생성 시 네이버 클라우드 기본값을 쓰고 이후에는 현재 설정을 유지합니다
+const (
+	// lbAlgorithmAnnotation은 타겟 그룹 부하 분산 알고리즘입니다 (RR, LC, SIPHS, NETWORK 로드밸런서는 MH도 가능)
+	lbAlgorithmAnnotation = "naver.k-paas.org/lb-algorithm"
+	// lbIdleTimeoutAnnotation은 로드밸런서 유휴 타임아웃(초)입니다 (1-3600, NETWORK 로드밸런서는 지원하지 않음)
+	lbIdleTimeoutAnnotation = "naver.k-paas.org/lb-idle-timeout"
+	// lbThroughputTypeAnnotation은 로드밸런서 처리량 타입입니다 (SMALL, MEDIUM, LARGE, NETWORK 로드밸런서는 지원하지 않음)
+	lbThroughputTypeAnnotation = "naver.k-paas.org/lb-throughput-type"
+	// stickySessionAnnotation은 타겟 그룹 세션 유지 여부입니다 (true, false, APPLICATION 로드밸런서만 지원)
+	stickySessionAnnotation = "naver.k-paas.org/sticky-session"
+	// proxyProtocolAnnotation은 PROXY_TCP 타겟 그룹의 Proxy Protocol 사용 여부입니다
+	// (true, false, NETWORK_PROXY 로드밸런서만 지원, 헤더를 해석하지 못하는 백엔드를 위해 기본값 false)
+	proxyProtocolAnnotation = "naver.k-paas.org/proxy-protocol"
+)
+
+// loadBalancerSettings는 어노테이션으로 지정한 로드밸런서와 타겟 그룹 동작 설정입니다 (빈 값은 지정하지 않음)
+type loadBalancerSettings struct {
+	Algorithm      string
+	StickySession  *bool
+	ProxyProtocol  *bool // NETWORK_PROXY 로드밸런서는 어노테이션이 없어도 false로 설정
+	IdleTimeout    int32
+	ThroughputType string
+}
+
+// parseLoadBalancerSettings는 Service 어노테이션에서 로드밸런서 동작 설정을 읽고 로드밸런서 타입에 맞는지 확인합니다
+func parseLoadBalancerSettings(service *corev1.Service, lbType string) (*loadBalancerSettings, error) {
+	settings := &loadBalancerSettings{}
+
+	if value := strings.ToUpper(strings.TrimSpace(service.Annotations[lbAlgorithmAnnotation])); value != "" {
+		switch {
+		case value == "MH" && lbType != lbTypeNetwork:
+			return nil, fmt.Errorf("MH 알고리즘은 NETWORK 로드밸런서만 지원함: %s", lbType)
+		case value != "RR" && value != "LC" && value != "SIPHS" && value != "MH":
+			return nil, fmt.Errorf("지원하지 않는 부하 분산 알고리즘: %s (RR, LC, SIPHS 중 선택)", value)
+		}
+		settings.Algorithm = value
+	}
+
+	if value := strings.TrimSpace(service.Annotations[lbIdleTimeoutAnnotation]); value != "" {
+		if lbType == lbTypeNetwork {
+			return nil, fmt.Errorf("NETWORK 로드밸런서는 유휴 타임아웃을 지원하지 않음")
+		}
+		number, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || number < 1 || number > 3600 {
+			return nil, fmt.Errorf("%s 어노테이션 값이 올바르지 않음: %q (1-3600)", lbIdleTimeoutAnnotation, value)
+		}
+		settings.IdleTimeout = int32(number)
+	}
+
+	if value := strings.ToUpper(strings.TrimSpace(service.Annotations[lbThroughputTypeAnnotation])); value != "" {
+		switch {
+		case lbType == lbTypeNetwork:
+			return nil, fmt.Errorf("NETWORK 로드밸런서는 처리량 타입을 지원하지 않음")
+		case value != "SMALL" && value != "MEDIUM" && value != "LARGE":
+			return nil, fmt.Errorf("지원하지 않는 처리량 타입: %s (SMALL, MEDIUM, LARGE 중 선택)", value)
+		}
+		settings.ThroughputType = value
+	}
+
+	if value := strings.TrimSpace(service.Annotations[stickySessionAnnotation]); value != "" {
+		sticky, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s 어노테이션 값이 올바르지 않음: %q (true, false)", stickySessionAnnotation, value)
+		}
+		if sticky && lbType != lbTypeApplication {
+			return nil, fmt.Errorf("세션 유지는 APPLICATION 로드밸런서만 지원함: %s", lbType)
+		}
+		settings.StickySession = ncloud.Bool(sticky)
+	}
+
+	proxyProtocol := false
+	if value := strings.TrimSpace(service.Annotations[proxyProtocolAnnotation]); value != "" {
+		var err error
+		if proxyProtocol, err = strconv.ParseBool(value); err != nil {
+			return nil, fmt.Errorf("%s 어노테이션 값이 올바르지 않음: %q (true, false)", proxyProtocolAnnotation, value)
+		}
+		if proxyProtocol && lbType != lbTypeNetworkProxy {
+			return nil, fmt.Errorf("Proxy Protocol은 NETWORK_PROXY 로드밸런서만 지원함: %s", lbType)
+		}
+	}
+	if lbType == lbTypeNetworkProxy {
+		settings.ProxyProtocol = ncloud.Bool(proxyProtocol)
+	}
+
+	return settings, nil
+}
+
+// applyToLoadBalancer는 로드밸런서 생성 요청에 유휴 타임아웃과 처리량 타입을 설정합니다
+func (s *loadBalancerSettings) applyToLoadBalancer(req *vloadbalancer.CreateLoadBalancerInstanceRequest) {
+	if s.IdleTimeout != 0 {
+		req.IdleTimeout = ncloud.Int32(s.IdleTimeout)
+	}
+	if s.ThroughputType != "" {
+		req.ThroughputTypeCode = ncloud.String(s.ThroughputType)
+	}
+}
+
+// syncLoadBalancerConfiguration은 기존 로드밸런서의 유휴 타임아웃과 처리량 타입을 원하는 설정으로 변경합니다
+func (r *ServiceReconciler) syncLoadBalancerConfiguration(ctx context.Context, client NaverCloudClient, credentials *NaverCloudCredentials, lbID string, settings *loadBalancerSettings) error {
+	if settings.IdleTimeout == 0 && settings.ThroughputType == "" {
+		return nil
+	}
+	logger := log.FromContext(ctx).WithValues("lb-id", lbID)
+
+	detailResp, err := client.GetLoadBalancerInstanceDetail(&vloadbalancer.GetLoadBalancerInstanceDetailRequest{
+		RegionCode:             ncloud.String(credentials.Region),
+		LoadBalancerInstanceNo: ncloud.String(lbID),
+	})
+	if err != nil {
+		return fmt.Errorf("로드밸런서 상세 정보 조회 실패: %w", err)
+	}
+	if detailResp == nil || len(detailResp.LoadBalancerInstanceList) == 0 {
+		return nil
+	}
+	current := detailResp.LoadBalancerInstanceList[0]
+
+	var currentThroughput string
+	if current.ThroughputType != nil {
+		currentThroughput = ncloud.StringValue(current.ThroughputType.Code)
+	}
+	if (settings.IdleTimeout == 0 || ncloud.Int32Value(current.IdleTimeout) == settings.IdleTimeout) &&
+		(settings.ThroughputType == "" || currentThroughput == settings.ThroughputType) {
+		return nil
+	}
+
+	// 지정하지 않은 값은 현재 설정을 그대로 보냄
+	req := &vloadbalancer.ChangeLoadBalancerInstanceConfigurationRequest{
+		RegionCode:             ncloud.String(credentials.Region),
+		LoadBalancerInstanceNo: ncloud.String(lbID),
+		IdleTimeout:            current.IdleTimeout,
+	}
+	if currentThroughput != "" {
+		req.ThroughputTypeCode = ncloud.String(currentThroughput)
+	}
+	if settings.IdleTimeout != 0 {
+		req.IdleTimeout = ncloud.Int32(settings.IdleTimeout)
+	}
+	if settings.ThroughputType != "" {
+		req.ThroughputTypeCode = ncloud.String(settings.ThroughputType)
+	}
+
+	if err := r.checkLoadBalancerReady(client, credentials, lbID); err != nil {
+		return err
+	}
+	if _, err := client.ChangeLoadBalancerInstanceConfiguration(req); err != nil {
+		return fmt.Errorf("로드밸런서 설정 변경 실패: %w", err)
+	}
+	logger.Info("로드밸런서 설정 변경 성공", "idleTimeout", ncloud.Int32Value(req.IdleTimeout), "throughputType", ncloud.StringValue(req.ThroughputTypeCode))
+	return nil
+}
+
+// syncTargetGroupConfiguration은 기존 타겟 그룹의 알고리즘, 세션 유지, Proxy Protocol 설정을 원하는 설정으로 변경합니다
+func (r *ServiceReconciler) syncTargetGroupConfiguration(ctx context.Context, client NaverCloudClient, credentials *NaverCloudCredentials, current *vloadbalancer.TargetGroup, settings *loadBalancerSettings) error {
+	var currentAlgorithm string
+	if current.AlgorithmType != nil {
+		currentAlgorithm = ncloud.StringValue(current.AlgorithmType.Code)
+	}
+	currentSticky := ncloud.BoolValue(current.UseStickySession)
+	currentProxyProtocol := ncloud.BoolValue(current.UseProxyProtocol)
+	if (settings.Algorithm == "" || currentAlgorithm == settings.Algorithm) &&
+		(settings.StickySession == nil || currentSticky == *settings.StickySession) &&
+		(settings.ProxyProtocol == nil || currentProxyProtocol == *settings.ProxyProtocol) {
+		return nil
+	}
+
+	// 지정하지 않은 값은 현재 설정을 그대로 보냄
+	req := &vloadbalancer.ChangeTargetGroupConfigurationRequest{
+		RegionCode:       ncloud.String(credentials.Region),
+		TargetGroupNo:    current.TargetGroupNo,
+		UseStickySession: ncloud.Bool(currentSticky),
+		UseProxyProtocol: ncloud.Bool(currentProxyProtocol),
+	}
+	if currentAlgorithm != "" {
+		req.AlgorithmTypeCode = ncloud.String(currentAlgorithm)
+	}
+	if settings.Algorithm != "" {
+		req.AlgorithmTypeCode = ncloud.String(settings.Algorithm)
+	}
+	if settings.StickySession != nil {
+		req.UseStickySession = settings.StickySession
+	}
+	if settings.ProxyProtocol != nil {
+		req.UseProxyProtocol = settings.ProxyProtocol
+	}
+	if _, err := client.ChangeTargetGroupConfiguration(req); err != nil {
+		return fmt.Errorf("타겟 그룹 설정 변경 실패: %w", err)
+	}
+	log.FromContext(ctx).Info("타겟 그룹 설정 변경 성공", "targetGroupID", ncloud.StringValue(current.TargetGroupNo),
+		"algorithm", ncloud.StringValue(req.AlgorithmTypeCode), "stickySession", ncloud.BoolValue(req.UseStickySession),
+		"proxyProtocol", ncloud.BoolValue(req.UseProxyProtocol))
+	return nil
+}
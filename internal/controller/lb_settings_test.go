@@ -0,0 +1,187 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("Load Balancer Settings Tests", func() {
+	var (
+		reconciler *ServiceReconciler
+		mockClient *navercloud.MockClient
+		ctx        context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockClient = navercloud.NewMockClient()
+
+		reconciler = &ServiceReconciler{
+			Client: k8sClient,
+			NaverCloudConfig: NaverCloudConfig{
+				APIKey:    "test-api-key",
+				APISecret: "test-api-secret",
+				Region:    "KR",
+				VpcNo:     "vpc-12345",
+				SubnetNo:  "subnet-67890",
+			},
+			NaverClient: mockClient,
+		}
+	})
+
+	Context("When reading the load balancer setting annotations", func() {
+		service := func(annotations map[string]string) *corev1.Service {
+			return &corev1.Service{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}}
+		}
+
+		It("should parse the settings supported by the load balancer type", func() {
+			settings, err := parseLoadBalancerSettings(service(map[string]string{
+				lbAlgorithmAnnotation:      "lc",
+				lbIdleTimeoutAnnotation:    "300",
+				lbThroughputTypeAnnotation: "medium",
+				stickySessionAnnotation:    "true",
+			}), lbTypeApplication)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(settings).To(Equal(&loadBalancerSettings{
+				Algorithm: "LC", StickySession: ncloud.Bool(true), IdleTimeout: 300, ThroughputType: "MEDIUM",
+			}))
+
+			settings, err = parseLoadBalancerSettings(service(nil), lbTypeNetwork)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(settings).To(Equal(&loadBalancerSettings{}))
+		})
+
+		It("should keep Proxy Protocol disabled unless requested on NETWORK_PROXY", func() {
+			settings, err := parseLoadBalancerSettings(service(nil), lbTypeNetworkProxy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(settings.ProxyProtocol).To(Equal(ncloud.Bool(false)))
+
+			settings, err = parseLoadBalancerSettings(service(map[string]string{proxyProtocolAnnotation: "true"}), lbTypeNetworkProxy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(settings.ProxyProtocol).To(Equal(ncloud.Bool(true)))
+
+			settings, err = parseLoadBalancerSettings(service(map[string]string{proxyProtocolAnnotation: "false"}), lbTypeApplication)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(settings.ProxyProtocol).To(BeNil())
+		})
+
+		It("should reject invalid or unsupported settings", func() {
+			invalid := []struct {
+				lbType      string
+				annotations map[string]string
+			}{
+				{lbTypeNetworkProxy, map[string]string{lbAlgorithmAnnotation: "WRR"}},
+				{lbTypeNetworkProxy, map[string]string{lbAlgorithmAnnotation: "MH"}},
+				{lbTypeNetworkProxy, map[string]string{lbIdleTimeoutAnnotation: "0"}},
+				{lbTypeNetwork, map[string]string{lbIdleTimeoutAnnotation: "60"}},
+				{lbTypeApplication, map[string]string{lbThroughputTypeAnnotation: "HUGE"}},
+				{lbTypeNetwork, map[string]string{lbThroughputTypeAnnotation: "SMALL"}},
+				{lbTypeApplication, map[string]string{stickySessionAnnotation: "yes"}},
+				{lbTypeNetworkProxy, map[string]string{stickySessionAnnotation: "true"}},
+				{lbTypeNetworkProxy, map[string]string{proxyProtocolAnnotation: "v2"}},
+				{lbTypeNetwork, map[string]string{proxyProtocolAnnotation: "true"}},
+			}
+			for _, tc := range invalid {
+				_, err := parseLoadBalancerSettings(service(tc.annotations), tc.lbType)
+				Expect(err).To(HaveOccurred(), "annotations: %v", tc.annotations)
+			}
+		})
+	})
+
+	Context("When the load balancer setting annotations change", func() {
+		It("should create with the settings and change them on the existing load balancer", func() {
+			service := newService(ctx, "lb-settings-service", map[string]string{
+				lbTypeAnnotation:        "application",
+				lbAlgorithmAnnotation:   "SIPHS",
+				lbIdleTimeoutAnnotation: "120",
+			}, 80)
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ncloud.Int32Value(mockClient.LoadBalancers[0].IdleTimeout)).To(Equal(int32(120)))
+			Expect(ncloud.StringValue(mockClient.TargetGroups[0].AlgorithmType.Code)).To(Equal("SIPHS"))
+			// 타겟 그룹 생성 API는 알고리즘을 받지 않으므로 생성 직후 설정 변경 API로 적용됨
+			Expect(mockClient.ChangeTGConfigCalled).To(Equal(1))
+
+			By("Leaving unchanged settings alone")
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.ChangeLBConfigCalled).To(Equal(0))
+			Expect(mockClient.ChangeTGConfigCalled).To(Equal(1))
+
+			By("Changing the settings through the change APIs")
+			service.Annotations[lbIdleTimeoutAnnotation] = "600"
+			service.Annotations[lbThroughputTypeAnnotation] = "LARGE"
+			service.Annotations[stickySessionAnnotation] = "true"
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.ChangeLBConfigCalled).To(Equal(1))
+			Expect(mockClient.ChangeTGConfigCalled).To(Equal(2))
+			Expect(mockClient.CreateLBCalled).To(Equal(1))
+			Expect(mockClient.CreateTGCalled).To(Equal(1))
+
+			lb := mockClient.LoadBalancers[0]
+			Expect(ncloud.Int32Value(lb.IdleTimeout)).To(Equal(int32(600)))
+			Expect(ncloud.StringValue(lb.ThroughputType.Code)).To(Equal("LARGE"))
+			tg := mockClient.TargetGroups[0]
+			Expect(ncloud.BoolValue(tg.UseStickySession)).To(BeTrue())
+			Expect(ncloud.StringValue(tg.AlgorithmType.Code)).To(Equal("SIPHS"))
+		})
+
+		It("should toggle Proxy Protocol on the existing target group", func() {
+			service := newService(ctx, "proxy-protocol-service", map[string]string{proxyProtocolAnnotation: "true"}, 80)
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ncloud.BoolValue(mockClient.TargetGroups[0].UseProxyProtocol)).To(BeTrue())
+			// 타겟 그룹 생성 API는 Proxy Protocol을 받지 않으므로 생성 직후 설정 변경 API로 켜짐
+			Expect(mockClient.ChangeTGConfigCalled).To(Equal(1))
+
+			By("Disabling it again when the annotation is removed")
+			delete(service.Annotations, proxyProtocolAnnotation)
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.ChangeTGConfigCalled).To(Equal(2))
+			Expect(mockClient.CreateTGCalled).To(Equal(1))
+			Expect(ncloud.BoolValue(mockClient.TargetGroups[0].UseProxyProtocol)).To(BeFalse())
+		})
+
+		It("should record an Event for invalid settings", func() {
+			recorder := record.NewFakeRecorder(10)
+			reconciler.Recorder = recorder
+
+			service := newService(ctx, "lb-settings-invalid-service", map[string]string{lbAlgorithmAnnotation: "random"}, 80)
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).To(HaveOccurred())
+			Expect(mockClient.CreateTGCalled).To(Equal(0))
+			Expect(recorder.Events).To(Receive(ContainSubstring("InvalidLoadBalancerSettings")))
+		})
+	})
+})
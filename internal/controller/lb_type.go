@@ -0,0 +1,232 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	naverv1alpha1 "github.com/suslmk-lee/kube-controller01/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// lbTypeAnnotation은 생성할 로드밸런서 타입을 지정하는 Service 어노테이션입니다
+const lbTypeAnnotation = "naver.k-paas.org/lb-type"
+
+// udpHealthCheckPortAnnotation은 UDP 타겟 그룹의 TCP 헬스 체크 포트를 지정하는 Service 어노테이션입니다.
+// 값은 TCP Service 포트의 이름 또는 번호(해당 NodePort 사용)이거나, 노드에서 직접 검사할 포트 번호입니다
+const udpHealthCheckPortAnnotation = "naver.k-paas.org/udp-health-check-port"
+
+// lbNetworkTypeAnnotation은 로드밸런서 네트워크 타입(public, private)을 지정하는 Service 어노테이션입니다
+const lbNetworkTypeAnnotation = "naver.k-paas.org/lb-network-type"
+
+// 네이버 클라우드 로드밸런서 네트워크 타입 코드
+const (
+	lbNetworkTypePublic  = "PUBLIC"  // 인터넷에 노출 (기본값)
+	lbNetworkTypePrivate = "PRIVATE" // VPC 내부 전용
+)
+
+// 네이버 클라우드 로드밸런서 타입 코드
+const (
+	lbTypeNetwork      = "NETWORK"       // L4 패스스루 (TCP/UDP)
+	lbTypeNetworkProxy = "NETWORK_PROXY" // L4 프록시 (TCP, 기본값)
+	lbTypeApplication  = "APPLICATION"   // L7 (HTTP/HTTPS)
+)
+
+// healthCheckConfig는 타겟 그룹 헬스 체크 설정입니다
+type healthCheckConfig struct {
+	Protocol   string
+	Port       int32
+	URLPath    string // HTTP 헬스 체크에서만 사용
+	HTTPMethod string // HTTP 헬스 체크에서만 사용
+	// 0이면 네이버 클라우드 기본값 사용
+	Interval           int32
+	HealthyThreshold   int32
+	UnhealthyThreshold int32
+}
+
+// loadBalancerType은 Service 어노테이션에서 로드밸런서 타입을 읽습니다 (기본값 NETWORK_PROXY)
+func loadBalancerType(service *corev1.Service) (string, error) {
+	value := strings.ToUpper(strings.TrimSpace(service.Annotations[lbTypeAnnotation]))
+	switch value {
+	case "":
+		return lbTypeNetworkProxy, nil
+	case lbTypeNetwork, lbTypeNetworkProxy, lbTypeApplication:
+		return value, nil
+	default:
+		return "", fmt.Errorf("지원하지 않는 로드밸런서 타입: %s (NETWORK, NETWORK_PROXY, APPLICATION 중 선택)", value)
+	}
+}
+
+// loadBalancerNetworkType은 Service 어노테이션에서 로드밸런서 네트워크 타입을 읽습니다 (기본값 PUBLIC)
+func loadBalancerNetworkType(service *corev1.Service) (string, error) {
+	value := strings.ToUpper(strings.TrimSpace(service.Annotations[lbNetworkTypeAnnotation]))
+	switch value {
+	case "":
+		return lbNetworkTypePublic, nil
+	case lbNetworkTypePublic, lbNetworkTypePrivate:
+		return value, nil
+	default:
+		return "", fmt.Errorf("지원하지 않는 로드밸런서 네트워크 타입: %s (public, private 중 선택)", value)
+	}
+}
+
+// loadBalancerSubnetNo는 네트워크 타입에 맞는 로드밸런서 서브넷 번호를 반환합니다.
+// private 로드밸런서는 별도로 지정한 private 서브넷에만 생성합니다
+func loadBalancerSubnetNo(credentials *NaverCloudCredentials, networkType string) (string, error) {
+	if networkType != lbNetworkTypePrivate {
+		return credentials.SubnetNo, nil
+	}
+	if credentials.PrivateSubnetNo == "" {
+		return "", fmt.Errorf("private 로드밸런서용 서브넷이 설정되지 않음 (NAVER_CLOUD_PRIVATE_SUBNET_NO)")
+	}
+	return credentials.PrivateSubnetNo, nil
+}
+
+// validateLoadBalancerPorts는 Service 포트 프로토콜을 로드밸런서 타입이 지원하는지 확인합니다
+func validateLoadBalancerPorts(lbType string, ports []corev1.ServicePort) error {
+	for _, port := range ports {
+		protocol := servicePortProtocol(port)
+		supported := protocol == corev1.ProtocolTCP || (lbType == lbTypeNetwork && protocol == corev1.ProtocolUDP)
+		if !supported {
+			return fmt.Errorf("%s 로드밸런서는 %s 포트를 지원하지 않음: port %d", lbType, protocol, port.Port)
+		}
+	}
+	return nil
+}
+
+// targetGroupProtocolForPort는 로드밸런서 타입에 맞는 타겟 그룹 프로토콜을 반환합니다
+func targetGroupProtocolForPort(lbType string, port corev1.ServicePort) string {
+	switch lbType {
+	case lbTypeNetwork:
+		return string(servicePortProtocol(port))
+	case lbTypeApplication:
+		return "HTTP"
+	default:
+		return "PROXY_TCP"
+	}
+}
+
+// listenerProtocolForPort는 로드밸런서 타입에 맞는 리스너 프로토콜을 반환합니다
+func listenerProtocolForPort(lbType string, port corev1.ServicePort) string {
+	switch lbType {
+	case lbTypeNetwork:
+		return string(servicePortProtocol(port))
+	case lbTypeApplication:
+		return "HTTP"
+	default:
+		return "TCP"
+	}
+}
+
+// listenerKey는 리스너를 포트와 프로토콜로 식별하는 키입니다 (같은 번호의 TCP/UDP 포트 구분, 기본 프로토콜 TCP)
+func listenerKey(port int32, protocol string) string {
+	if protocol == "" {
+		protocol = "TCP"
+	}
+	return fmt.Sprintf("%d/%s", port, protocol)
+}
+
+// apiListenerKey는 API로 조회한 리스너의 키를 반환합니다
+func apiListenerKey(listener *vloadbalancer.LoadBalancerListener) string {
+	var protocol string
+	if listener.ProtocolType != nil {
+		protocol = ncloud.StringValue(listener.ProtocolType.Code)
+	}
+	return listenerKey(ncloud.Int32Value(listener.Port), protocol)
+}
+
+// recordedLoadBalancerType은 상태에 기록된 로드밸런서 타입을 반환합니다 (이전 버전은 항상 NETWORK_PROXY로 생성)
+func recordedLoadBalancerType(status *naverv1alpha1.NaverLoadBalancerStatus) string {
+	if status.LoadBalancerType == "" {
+		return lbTypeNetworkProxy
+	}
+	return status.LoadBalancerType
+}
+
+// recordedNetworkType은 상태에 기록된 로드밸런서 네트워크 타입을 반환합니다 (이전 버전은 항상 PUBLIC으로 생성)
+func recordedNetworkType(status *naverv1alpha1.NaverLoadBalancerStatus) string {
+	if status.NetworkType == "" {
+		return lbNetworkTypePublic
+	}
+	return status.NetworkType
+}
+
+// healthCheckForPort는 헬스 체크 어노테이션을 적용한 타겟 그룹 헬스 체크 설정을 반환합니다
+func healthCheckForPort(service *corev1.Service, lbType string, port corev1.ServicePort) healthCheckConfig {
+	// 유효성은 validateHealthCheckAnnotations에서 미리 확인
+	config, _ := resolveHealthCheck(service, lbType, port)
+	return config
+}
+
+// defaultHealthCheck는 로드밸런서 타입과 externalTrafficPolicy에 맞는 기본 헬스 체크 설정을 반환합니다
+func defaultHealthCheck(service *corev1.Service, lbType string, port corev1.ServicePort) healthCheckConfig {
+	// externalTrafficPolicy: Local이면 kube-proxy의 HealthCheckNodePort로 로컬 엔드포인트 유무를 확인
+	if isLocalTrafficPolicy(service) && service.Spec.HealthCheckNodePort != 0 {
+		return healthCheckConfig{
+			Protocol:   "HTTP",
+			Port:       service.Spec.HealthCheckNodePort,
+			URLPath:    localHealthCheckPath,
+			HTTPMethod: "GET",
+		}
+	}
+
+	if lbType == lbTypeApplication {
+		return healthCheckConfig{Protocol: "HTTP", Port: port.NodePort, URLPath: "/", HTTPMethod: "GET"}
+	}
+	// UDP 타겟 그룹도 헬스 체크는 TCP로만 가능하므로 별도의 TCP 포트를 검사
+	return healthCheckConfig{Protocol: "TCP", Port: healthCheckPortForPort(service, port)}
+}
+
+// udpHealthCheckPort는 UDP 포트의 TCP 헬스 체크 노드 포트를 찾습니다.
+// 어노테이션이 없으면 같은 번호의 TCP Service 포트(예: DNS 53/TCP)를 사용하고, 찾지 못하면 0을 반환합니다
+func udpHealthCheckPort(service *corev1.Service, port corev1.ServicePort) (int32, error) {
+	value := strings.TrimSpace(service.Annotations[udpHealthCheckPortAnnotation])
+	if value == "" {
+		for _, candidate := range service.Spec.Ports {
+			if servicePortProtocol(candidate) == corev1.ProtocolTCP && candidate.Port == port.Port {
+				return candidate.NodePort, nil
+			}
+		}
+		return 0, nil
+	}
+
+	return resolveHealthCheckPort(service, udpHealthCheckPortAnnotation, value)
+}
+
+// validateHealthCheckPorts는 헬스 체크 포트를 정할 수 없는 포트가 있는지 확인합니다
+func validateHealthCheckPorts(service *corev1.Service) error {
+	if isLocalTrafficPolicy(service) && service.Spec.HealthCheckNodePort != 0 {
+		return nil
+	}
+	for _, port := range service.Spec.Ports {
+		// health-check-port 어노테이션이 있으면 validateHealthCheckAnnotations에서 확인
+		if servicePortProtocol(port) != corev1.ProtocolUDP || healthCheckAnnotation(service, port, healthCheckPortAnnotation) != "" {
+			continue
+		}
+		healthCheckPort, err := udpHealthCheckPort(service, port)
+		if err != nil {
+			return err
+		}
+		if healthCheckPort == 0 {
+			return fmt.Errorf("UDP 포트 %d의 TCP 헬스 체크 포트를 찾을 수 없음 (같은 번호의 TCP 포트를 추가하거나 %s 어노테이션 지정)", port.Port, udpHealthCheckPortAnnotation)
+		}
+	}
+	return nil
+}
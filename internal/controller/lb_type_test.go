@@ -0,0 +1,257 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("Load Balancer Type Tests", func() {
+	var (
+		reconciler *ServiceReconciler
+		mockClient *navercloud.MockClient
+		ctx        context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockClient = navercloud.NewMockClient()
+
+		reconciler = &ServiceReconciler{
+			Client: k8sClient,
+			NaverCloudConfig: NaverCloudConfig{
+				APIKey:    "test-api-key",
+				APISecret: "test-api-secret",
+				Region:    "KR",
+				VpcNo:     "vpc-12345",
+				SubnetNo:  "subnet-67890",
+			},
+			NaverClient: mockClient,
+		}
+	})
+
+	Context("When reading the lb-type annotation", func() {
+		It("should default to NETWORK_PROXY and reject unknown types", func() {
+			withType := func(value string) *corev1.Service {
+				return &corev1.Service{ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{lbTypeAnnotation: value},
+				}}
+			}
+
+			lbType, err := loadBalancerType(&corev1.Service{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lbType).To(Equal(lbTypeNetworkProxy))
+
+			lbType, err = loadBalancerType(withType("network"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lbType).To(Equal(lbTypeNetwork))
+
+			_, err = loadBalancerType(withType("GATEWAY"))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should derive protocols and health checks from the type", func() {
+			tcp := corev1.ServicePort{Port: 80, NodePort: 30080, Protocol: corev1.ProtocolTCP}
+			udp := corev1.ServicePort{Port: 53, NodePort: 30053, Protocol: corev1.ProtocolUDP}
+			service := &corev1.Service{}
+
+			Expect(targetGroupProtocolForPort(lbTypeNetworkProxy, tcp)).To(Equal("PROXY_TCP"))
+			Expect(targetGroupProtocolForPort(lbTypeNetwork, udp)).To(Equal("UDP"))
+			Expect(targetGroupProtocolForPort(lbTypeApplication, tcp)).To(Equal("HTTP"))
+			Expect(listenerProtocolForPort(lbTypeNetworkProxy, tcp)).To(Equal("TCP"))
+			Expect(listenerProtocolForPort(lbTypeApplication, tcp)).To(Equal("HTTP"))
+
+			Expect(healthCheckForPort(service, lbTypeNetwork, tcp)).To(Equal(healthCheckConfig{Protocol: "TCP", Port: 30080}))
+			Expect(healthCheckForPort(service, lbTypeApplication, tcp)).To(Equal(healthCheckConfig{
+				Protocol: "HTTP", Port: 30080, URLPath: "/", HTTPMethod: "GET",
+			}))
+
+			Expect(validateLoadBalancerPorts(lbTypeNetwork, []corev1.ServicePort{tcp, udp})).To(Succeed())
+			Expect(validateLoadBalancerPorts(lbTypeNetworkProxy, []corev1.ServicePort{tcp, udp})).NotTo(Succeed())
+			Expect(validateLoadBalancerPorts(lbTypeApplication, []corev1.ServicePort{udp})).NotTo(Succeed())
+		})
+	})
+
+	Context("When creating an APPLICATION load balancer", func() {
+		It("should create HTTP target groups and listeners", func() {
+			service := newService(ctx, "lb-type-application-service", map[string]string{lbTypeAnnotation: "APPLICATION"}, 80)
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mockClient.LoadBalancers).To(HaveLen(1))
+			Expect(ncloud.StringValue(mockClient.LoadBalancers[0].LoadBalancerType.Code)).To(Equal("APPLICATION"))
+			Expect(ncloud.StringValue(mockClient.TargetGroups[0].TargetGroupProtocolType.Code)).To(Equal("HTTP"))
+			Expect(ncloud.StringValue(mockClient.TargetGroups[0].HealthCheckProtocolType.Code)).To(Equal("HTTP"))
+			Expect(ncloud.StringValue(mockClient.Listeners[0].ProtocolType.Code)).To(Equal("HTTP"))
+
+			nlb, err := reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Status.LoadBalancerType).To(Equal("APPLICATION"))
+
+			By("Refusing to change the type of the existing load balancer")
+			service.Annotations[lbTypeAnnotation] = "NETWORK"
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).To(MatchError(ContainSubstring("APPLICATION -> NETWORK")))
+		})
+	})
+
+	Context("When resolving the UDP health check port", func() {
+		It("should prefer the annotation and fall back to the matching TCP port", func() {
+			udp := corev1.ServicePort{Port: 53, NodePort: 30053, Protocol: corev1.ProtocolUDP}
+			service := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{}},
+				Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{
+					udp,
+					{Name: "dns-tcp", Port: 53, NodePort: 31053, Protocol: corev1.ProtocolTCP},
+					{Name: "metrics", Port: 9153, NodePort: 31153, Protocol: corev1.ProtocolTCP},
+				}},
+			}
+
+			Expect(healthCheckPortForPort(service, udp)).To(Equal(int32(31053)))
+			Expect(healthCheckForPort(service, lbTypeNetwork, udp)).To(Equal(healthCheckConfig{Protocol: "TCP", Port: 31053}))
+
+			service.Annotations[udpHealthCheckPortAnnotation] = "metrics"
+			Expect(healthCheckPortForPort(service, udp)).To(Equal(int32(31153)))
+
+			service.Annotations[udpHealthCheckPortAnnotation] = "9153"
+			Expect(healthCheckPortForPort(service, udp)).To(Equal(int32(31153)))
+
+			service.Annotations[udpHealthCheckPortAnnotation] = "32000"
+			Expect(healthCheckPortForPort(service, udp)).To(Equal(int32(32000)))
+			Expect(validateHealthCheckPorts(service)).To(Succeed())
+
+			service.Annotations[udpHealthCheckPortAnnotation] = "unknown"
+			Expect(validateHealthCheckPorts(service)).NotTo(Succeed())
+
+			By("Rejecting a UDP-only Service without a health check port")
+			udpOnly := &corev1.Service{Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{udp}}}
+			Expect(validateHealthCheckPorts(udpOnly)).To(MatchError(ContainSubstring(udpHealthCheckPortAnnotation)))
+		})
+	})
+
+	Context("When creating a load balancer for a UDP Service", func() {
+		newUDPService := func(name string, annotations map[string]string) *corev1.Service {
+			service := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Annotations: annotations},
+				Spec: corev1.ServiceSpec{
+					Type: corev1.ServiceTypeLoadBalancer,
+					Ports: []corev1.ServicePort{
+						{Name: "dns-udp", Port: 53, TargetPort: intstr.FromInt32(53), Protocol: corev1.ProtocolUDP},
+						{Name: "dns-tcp", Port: 53, TargetPort: intstr.FromInt32(53), Protocol: corev1.ProtocolTCP},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, service)).To(Succeed())
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: service.Namespace, Name: service.Name}, service)).To(Succeed())
+			return service
+		}
+
+		It("should create UDP target groups and listeners on a NETWORK load balancer", func() {
+			service := newUDPService("lb-type-udp-service", map[string]string{lbTypeAnnotation: "NETWORK"})
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mockClient.TargetGroups).To(HaveLen(2))
+			udpTG := mockClient.TargetGroups[0]
+			Expect(ncloud.StringValue(udpTG.TargetGroupProtocolType.Code)).To(Equal("UDP"))
+			Expect(ncloud.StringValue(udpTG.HealthCheckProtocolType.Code)).To(Equal("TCP"))
+			Expect(ncloud.Int32Value(udpTG.HealthCheckPort)).To(Equal(service.Spec.Ports[1].NodePort))
+			Expect(mockClient.Listeners).To(HaveLen(2))
+
+			By("Tracking the TCP and UDP listeners on the same port separately")
+			nlb, err := reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Status.Listeners).To(ConsistOf(
+				HaveField("Protocol", "UDP"),
+				HaveField("Protocol", "TCP"),
+			))
+
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.DeleteListenerCalled).To(Equal(0))
+			Expect(mockClient.Listeners).To(HaveLen(2))
+		})
+
+		It("should record an Event when the load balancer type does not support UDP", func() {
+			recorder := record.NewFakeRecorder(10)
+			reconciler.Recorder = recorder
+
+			service := newUDPService("lb-type-udp-proxy-service", nil)
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).To(HaveOccurred())
+			Expect(mockClient.CreateLBCalled).To(Equal(0))
+			Expect(recorder.Events).To(Receive(ContainSubstring("UnsupportedProtocol")))
+		})
+	})
+
+	Context("When creating a private load balancer", func() {
+		It("should use the private subnet and publish the private IP", func() {
+			reconciler.NaverCloudConfig.PrivateSubnetNo = "subnet-private"
+
+			service := newService(ctx, "lb-private-service", map[string]string{lbNetworkTypeAnnotation: "private"}, 80)
+			defer cleanupService(ctx, service)
+
+			lbStatus, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mockClient.LoadBalancers).To(HaveLen(1))
+			lb := mockClient.LoadBalancers[0]
+			Expect(ncloud.StringValue(lb.LoadBalancerNetworkType.Code)).To(Equal("PRIVATE"))
+			Expect(lb.SubnetNoList).To(HaveExactElements(HaveValue(Equal("subnet-private"))))
+			Expect(lbStatus.ExternalIP).To(Equal(ncloud.StringValue(lb.LoadBalancerIpList[0])))
+
+			nlb, err := reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Status.NetworkType).To(Equal("PRIVATE"))
+
+			By("Refusing to expose the existing load balancer publicly")
+			delete(service.Annotations, lbNetworkTypeAnnotation)
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).To(MatchError(ContainSubstring("PRIVATE -> PUBLIC")))
+		})
+
+		It("should not fall back to the public subnet", func() {
+			service := newService(ctx, "lb-private-nosubnet-service", map[string]string{lbNetworkTypeAnnotation: "PRIVATE"}, 80)
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).To(MatchError(ContainSubstring("NAVER_CLOUD_PRIVATE_SUBNET_NO")))
+			Expect(mockClient.CreateLBCalled).To(Equal(0))
+			Expect(mockClient.CreateTGCalled).To(Equal(0))
+
+			service.Annotations[lbNetworkTypeAnnotation] = "internal"
+			_, err = loadBalancerNetworkType(service)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
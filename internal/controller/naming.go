@@ -0,0 +1,101 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// 리소스 이름 길이 제한과 이름 끝에 붙이는 해시 길이
+const (
+	maxResourceNameLength  = 30
+	resourceNameHashLength = 8
+)
+
+// resourceName은 로드밸런서와 타겟 그룹의 이름을 만듭니다.
+// generateValidName으로 만든 읽을 수 있는 접두사 뒤에 클러스터, 네임스페이스, Service 이름, 접미사의 해시를 붙여
+// 긴 이름이 잘려도 서로 다른 Service나 포트의 이름이 겹치지 않도록 합니다
+func (r *ServiceReconciler) resourceName(prefix string, service *corev1.Service, suffix string) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{r.ClusterName, service.Namespace, service.Name, suffix}, "/")))
+	hash := hex.EncodeToString(sum[:])[:resourceNameHashLength]
+
+	readable := r.generateValidName(prefix, service.Namespace, service.Name, suffix)
+	if maxLength := maxResourceNameLength - resourceNameHashLength - 1; len(readable) > maxLength {
+		readable = strings.TrimSuffix(readable[:maxLength], "-")
+	}
+	return readable + "-" + hash
+}
+
+// findOwnedLoadBalancer는 이름이 names 중 하나이고 이 Service가 만든 로드밸런서를 찾습니다 (없거나 삭제 중이면 nil).
+// 해시를 붙이기 전 이름 규칙으로 만든 로드밸런서도 찾을 수 있도록 이름을 여러 개 받습니다
+func (r *ServiceReconciler) findOwnedLoadBalancer(client NaverCloudClient, credentials *NaverCloudCredentials, service *corev1.Service, names ...string) (*vloadbalancer.LoadBalancerInstance, error) {
+	resp, err := client.GetLoadBalancerInstanceList(&vloadbalancer.GetLoadBalancerInstanceListRequest{
+		RegionCode: ncloud.String(credentials.Region),
+		VpcNo:      ncloud.String(credentials.VpcNo),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("로드밸런서 목록 조회 실패: %w", err)
+	}
+	if resp == nil {
+		return nil, nil
+	}
+	for _, lb := range resp.LoadBalancerInstanceList {
+		if code, _ := loadBalancerInstanceStatus(lb); code == "TERMINATING" {
+			continue
+		}
+		if containsString(names, ncloud.StringValue(lb.LoadBalancerName)) &&
+			r.ownedByService(ncloud.StringValue(lb.LoadBalancerDescription), service) {
+			return lb, nil
+		}
+	}
+	return nil, nil
+}
+
+// findOwnedTargetGroup는 이름이 names 중 하나이고 이 Service가 만든 타겟 그룹을 찾습니다 (없으면 nil).
+// 같은 이름이라도 NodePort나 헬스 체크 포트가 다르면 다른 포트 구성의 타겟 그룹이므로 제외하며,
+// 해시를 붙이기 전 이름 규칙으로 만든 타겟 그룹도 찾을 수 있도록 이름을 여러 개 받습니다
+func (r *ServiceReconciler) findOwnedTargetGroup(client NaverCloudClient, credentials *NaverCloudCredentials, service *corev1.Service, nodePort, healthCheckPort int32, names ...string) (*vloadbalancer.TargetGroup, error) {
+	resp, err := client.GetTargetGroupList(&vloadbalancer.GetTargetGroupListRequest{
+		RegionCode: ncloud.String(credentials.Region),
+		VpcNo:      ncloud.String(credentials.VpcNo),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("타겟 그룹 목록 조회 실패: %w", err)
+	}
+	if resp == nil {
+		return nil, nil
+	}
+	for _, tg := range resp.TargetGroupList {
+		if tg == nil {
+			continue
+		}
+		if containsString(names, ncloud.StringValue(tg.TargetGroupName)) &&
+			r.ownedByService(ncloud.StringValue(tg.TargetGroupDescription), service) &&
+			(tg.TargetGroupPort == nil || *tg.TargetGroupPort == nodePort) &&
+			(tg.HealthCheckPort == nil || *tg.HealthCheckPort == healthCheckPort) {
+			return tg, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,376 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+
+	naverv1alpha1 "github.com/suslmk-lee/kube-controller01/api/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// naverLoadBalancerFinalizer는 Service 삭제 처리에서 네이버 클라우드 리소스를 정리할 때까지 NaverLoadBalancer를 남겨 둡니다.
+// 타겟 그룹, 인증서, Network ACL·ACG 규칙은 NaverLoadBalancer 상태에만 기록되므로 Service보다 먼저 사라지면 정리할 수 없습니다
+const naverLoadBalancerFinalizer = "naver.k-paas.org/nlb-finalizer"
+
+// getNaverLoadBalancer는 Service와 같은 이름의 NaverLoadBalancer를 조회합니다. 없으면 nil을 반환합니다
+func (r *ServiceReconciler) getNaverLoadBalancer(ctx context.Context, service *corev1.Service) (*naverv1alpha1.NaverLoadBalancer, error) {
+	nlb := &naverv1alpha1.NaverLoadBalancer{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: service.Namespace, Name: service.Name}, nlb)
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("NaverLoadBalancer 조회 실패: %w", err)
+	}
+	return nlb, nil
+}
+
+// ensureNaverLoadBalancer는 Service에 대응하는 NaverLoadBalancer를 조회하고, 없으면 생성합니다.
+// 이전 버전이 남긴 lb-id/target-groups 어노테이션이 있으면 그 값으로 상태를 초기화합니다.
+func (r *ServiceReconciler) ensureNaverLoadBalancer(ctx context.Context, service *corev1.Service) (*naverv1alpha1.NaverLoadBalancer, error) {
+	logger := log.FromContext(ctx).WithValues("service", types.NamespacedName{Namespace: service.Namespace, Name: service.Name})
+
+	nlb, err := r.getNaverLoadBalancer(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+
+	ports := naverLoadBalancerPorts(service)
+
+	if nlb != nil {
+		// Service 포트가 바뀌면 spec을 갱신하여 generation을 올리고, finalizer 없이 만든 이전 리소스에는 finalizer 추가
+		// (삭제 중인 리소스에는 새 finalizer를 붙일 수 없음)
+		missingFinalizer := nlb.DeletionTimestamp.IsZero() && !containsString(nlb.Finalizers, naverLoadBalancerFinalizer)
+		if !equality.Semantic.DeepEqual(nlb.Spec.Ports, ports) || nlb.Spec.ServiceUID != service.UID || missingFinalizer {
+			nlb.Spec.Ports = ports
+			nlb.Spec.ServiceUID = service.UID
+			if missingFinalizer {
+				nlb.Finalizers = append(nlb.Finalizers, naverLoadBalancerFinalizer)
+			}
+			if err := r.Update(ctx, nlb); err != nil {
+				return nil, fmt.Errorf("NaverLoadBalancer spec 업데이트 실패: %w", err)
+			}
+		}
+		return nlb, nil
+	}
+
+	nlb = &naverv1alpha1.NaverLoadBalancer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       service.Name,
+			Namespace:  service.Namespace,
+			Finalizers: []string{naverLoadBalancerFinalizer},
+		},
+		Spec: naverv1alpha1.NaverLoadBalancerSpec{
+			ServiceName: service.Name,
+			ServiceUID:  service.UID,
+			Ports:       ports,
+		},
+	}
+	// Service가 삭제되면 NaverLoadBalancer도 가비지 컬렉션되도록 소유자 참조 설정
+	if service.UID != "" {
+		nlb.OwnerReferences = []metav1.OwnerReference{
+			*metav1.NewControllerRef(service, corev1.SchemeGroupVersion.WithKind("Service")),
+		}
+	}
+
+	if err := r.Create(ctx, nlb); err != nil {
+		return nil, fmt.Errorf("NaverLoadBalancer 생성 실패: %w", err)
+	}
+	logger.Info("NaverLoadBalancer 생성", "name", nlb.Name)
+
+	if migrated := naverLoadBalancerStatusFromAnnotations(service); migrated != nil {
+		logger.Info("기존 어노테이션에서 NaverLoadBalancer 상태 마이그레이션",
+			"lb-id", migrated.LoadBalancerNo,
+			"target-groups", migrated.TargetGroupNos())
+
+		if err := r.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+			latest.Status.LoadBalancerNo = migrated.LoadBalancerNo
+			latest.Status.TargetGroups = migrated.TargetGroups
+			// 이전 버전은 리스너 구성까지 끝난 뒤에 lb-id 어노테이션을 기록했으므로 조정 완료로 간주
+			if migrated.LoadBalancerNo != "" {
+				latest.Status.ObservedGeneration = latest.Generation
+			}
+			setReadyCondition(latest, metav1.ConditionUnknown, naverv1alpha1.ReasonMigratedAnnotation,
+				"Service 어노테이션에서 리소스 정보를 가져옴")
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return nlb, nil
+}
+
+// updateNaverLoadBalancerStatus는 최신 NaverLoadBalancer에 mutate를 적용하여 상태를 갱신합니다 (충돌 시 재시도)
+func (r *ServiceReconciler) updateNaverLoadBalancerStatus(ctx context.Context, nlb *naverv1alpha1.NaverLoadBalancer, mutate func(latest *naverv1alpha1.NaverLoadBalancer)) error {
+	key := types.NamespacedName{Namespace: nlb.Namespace, Name: nlb.Name}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &naverv1alpha1.NaverLoadBalancer{}
+		if err := r.Get(ctx, key, latest); err != nil {
+			return err
+		}
+		mutate(latest)
+		if err := r.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		latest.DeepCopyInto(nlb)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("NaverLoadBalancer 상태 업데이트 실패: %w", err)
+	}
+	return nil
+}
+
+// recordTargetGroup은 Service 포트에 대해 생성한 타겟 그룹을 상태에 기록합니다
+func (r *ServiceReconciler) recordTargetGroup(ctx context.Context, nlb *naverv1alpha1.NaverLoadBalancer, service *corev1.Service, port corev1.ServicePort, targetGroupNo, targetGroupName string) error {
+	entry := targetGroupStatusForPort(service, port, targetGroupNo, targetGroupName)
+	return r.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+		if existing := latest.Status.FindTargetGroup(port.Port, port.Protocol); existing != nil {
+			*existing = entry
+			return
+		}
+		latest.Status.TargetGroups = append(latest.Status.TargetGroups, entry)
+	})
+}
+
+// recordLoadBalancer는 생성(또는 이름으로 발견)한 로드밸런서를 상태에 기록합니다 (reservedIP는 지정한 경우에만 기록)
+func (r *ServiceReconciler) recordLoadBalancer(ctx context.Context, nlb *naverv1alpha1.NaverLoadBalancer, lbNo, lbName, lbType, networkType string, subnetNos []string, reservedIP *naverv1alpha1.ReservedPublicIPStatus) error {
+	return r.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+		latest.Status.LoadBalancerNo = lbNo
+		latest.Status.LoadBalancerName = lbName
+		latest.Status.LoadBalancerType = lbType
+		latest.Status.NetworkType = networkType
+		latest.Status.SubnetNos = subnetNos
+		if reservedIP != nil {
+			latest.Status.ReservedPublicIP = reservedIP
+		}
+		setPhase(latest, naverv1alpha1.PhaseLBCreating)
+		setReadyCondition(latest, metav1.ConditionFalse, naverv1alpha1.ReasonProvisioning, "로드밸런서 프로비저닝 중")
+	})
+}
+
+// syncNaverLoadBalancerStatus는 로드밸런서 주소와 리스너 정보를 조회하여 상태에 반영하고 Ready 조건을 설정합니다.
+// targets는 등록한 노드 인스턴스 번호이며 nil이면 기존 기록을 유지하고, 비어 있으면 기록을 지워 타겟 드리프트 비교를 생략합니다
+func (r *ServiceReconciler) syncNaverLoadBalancerStatus(ctx context.Context, client NaverCloudClient, nlb *naverv1alpha1.NaverLoadBalancer, lbID string, settings *listenerSettings, ports []corev1.ServicePort, targetGroupIDs, targets []string) error {
+	logger := log.FromContext(ctx)
+
+	var domain string
+	var ips []string
+	detailResp, err := client.GetLoadBalancerInstanceDetail(&vloadbalancer.GetLoadBalancerInstanceDetailRequest{
+		LoadBalancerInstanceNo: ncloud.String(lbID),
+	})
+	if err != nil {
+		logger.Info("로드밸런서 상세 정보 조회 실패, 주소 정보 유지", "lb-id", lbID, "error", err.Error())
+	} else if detailResp != nil && len(detailResp.LoadBalancerInstanceList) > 0 {
+		lbInstance := detailResp.LoadBalancerInstanceList[0]
+		domain = ncloud.StringValue(lbInstance.LoadBalancerDomain)
+		for _, ip := range lbInstance.LoadBalancerIpList {
+			if ip != nil && *ip != "" {
+				ips = append(ips, *ip)
+			}
+		}
+	}
+
+	// 포트/프로토콜별 리스너 번호 조회
+	listenerNos := make(map[string]string)
+	listenerResp, err := client.GetLoadBalancerListenerList(&vloadbalancer.GetLoadBalancerListenerListRequest{
+		LoadBalancerInstanceNo: ncloud.String(lbID),
+	})
+	if err != nil {
+		logger.Info("리스너 목록 조회 실패, 리스너 정보 유지", "lb-id", lbID, "error", err.Error())
+	} else if listenerResp != nil {
+		for _, listener := range listenerResp.LoadBalancerListenerList {
+			if listener.Port == nil {
+				continue
+			}
+			listenerNos[apiListenerKey(listener)] = ncloud.StringValue(listener.LoadBalancerListenerNo)
+		}
+	}
+
+	var listeners []naverv1alpha1.ListenerStatus
+	for i, port := range ports {
+		listenerNo, ok := listenerNos[settings.key(port)]
+		if !ok {
+			continue
+		}
+		listener := naverv1alpha1.ListenerStatus{
+			Port:       port.Port,
+			Protocol:   settings.protocol(port),
+			ListenerNo: listenerNo,
+		}
+		if i < len(targetGroupIDs) {
+			listener.TargetGroupNo = targetGroupIDs[i]
+		}
+		listeners = append(listeners, listener)
+	}
+
+	return r.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+		latest.Status.LoadBalancerNo = lbID
+		if domain != "" || len(ips) > 0 {
+			latest.Status.Domain = domain
+			latest.Status.IPs = ips
+		}
+		if listenerResp != nil {
+			latest.Status.Listeners = listeners
+		}
+		if targets != nil {
+			latest.Status.Targets = nil
+			if len(targets) > 0 {
+				latest.Status.Targets = targets
+			}
+		}
+		latest.Status.ObservedGeneration = latest.Generation
+		setPhase(latest, naverv1alpha1.PhaseReady)
+		setReadyCondition(latest, metav1.ConditionTrue, naverv1alpha1.ReasonAvailable, "로드밸런서 사용 가능")
+	})
+}
+
+// markNaverLoadBalancerNotReady는 조정 실패를 Ready 조건에 기록합니다. NaverLoadBalancer가 없으면 무시합니다
+func (r *ServiceReconciler) markNaverLoadBalancerNotReady(ctx context.Context, service *corev1.Service, reason string, cause error) {
+	logger := log.FromContext(ctx)
+
+	nlb, err := r.getNaverLoadBalancer(ctx, service)
+	if err != nil || nlb == nil {
+		return
+	}
+
+	if err := r.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+		setReadyCondition(latest, metav1.ConditionFalse, reason, cause.Error())
+	}); err != nil {
+		logger.Error(err, "NaverLoadBalancer 조건 업데이트 실패")
+	}
+}
+
+// deleteNaverLoadBalancer는 네이버 클라우드 리소스 정리가 끝난 NaverLoadBalancer의 finalizer를 제거하고 삭제합니다
+func (r *ServiceReconciler) deleteNaverLoadBalancer(ctx context.Context, nlb *naverv1alpha1.NaverLoadBalancer) error {
+	if nlb == nil {
+		return nil
+	}
+	if containsString(nlb.Finalizers, naverLoadBalancerFinalizer) {
+		nlb.Finalizers = removeString(nlb.Finalizers, naverLoadBalancerFinalizer)
+		if err := r.Update(ctx, nlb); err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("NaverLoadBalancer finalizer 제거 실패: %w", err)
+		}
+	}
+	if err := r.Delete(ctx, nlb); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("NaverLoadBalancer 삭제 실패: %w", err)
+	}
+	return nil
+}
+
+// setReadyCondition은 현재 generation 기준으로 Ready 조건을 설정합니다
+func setReadyCondition(nlb *naverv1alpha1.NaverLoadBalancer, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&nlb.Status.Conditions, metav1.Condition{
+		Type:               naverv1alpha1.ConditionReady,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: nlb.Generation,
+	})
+}
+
+// naverLoadBalancerPorts는 Service 포트를 NaverLoadBalancer spec 포트로 변환합니다
+func naverLoadBalancerPorts(service *corev1.Service) []naverv1alpha1.NaverLoadBalancerPort {
+	ports := make([]naverv1alpha1.NaverLoadBalancerPort, 0, len(service.Spec.Ports))
+	for _, port := range service.Spec.Ports {
+		ports = append(ports, naverv1alpha1.NaverLoadBalancerPort{
+			Name:     port.Name,
+			Protocol: servicePortProtocol(port),
+			Port:     port.Port,
+			NodePort: port.NodePort,
+		})
+	}
+	return ports
+}
+
+// naverLoadBalancerStatusFromAnnotations는 이전 버전이 Service 어노테이션에 남긴 리소스 정보를 상태로 변환합니다.
+// 이전 버전은 Service 포트 순서대로 타겟 그룹을 생성했으므로 같은 순서로 포트에 매핑합니다.
+func naverLoadBalancerStatusFromAnnotations(service *corev1.Service) *naverv1alpha1.NaverLoadBalancerStatus {
+	lbID := service.Annotations["naver.k-paas.org/lb-id"]
+	targetGroupsStr := service.Annotations["naver.k-paas.org/target-groups"]
+	if lbID == "" && targetGroupsStr == "" {
+		return nil
+	}
+
+	status := &naverv1alpha1.NaverLoadBalancerStatus{LoadBalancerNo: lbID}
+	i := 0
+	for _, tgID := range strings.Split(targetGroupsStr, ",") {
+		tgID = strings.TrimSpace(tgID)
+		if tgID == "" {
+			continue
+		}
+		entry := naverv1alpha1.TargetGroupStatus{TargetGroupNo: tgID}
+		if i < len(service.Spec.Ports) {
+			port := service.Spec.Ports[i]
+			entry.Port = port.Port
+			entry.Protocol = servicePortProtocol(port)
+			entry.NodePort = port.NodePort
+		}
+		status.TargetGroups = append(status.TargetGroups, entry)
+		i++
+	}
+	return status
+}
+
+// targetGroupIDsForPorts는 Service 포트 순서대로 상태에 기록된 타겟 그룹 번호를 반환합니다 (없으면 빈 문자열)
+func targetGroupIDsForPorts(status *naverv1alpha1.NaverLoadBalancerStatus, ports []corev1.ServicePort) []string {
+	ids := make([]string, len(ports))
+	for i, port := range ports {
+		if tg := status.FindTargetGroup(port.Port, port.Protocol); tg != nil {
+			ids[i] = tg.TargetGroupNo
+		}
+	}
+	return ids
+}
+
+// servicePortProtocol은 Service 포트 프로토콜을 반환합니다 (기본값 TCP)
+func servicePortProtocol(port corev1.ServicePort) corev1.Protocol {
+	if port.Protocol == "" {
+		return corev1.ProtocolTCP
+	}
+	return port.Protocol
+}
+
+// targetGroupStatusForPort는 Service 포트에 연결된 타겟 그룹의 상태 항목을 만듭니다
+func targetGroupStatusForPort(service *corev1.Service, port corev1.ServicePort, targetGroupNo, targetGroupName string) naverv1alpha1.TargetGroupStatus {
+	return naverv1alpha1.TargetGroupStatus{
+		Port:            port.Port,
+		Protocol:        servicePortProtocol(port),
+		NodePort:        port.NodePort,
+		HealthCheckPort: healthCheckPortForPort(service, port),
+		TargetGroupNo:   targetGroupNo,
+		TargetGroupName: targetGroupName,
+	}
+}
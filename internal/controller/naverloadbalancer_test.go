@@ -0,0 +1,292 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	naverv1alpha1 "github.com/suslmk-lee/kube-controller01/api/v1alpha1"
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var _ = Describe("NaverLoadBalancer Tests", func() {
+	var (
+		reconciler *ServiceReconciler
+		mockClient *navercloud.MockClient
+		ctx        context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockClient = navercloud.NewMockClient()
+
+		reconciler = &ServiceReconciler{
+			Client: k8sClient,
+			NaverCloudConfig: NaverCloudConfig{
+				APIKey:    "test-api-key",
+				APISecret: "test-api-secret",
+				Region:    "KR",
+				VpcNo:     "vpc-12345",
+				SubnetNo:  "subnet-67890",
+			},
+			NaverClient: mockClient,
+		}
+	})
+
+	Context("When creating the NaverLoadBalancer for a Service", func() {
+		It("should own the resource and mirror the Service ports", func() {
+			service := newService(ctx, "nlb-owner-service", nil, 80, 443)
+			defer cleanupService(ctx, service)
+
+			nlb, err := reconciler.ensureNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Spec.ServiceName).To(Equal(service.Name))
+			Expect(nlb.Spec.ServiceUID).To(Equal(service.UID))
+			Expect(nlb.Spec.Ports).To(HaveLen(2))
+			Expect(metav1.IsControlledBy(nlb, service)).To(BeTrue())
+
+			By("Changing the Service ports")
+			generation := nlb.Generation
+			service.Spec.Ports = service.Spec.Ports[:1]
+			nlb, err = reconciler.ensureNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Spec.Ports).To(HaveLen(1))
+			Expect(nlb.Generation).To(BeNumerically(">", generation))
+		})
+	})
+
+	Context("When the NaverLoadBalancer is deleted before the Service", func() {
+		It("should keep it until the target groups are cleaned up", func() {
+			service := newService(ctx, "nlb-early-delete-service", nil, 80)
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			nlb, err := reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Finalizers).To(ContainElement(naverLoadBalancerFinalizer))
+
+			By("Deleting the NaverLoadBalancer while the Service still exists")
+			Expect(k8sClient.Delete(ctx, nlb)).To(Succeed())
+			nlb, err = reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb).NotTo(BeNil())
+			Expect(nlb.DeletionTimestamp).NotTo(BeNil())
+			Expect(nlb.Status.TargetGroupNos()).To(Equal([]string{"tg-12345"}))
+
+			By("Cleaning up the Naver Cloud resources")
+			Expect(reconciler.deleteNaverCloudLB(ctx, service)).To(Succeed())
+			Expect(mockClient.DeleteTGCalled).To(Equal(1))
+			Expect(mockClient.TargetGroups).To(BeEmpty())
+			nlb, err = reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb).To(BeNil())
+		})
+	})
+
+	Context("When migrating a Service created by an older controller", func() {
+		It("should import the annotations and keep the existing load balancer", func() {
+			mockClient.AddMockLoadBalancer("lb-legacy", "k8s-lb-nlb-legacy-service", "Running")
+			mockClient.AddMockListener("lb-legacy", "listener-a", 80, "tg-a")
+			mockClient.AddMockListener("lb-legacy", "listener-b", 443, "tg-b")
+
+			service := newService(ctx, "nlb-legacy-service", map[string]string{
+				"naver.k-paas.org/lb-id":         "lb-legacy",
+				"naver.k-paas.org/target-groups": "tg-a,tg-b",
+			}, 80, 443)
+			defer cleanupService(ctx, service)
+
+			lbStatus, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lbStatus.LBID).To(Equal("lb-legacy"))
+			Expect(mockClient.CreateLBCalled).To(Equal(0))
+			Expect(mockClient.CreateTGCalled).To(Equal(0))
+
+			nlb, err := reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Status.LoadBalancerNo).To(Equal("lb-legacy"))
+			Expect(nlb.Status.FindTargetGroup(80, corev1.ProtocolTCP).TargetGroupNo).To(Equal("tg-a"))
+			Expect(nlb.Status.FindTargetGroup(443, corev1.ProtocolTCP).TargetGroupNo).To(Equal("tg-b"))
+		})
+	})
+
+	Context("When a previous reconcile stopped after creating target groups", func() {
+		It("should reuse the recorded target groups", func() {
+			mockClient.AddMockTargetGroup("tg-recorded", "tg-nlb-resume-service-0", 30080)
+
+			service := newService(ctx, "nlb-resume-service", nil, 80)
+			defer cleanupService(ctx, service)
+
+			nlb, err := reconciler.ensureNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reconciler.recordTargetGroup(ctx, nlb, service, service.Spec.Ports[0], "tg-recorded", "tg-nlb-resume-service-0")).To(Succeed())
+
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.CreateTGCalled).To(Equal(0))
+			Expect(mockClient.CreateLBCalled).To(Equal(1))
+
+			nlb, err = reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Status.TargetGroups).To(HaveLen(1))
+			Expect(nlb.Status.Listeners).To(ConsistOf(HaveField("TargetGroupNo", "tg-recorded")))
+		})
+	})
+
+	Context("When a previous reconcile stopped after requesting the load balancer", func() {
+		It("should reuse the load balancer created under the legacy name", func() {
+			service := newService(ctx, "nlb-interrupted-service", nil, 80)
+			defer cleanupService(ctx, service)
+			mockClient.AddMockTargetGroup("tg-recorded", "tg-nlb-interrupted-service-0", 30080)
+			mockClient.AddMockLoadBalancer("lb-interrupted", reconciler.generateValidName("k8s-lb", service.Namespace, service.Name, ""), "Running")
+			mockClient.LoadBalancers[0].LoadBalancerDescription = ncloud.String(reconciler.loadBalancerDescription(service))
+
+			nlb, err := reconciler.ensureNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reconciler.recordTargetGroup(ctx, nlb, service, service.Spec.Ports[0], "tg-recorded", "tg-nlb-interrupted-service-0")).To(Succeed())
+			Expect(reconciler.recordPhase(ctx, nlb, naverv1alpha1.PhaseTargetGroupsCreated)).To(Succeed())
+
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.CreateLBCalled).To(Equal(0))
+
+			nlb, err = reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Status.LoadBalancerNo).To(Equal("lb-interrupted"))
+		})
+	})
+
+	Context("When a target group was created under the legacy name", func() {
+		It("should reuse it instead of creating another one", func() {
+			service := newService(ctx, "nlb-legacy-tg-service", nil, 80)
+			defer cleanupService(ctx, service)
+			port := service.Spec.Ports[0]
+			mockClient.AddMockTargetGroup("tg-legacy", reconciler.generateValidName("tg", service.Namespace, service.Name, "80"), port.NodePort)
+			mockClient.TargetGroups[0].TargetGroupDescription = ncloud.String(reconciler.targetGroupDescription(service, port))
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.CreateTGCalled).To(Equal(0))
+			Expect(mockClient.TargetGroups).To(HaveLen(1))
+
+			nlb, err := reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Status.TargetGroupNos()).To(Equal([]string{"tg-legacy"}))
+		})
+	})
+
+	Context("When the Service stops being a LoadBalancer before provisioning finishes", func() {
+		It("should delete the NaverLoadBalancer and release the finalizer", func() {
+			service := newService(ctx, "nlb-type-change-service", nil, 80)
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.ensureNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			service.Finalizers = append(service.Finalizers, naverLBFinalizer)
+			service.Spec.Type = corev1.ServiceTypeClusterIP
+			service.Spec.ExternalTrafficPolicy = ""
+			service.Spec.AllocateLoadBalancerNodePorts = nil
+			for i := range service.Spec.Ports {
+				service.Spec.Ports[i].NodePort = 0
+			}
+			Expect(k8sClient.Update(ctx, service)).To(Succeed())
+			Expect(isRelevantService(service)).To(BeTrue())
+
+			_, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: service.Namespace, Name: service.Name}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: service.Namespace, Name: service.Name}, service)).To(Succeed())
+			Expect(service.Finalizers).NotTo(ContainElement(naverLBFinalizer))
+			nlb, err := reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb).To(BeNil())
+			Expect(mockClient.DeleteLBCalled).To(Equal(0))
+		})
+	})
+
+	Context("When converting legacy annotations", func() {
+		It("should map target groups to ports in order", func() {
+			service := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"naver.k-paas.org/target-groups": ",tg-1,,tg-2,tg-3"},
+				},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{
+						{Port: 80, NodePort: 30080},
+						{Port: 53, NodePort: 30053, Protocol: corev1.ProtocolUDP},
+					},
+				},
+			}
+
+			status := naverLoadBalancerStatusFromAnnotations(service)
+			Expect(status).NotTo(BeNil())
+			Expect(status.LoadBalancerNo).To(BeEmpty())
+			Expect(status.TargetGroups).To(Equal([]naverv1alpha1.TargetGroupStatus{
+				{Port: 80, Protocol: corev1.ProtocolTCP, NodePort: 30080, TargetGroupNo: "tg-1"},
+				{Port: 53, Protocol: corev1.ProtocolUDP, NodePort: 30053, TargetGroupNo: "tg-2"},
+				{TargetGroupNo: "tg-3"},
+			}))
+			Expect(targetGroupIDsForPorts(status, service.Spec.Ports)).To(Equal([]string{"tg-1", "tg-2"}))
+			Expect(status.TargetGroupNos()).To(Equal([]string{"tg-1", "tg-2", "tg-3"}))
+
+			Expect(naverLoadBalancerStatusFromAnnotations(&corev1.Service{})).To(BeNil())
+		})
+	})
+})
+
+// newService는 테스트용 LoadBalancer 타입 Service를 생성하고 API 서버가 채운 값(UID, NodePort)을 다시 읽어옵니다
+func newService(ctx context.Context, name string, annotations map[string]string, ports ...int32) *corev1.Service {
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "default",
+			Annotations: annotations,
+		},
+		Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+	}
+	for _, port := range ports {
+		service.Spec.Ports = append(service.Spec.Ports, corev1.ServicePort{
+			Name:       fmt.Sprintf("port-%d", port),
+			Port:       port,
+			TargetPort: intstr.FromInt32(port),
+			Protocol:   corev1.ProtocolTCP,
+		})
+	}
+	Expect(k8sClient.Create(ctx, service)).To(Succeed())
+	Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: service.Namespace, Name: service.Name}, service)).To(Succeed())
+	return service
+}
+
+// cleanupService는 테스트 Service와 NaverLoadBalancer를 삭제합니다 (envtest에는 GC가 없음)
+func cleanupService(ctx context.Context, service *corev1.Service) {
+	nlb := &naverv1alpha1.NaverLoadBalancer{}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: service.Namespace, Name: service.Name}, nlb); err == nil {
+		nlb.Finalizers = nil
+		_ = k8sClient.Update(ctx, nlb)
+		_ = k8sClient.Delete(ctx, nlb)
+	}
+	Expect(k8sClient.Delete(ctx, service)).To(Succeed())
+}
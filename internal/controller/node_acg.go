@@ -0,0 +1,304 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	naverv1alpha1 "github.com/suslmk-lee/kube-controller01/api/v1alpha1"
+)
+
+// desiredNodeAccessRules는 Service 포트별 NodePort와 헬스 체크 포트를 로드밸런서 서브넷 CIDR에서 허용하는 ACG 규칙을 만듭니다.
+// NETWORK 로드밸런서는 클라이언트 IP를 그대로 전달하므로 NodePort를 클라이언트 소스 범위에서도 허용합니다
+func desiredNodeAccessRules(acgNo, lbType string, subnetCIDRs, sourceRanges []string, service *corev1.Service) []naverv1alpha1.NodeAccessRuleStatus {
+	clientSources := sourceRanges
+	if len(clientSources) == 0 {
+		clientSources = []string{allSourcesCIDR}
+	}
+
+	var rules []naverv1alpha1.NodeAccessRuleStatus
+	seen := make(map[naverv1alpha1.NodeAccessRuleStatus]bool)
+	allow := func(protocol corev1.Protocol, nodePort int32, cidrs []string) {
+		if nodePort == 0 {
+			return
+		}
+		for _, cidr := range cidrs {
+			rule := naverv1alpha1.NodeAccessRuleStatus{
+				AccessControlGroupNo: acgNo,
+				Protocol:             string(protocol),
+				IPBlock:              cidr,
+				PortRange:            fmt.Sprintf("%d", nodePort),
+			}
+			if !seen[rule] {
+				seen[rule] = true
+				rules = append(rules, rule)
+			}
+		}
+	}
+
+	for _, port := range service.Spec.Ports {
+		protocol := servicePortProtocol(port)
+		allow(protocol, port.NodePort, subnetCIDRs)
+		if lbType == lbTypeNetwork {
+			allow(protocol, port.NodePort, clientSources)
+		}
+		// 헬스 체크는 HTTP/HTTPS도 TCP 연결이므로 UDP 포트여도 TCP로 허용
+		allow(corev1.ProtocolTCP, healthCheckForPort(service, lbType, port).Port, subnetCIDRs)
+	}
+	return rules
+}
+
+// syncNodeAccessRules는 NAVER_CLOUD_NODE_ACG_NO가 설정되면 로드밸런서가 사용하는 NodePort를 워커 노드 ACG에서 허용합니다.
+// 새 규칙을 먼저 추가한 뒤 필요 없는 규칙을 제거하며, 사용자가 직접 추가한 규칙은 기록하거나 제거하지 않습니다
+func (r *ServiceReconciler) syncNodeAccessRules(ctx context.Context, client NaverCloudClient, credentials *NaverCloudCredentials, service *corev1.Service, nlb *naverv1alpha1.NaverLoadBalancer) error {
+	acgNo := credentials.NodeAccessControlGroupNo
+	if acgNo == "" && len(nlb.Status.NodeAccessRules) == 0 {
+		return nil
+	}
+	logger := log.FromContext(ctx).WithValues("lb-id", nlb.Status.LoadBalancerNo)
+
+	var desired []naverv1alpha1.NodeAccessRuleStatus
+	if acgNo != "" {
+		lbType, err := loadBalancerType(service)
+		if err != nil {
+			return err
+		}
+		sourceRanges, err := sourceRangesForService(service)
+		if err != nil {
+			return err
+		}
+		subnets, err := r.lookupLoadBalancerSubnets(client, credentials, nlb.Status.SubnetNos)
+		if err != nil {
+			return err
+		}
+		var subnetCIDRs []string
+		for _, subnet := range subnets {
+			if cidr := ncloud.StringValue(subnet.Subnet); cidr != "" && !containsString(subnetCIDRs, cidr) {
+				subnetCIDRs = append(subnetCIDRs, cidr)
+			}
+		}
+		desired = desiredNodeAccessRules(acgNo, lbType, subnetCIDRs, sourceRanges, service)
+	}
+
+	shared, err := r.nodeAccessRulesInUse(ctx, nlb)
+	if err != nil {
+		return err
+	}
+
+	// 1. 기록되지 않은 규칙 추가 (다른 로드밸런서가 추가한 규칙은 함께 사용)
+	recorded := make(map[naverv1alpha1.NodeAccessRuleStatus]bool, len(nlb.Status.NodeAccessRules))
+	for _, rule := range nlb.Status.NodeAccessRules {
+		recorded[rule] = true
+	}
+	var candidates []naverv1alpha1.NodeAccessRuleStatus
+	for _, rule := range desired {
+		if !recorded[rule] {
+			candidates = append(candidates, rule)
+		}
+	}
+
+	if len(candidates) > 0 {
+		existing, err := r.existingNodeAccessRules(client, credentials, acgNo)
+		if err != nil {
+			return err
+		}
+		var missing, owned []naverv1alpha1.NodeAccessRuleStatus
+		for _, rule := range candidates {
+			switch {
+			case !existing[rule]:
+				missing = append(missing, rule)
+				owned = append(owned, rule)
+			case shared[rule]:
+				owned = append(owned, rule)
+			}
+		}
+
+		if len(missing) > 0 {
+			if err := r.addNodeAccessRules(client, credentials, acgNo, missing, fmt.Sprintf("k8s %s/%s", service.Namespace, service.Name)); err != nil {
+				return err
+			}
+			logger.Info("워커 노드 ACG 규칙 추가 성공", "accessControlGroupNo", acgNo, "count", len(missing))
+		}
+		if len(owned) > 0 {
+			if err := r.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+				latest.Status.NodeAccessRules = append(latest.Status.NodeAccessRules, owned...)
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	// 2. 더 이상 필요 없는 규칙 제거 (다른 로드밸런서가 아직 사용하는 규칙은 남겨 둠)
+	wanted := make(map[naverv1alpha1.NodeAccessRuleStatus]bool, len(desired))
+	for _, rule := range desired {
+		wanted[rule] = true
+	}
+	var released, stale []naverv1alpha1.NodeAccessRuleStatus
+	for _, rule := range nlb.Status.NodeAccessRules {
+		if wanted[rule] {
+			continue
+		}
+		released = append(released, rule)
+		if !shared[rule] {
+			stale = append(stale, rule)
+		}
+	}
+	if len(released) == 0 {
+		return nil
+	}
+	if len(stale) > 0 {
+		if err := r.removeNodeAccessRules(client, credentials, stale); err != nil {
+			return err
+		}
+		logger.Info("워커 노드 ACG 규칙 제거 성공", "count", len(stale))
+	}
+
+	return r.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+		var kept []naverv1alpha1.NodeAccessRuleStatus
+		for _, rule := range latest.Status.NodeAccessRules {
+			if wanted[rule] {
+				kept = append(kept, rule)
+			}
+		}
+		latest.Status.NodeAccessRules = kept
+	})
+}
+
+// releaseNodeAccessRules는 로드밸런서 삭제 시 기록된 워커 노드 ACG 규칙 중 다른 로드밸런서가 쓰지 않는 규칙을 제거합니다
+func (r *ServiceReconciler) releaseNodeAccessRules(ctx context.Context, client NaverCloudClient, credentials *NaverCloudCredentials, nlb *naverv1alpha1.NaverLoadBalancer) error {
+	shared, err := r.nodeAccessRulesInUse(ctx, nlb)
+	if err != nil {
+		return err
+	}
+	var stale []naverv1alpha1.NodeAccessRuleStatus
+	for _, rule := range nlb.Status.NodeAccessRules {
+		if !shared[rule] {
+			stale = append(stale, rule)
+		}
+	}
+	if len(stale) > 0 {
+		if err := r.removeNodeAccessRules(client, credentials, stale); err != nil {
+			return err
+		}
+	}
+	return r.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+		latest.Status.NodeAccessRules = nil
+	})
+}
+
+// nodeAccessRulesInUse는 다른 NaverLoadBalancer가 기록한 워커 노드 ACG 규칙을 반환합니다
+func (r *ServiceReconciler) nodeAccessRulesInUse(ctx context.Context, nlb *naverv1alpha1.NaverLoadBalancer) (map[naverv1alpha1.NodeAccessRuleStatus]bool, error) {
+	var nlbList naverv1alpha1.NaverLoadBalancerList
+	if err := r.List(ctx, &nlbList); err != nil {
+		return nil, fmt.Errorf("NaverLoadBalancer 목록 조회 실패: %w", err)
+	}
+
+	inUse := make(map[naverv1alpha1.NodeAccessRuleStatus]bool)
+	for _, other := range nlbList.Items {
+		if other.Namespace == nlb.Namespace && other.Name == nlb.Name {
+			continue
+		}
+		for _, rule := range other.Status.NodeAccessRules {
+			inUse[rule] = true
+		}
+	}
+	return inUse, nil
+}
+
+// existingNodeAccessRules는 워커 노드 ACG에 이미 있는 인바운드 규칙을 반환합니다
+func (r *ServiceReconciler) existingNodeAccessRules(client NaverCloudClient, credentials *NaverCloudCredentials, acgNo string) (map[naverv1alpha1.NodeAccessRuleStatus]bool, error) {
+	resp, err := client.GetAccessControlGroupRuleList(&vserver.GetAccessControlGroupRuleListRequest{
+		RegionCode:                     ncloud.String(credentials.Region),
+		AccessControlGroupNo:           ncloud.String(acgNo),
+		AccessControlGroupRuleTypeCode: ncloud.String("INBND"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ACG 규칙 목록 조회 실패: %w", err)
+	}
+
+	existing := make(map[naverv1alpha1.NodeAccessRuleStatus]bool)
+	if resp != nil {
+		for _, rule := range resp.AccessControlGroupRuleList {
+			if rule == nil || rule.ProtocolType == nil {
+				continue
+			}
+			existing[naverv1alpha1.NodeAccessRuleStatus{
+				AccessControlGroupNo: acgNo,
+				Protocol:             ncloud.StringValue(rule.ProtocolType.Code),
+				IPBlock:              ncloud.StringValue(rule.IpBlock),
+				PortRange:            ncloud.StringValue(rule.PortRange),
+			}] = true
+		}
+	}
+	return existing, nil
+}
+
+// addNodeAccessRules는 워커 노드 ACG에 인바운드 규칙을 추가합니다
+func (r *ServiceReconciler) addNodeAccessRules(client NaverCloudClient, credentials *NaverCloudCredentials, acgNo string, rules []naverv1alpha1.NodeAccessRuleStatus, description string) error {
+	params := make([]*vserver.AddAccessControlGroupRuleParameter, 0, len(rules))
+	for _, rule := range rules {
+		params = append(params, &vserver.AddAccessControlGroupRuleParameter{
+			ProtocolTypeCode:                  ncloud.String(rule.Protocol),
+			IpBlock:                           ncloud.String(rule.IPBlock),
+			PortRange:                         ncloud.String(rule.PortRange),
+			AccessControlGroupRuleDescription: ncloud.String(description),
+		})
+	}
+	if _, err := client.AddAccessControlGroupInboundRule(&vserver.AddAccessControlGroupInboundRuleRequest{
+		RegionCode:                 ncloud.String(credentials.Region),
+		VpcNo:                      ncloud.String(credentials.VpcNo),
+		AccessControlGroupNo:       ncloud.String(acgNo),
+		AccessControlGroupRuleList: params,
+	}); err != nil {
+		return fmt.Errorf("ACG 규칙 추가 실패: %w", err)
+	}
+	return nil
+}
+
+// removeNodeAccessRules는 워커 노드 ACG 규칙을 ACG별로 제거합니다
+func (r *ServiceReconciler) removeNodeAccessRules(client NaverCloudClient, credentials *NaverCloudCredentials, rules []naverv1alpha1.NodeAccessRuleStatus) error {
+	params := make(map[string][]*vserver.RemoveAccessControlGroupRuleParameter)
+	var acgNos []string
+	for _, rule := range rules {
+		if _, ok := params[rule.AccessControlGroupNo]; !ok {
+			acgNos = append(acgNos, rule.AccessControlGroupNo)
+		}
+		params[rule.AccessControlGroupNo] = append(params[rule.AccessControlGroupNo], &vserver.RemoveAccessControlGroupRuleParameter{
+			ProtocolTypeCode: ncloud.String(rule.Protocol),
+			IpBlock:          ncloud.String(rule.IPBlock),
+			PortRange:        ncloud.String(rule.PortRange),
+		})
+	}
+
+	for _, acgNo := range acgNos {
+		if _, err := client.RemoveAccessControlGroupInboundRule(&vserver.RemoveAccessControlGroupInboundRuleRequest{
+			RegionCode:                 ncloud.String(credentials.Region),
+			VpcNo:                      ncloud.String(credentials.VpcNo),
+			AccessControlGroupNo:       ncloud.String(acgNo),
+			AccessControlGroupRuleList: params[acgNo],
+		}); err != nil {
+			return fmt.Errorf("ACG 규칙 제거 실패: %w", err)
+		}
+	}
+	return nil
+}
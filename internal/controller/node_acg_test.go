@@ -0,0 +1,158 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	naverv1alpha1 "github.com/suslmk-lee/kube-controller01/api/v1alpha1"
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("Node ACG Tests", func() {
+	var (
+		reconciler *ServiceReconciler
+		mockClient *navercloud.MockClient
+		ctx        context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockClient = navercloud.NewMockClient()
+		mockClient.AddMockSubnet("subnet-67890", "vpc-12345", "KR-1", "PRIVATE", "LOADB")
+		mockClient.Subnets[0].Subnet = ncloud.String("10.0.100.0/24")
+		// 사용자가 직접 추가한 규칙
+		mockClient.ACGRules["acg-node"] = []vserver.AccessControlGroupRule{
+			{ProtocolType: &vserver.ProtocolType{Code: ncloud.String("TCP")}, IpBlock: ncloud.String("10.0.0.0/16"), PortRange: ncloud.String("22")},
+		}
+
+		reconciler = &ServiceReconciler{
+			Client: k8sClient,
+			NaverCloudConfig: NaverCloudConfig{
+				APIKey:                   "test-api-key",
+				APISecret:                "test-api-secret",
+				Region:                   "KR",
+				VpcNo:                    "vpc-12345",
+				SubnetNo:                 "subnet-67890",
+				NodeAccessControlGroupNo: "acg-node",
+			},
+			NaverClient: mockClient,
+		}
+	})
+
+	Context("When building the node ACG rules", func() {
+		It("should allow the NodePort and health check port from the load balancer subnets", func() {
+			service := &corev1.Service{Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{
+				{Port: 80, NodePort: 30080, Protocol: corev1.ProtocolTCP},
+			}}}
+			Expect(desiredNodeAccessRules("acg-node", lbTypeNetworkProxy, []string{"10.0.100.0/24"}, nil, service)).To(Equal([]naverv1alpha1.NodeAccessRuleStatus{
+				{AccessControlGroupNo: "acg-node", Protocol: "TCP", IPBlock: "10.0.100.0/24", PortRange: "30080"},
+			}))
+
+			By("Adding the HealthCheckNodePort for externalTrafficPolicy: Local")
+			service.Spec.ExternalTrafficPolicy = corev1.ServiceExternalTrafficPolicyLocal
+			service.Spec.HealthCheckNodePort = 32000
+			Expect(desiredNodeAccessRules("acg-node", lbTypeNetworkProxy, []string{"10.0.100.0/24"}, nil, service)).To(ConsistOf(
+				HaveField("PortRange", "30080"),
+				HaveField("PortRange", "32000"),
+			))
+		})
+
+		It("should also allow client sources for NETWORK load balancers", func() {
+			service := &corev1.Service{Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{
+				{Port: 53, NodePort: 30053, Protocol: corev1.ProtocolUDP},
+				{Port: 53, NodePort: 30054, Protocol: corev1.ProtocolTCP},
+			}}}
+			rules := desiredNodeAccessRules("acg-node", lbTypeNetwork, []string{"10.0.100.0/24"}, []string{"203.0.113.0/24"}, service)
+			Expect(rules).To(ConsistOf(
+				naverv1alpha1.NodeAccessRuleStatus{AccessControlGroupNo: "acg-node", Protocol: "UDP", IPBlock: "10.0.100.0/24", PortRange: "30053"},
+				naverv1alpha1.NodeAccessRuleStatus{AccessControlGroupNo: "acg-node", Protocol: "UDP", IPBlock: "203.0.113.0/24", PortRange: "30053"},
+				naverv1alpha1.NodeAccessRuleStatus{AccessControlGroupNo: "acg-node", Protocol: "TCP", IPBlock: "10.0.100.0/24", PortRange: "30054"},
+				naverv1alpha1.NodeAccessRuleStatus{AccessControlGroupNo: "acg-node", Protocol: "TCP", IPBlock: "203.0.113.0/24", PortRange: "30054"},
+			))
+		})
+	})
+
+	Context("When the node ACG is managed", func() {
+		It("should add the NodePort rules and remove them when the Service is deleted", func() {
+			service := newService(ctx, "node-acg-service", nil, 80)
+			defer cleanupService(ctx, service)
+			nodePort := fmt.Sprintf("%d", service.Spec.Ports[0].NodePort)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+
+			nlb, err := reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Status.NodeAccessRules).To(Equal([]naverv1alpha1.NodeAccessRuleStatus{
+				{AccessControlGroupNo: "acg-node", Protocol: "TCP", IPBlock: "10.0.100.0/24", PortRange: nodePort},
+			}))
+			Expect(mockClient.ACGRules["acg-node"]).To(HaveLen(2))
+
+			By("Leaving the rules unchanged on the next reconcile")
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.AddACGRuleCalled).To(Equal(1))
+
+			Expect(reconciler.deleteNaverCloudLB(ctx, service)).To(Succeed())
+			Expect(mockClient.RemoveACGRuleCalled).To(Equal(1))
+			Expect(mockClient.ACGRules["acg-node"]).To(HaveLen(1))
+		})
+
+		It("should keep rules another load balancer still uses and never record user rules", func() {
+			service := newService(ctx, "node-acg-shared-service", nil, 80)
+			defer cleanupService(ctx, service)
+			other := newService(ctx, "node-acg-other-service", nil, 80)
+			defer cleanupService(ctx, other)
+
+			shared := naverv1alpha1.NodeAccessRuleStatus{AccessControlGroupNo: "acg-node", Protocol: "TCP", IPBlock: "10.0.100.0/24", PortRange: "10256"}
+			user := naverv1alpha1.NodeAccessRuleStatus{AccessControlGroupNo: "acg-node", Protocol: "TCP", IPBlock: "10.0.100.0/24", PortRange: "10257"}
+			Expect(reconciler.addNodeAccessRules(mockClient, &NaverCloudCredentials{Region: "KR"}, "acg-node",
+				[]naverv1alpha1.NodeAccessRuleStatus{shared, user}, "test")).To(Succeed())
+			otherNLB, err := reconciler.ensureNaverLoadBalancer(ctx, other)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reconciler.updateNaverLoadBalancerStatus(ctx, otherNLB, func(latest *naverv1alpha1.NaverLoadBalancer) {
+				latest.Status.NodeAccessRules = []naverv1alpha1.NodeAccessRuleStatus{shared}
+			})).To(Succeed())
+
+			// 두 포트 모두 헬스 체크 포트로 사용
+			service.Annotations = map[string]string{healthCheckPortAnnotation: "10256"}
+			service.Spec.Ports = append(service.Spec.Ports, corev1.ServicePort{
+				Name: "port-81", Port: 81, NodePort: service.Spec.Ports[0].NodePort + 1, Protocol: corev1.ProtocolTCP,
+			})
+			service.Annotations[healthCheckPortAnnotation+".port-81"] = "10257"
+
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+
+			nlb, err := reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Status.NodeAccessRules).To(ContainElement(shared))
+			Expect(nlb.Status.NodeAccessRules).NotTo(ContainElement(user))
+
+			Expect(reconciler.deleteNaverCloudLB(ctx, service)).To(Succeed())
+			Expect(mockClient.ACGRules["acg-node"]).To(ContainElement(HaveField("PortRange", HaveValue(Equal("10256")))))
+			Expect(mockClient.ACGRules["acg-node"]).To(ContainElement(HaveField("PortRange", HaveValue(Equal("10257")))))
+		})
+	})
+})
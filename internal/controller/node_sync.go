@@ -0,0 +1,277 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+
+	naverv1alpha1 "github.com/suslmk-lee/kube-controller01/api/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// excludeFromLoadBalancerLabel이 있는 노드는 로드밸런서 타겟에서 제외합니다 (Kubernetes 표준 레이블)
+const excludeFromLoadBalancerLabel = "node.kubernetes.io/exclude-from-external-load-balancers"
+
+// isTargetNode는 노드를 로드밸런서 타겟으로 등록할 수 있는지 확인합니다.
+// 마스터 노드, 삭제 중인 노드, Ready가 아닌 노드, cordon된 노드, 제외 레이블이 있는 노드는 제외합니다
+func (r *ServiceReconciler) isTargetNode(node *corev1.Node) bool {
+	if r.isMasterNode(node) || !node.DeletionTimestamp.IsZero() || node.Spec.Unschedulable {
+		return false
+	}
+	if _, excluded := node.Labels[excludeFromLoadBalancerLabel]; excluded {
+		return false
+	}
+	if r.getNodeInternalIP(node) == "" {
+		return false
+	}
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// targetInstanceNos는 타겟으로 등록할 노드들의 네이버 클라우드 인스턴스 번호를 반환합니다.
+// externalTrafficPolicy: Local인 Service는 준비된 엔드포인트가 있는 노드만 포함합니다.
+// 인스턴스 번호를 찾지 못한 노드가 있으면 complete가 false이며, 이때는 기존 타겟을 제거하지 않아야 합니다
+func (r *ServiceReconciler) targetInstanceNos(ctx context.Context, client NaverCloudClient, service *corev1.Service) (instanceNos []string, complete bool, err error) {
+	logger := log.FromContext(ctx)
+
+	var nodeList corev1.NodeList
+	if err := r.List(ctx, &nodeList); err != nil {
+		return nil, false, fmt.Errorf("노드 목록 조회 실패: %w", err)
+	}
+
+	var endpointNodes map[string]bool
+	if service != nil && isLocalTrafficPolicy(service) {
+		endpointNodes, err = r.readyEndpointNodes(ctx, service)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	complete = true
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		if !r.isTargetNode(node) {
+			logger.V(1).Info("타겟 대상이 아닌 노드 제외", "node", node.Name)
+			continue
+		}
+		if endpointNodes != nil && !endpointNodes[node.Name] {
+			logger.V(1).Info("준비된 로컬 엔드포인트가 없는 노드 제외", "node", node.Name)
+			continue
+		}
+
+		nodeIP := r.getNodeInternalIP(node)
+
+		// 1. 노드 메타데이터에서 인스턴스 번호 확인, 2. 없으면 IP로 API 검색
+		instanceNo := r.getNaverCloudInstanceNo(node)
+		if instanceNo == "" {
+			apiInstanceNo, err := r.getNaverCloudInstanceNoByIP(ctx, client, nodeIP)
+			if err != nil {
+				logger.Error(err, "API를 통한 인스턴스 번호 찾기 실패", "node", node.Name, "ip", nodeIP)
+				complete = false
+				continue
+			}
+			instanceNo = apiInstanceNo
+		}
+
+		if !containsString(instanceNos, instanceNo) {
+			instanceNos = append(instanceNos, instanceNo)
+		}
+	}
+
+	return instanceNos, complete, nil
+}
+
+// syncTargetGroupTargets는 타겟 그룹의 타겟을 현재 노드 목록에 맞춥니다.
+// 새 노드는 AddTarget으로 추가하고, 떠났거나 NotReady/cordon된 노드는 RemoveTarget으로 제거합니다
+func (r *ServiceReconciler) syncTargetGroupTargets(ctx context.Context, client NaverCloudClient, credentials *NaverCloudCredentials, targetGroupID string, instanceNos []string, complete bool) error {
+	logger := log.FromContext(ctx).WithValues("targetGroupID", targetGroupID)
+
+	targetResp, err := client.GetTargetList(&vloadbalancer.GetTargetListRequest{
+		RegionCode:    ncloud.String(credentials.Region),
+		TargetGroupNo: ncloud.String(targetGroupID),
+	})
+	if err != nil {
+		return fmt.Errorf("타겟 목록 조회 실패: %w", err)
+	}
+
+	var registered []string
+	if targetResp != nil {
+		for _, target := range targetResp.TargetList {
+			if target.TargetNo != nil {
+				registered = append(registered, *target.TargetNo)
+			}
+		}
+	}
+
+	var toAdd []string
+	for _, instanceNo := range instanceNos {
+		if !containsString(registered, instanceNo) {
+			toAdd = append(toAdd, instanceNo)
+		}
+	}
+
+	// 인스턴스 번호를 모두 확인하지 못했거나 등록할 노드가 하나도 없으면 트래픽 중단을 막기 위해 제거하지 않음
+	var toRemove []string
+	if complete && len(instanceNos) > 0 {
+		for _, targetNo := range registered {
+			if !containsString(instanceNos, targetNo) {
+				toRemove = append(toRemove, targetNo)
+			}
+		}
+	} else {
+		logger.Info("노드 정보가 불완전하여 타겟 제거 생략", "complete", complete, "nodeCount", len(instanceNos))
+	}
+
+	if len(toAdd) > 0 {
+		logger.Info("새 노드를 타겟으로 추가", "targets", toAdd)
+		if err := r.addTargets(ctx, client, targetGroupID, toAdd); err != nil {
+			return err
+		}
+	}
+
+	if len(toRemove) > 0 {
+		logger.Info("제외된 노드를 타겟에서 제거", "targets", toRemove)
+		removeReq := vloadbalancer.RemoveTargetRequest{
+			RegionCode:    ncloud.String(credentials.Region),
+			TargetGroupNo: ncloud.String(targetGroupID),
+		}
+		for _, targetNo := range toRemove {
+			removeReq.TargetNoList = append(removeReq.TargetNoList, ncloud.String(targetNo))
+		}
+		if _, err := client.RemoveTarget(&removeReq); err != nil {
+			return fmt.Errorf("타겟 제거 실패: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// syncServiceTargets는 Service의 모든 타겟 그룹 타겟을 현재 노드 목록에 맞춥니다.
+// 채택한 타겟 그룹(adopted)에서는 클러스터 노드 타겟만 관리합니다.
+// 반환값은 타겟으로 등록한 노드 인스턴스 번호이며, 등록할 노드가 없어 제거를 생략했으면 nil(기록 유지)입니다.
+// 노드 정보가 불완전하면 새 노드는 추가하되 제거는 생략하여 실제 등록된 타겟을 알 수 없으므로 빈 목록(기록 삭제)을 반환합니다
+func (r *ServiceReconciler) syncServiceTargets(ctx context.Context, client NaverCloudClient, credentials *NaverCloudCredentials, service *corev1.Service, targetGroupIDs []string, adopted map[string]bool) ([]string, error) {
+	instanceNos, complete, err := r.targetInstanceNos(ctx, client, service)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range service.Spec.Ports {
+		if i >= len(targetGroupIDs) || targetGroupIDs[i] == "" {
+			continue
+		}
+		targets, targetsComplete := instanceNos, complete
+		if adopted[targetGroupIDs[i]] {
+			if targets, targetsComplete, err = r.adoptedTargetGroupTargets(ctx, client, credentials, targetGroupIDs[i], instanceNos, complete); err != nil {
+				return nil, err
+			}
+		}
+		if err := r.syncTargetGroupTargets(ctx, client, credentials, targetGroupIDs[i], targets, targetsComplete); err != nil {
+			return nil, err
+		}
+	}
+
+	if !complete {
+		return []string{}, nil
+	}
+	if len(instanceNos) == 0 {
+		return nil, nil
+	}
+	return instanceNos, nil
+}
+
+// mapNodeToServices는 노드 변경 시 타겟 그룹 구성이 바뀔 수 있는 Service를 조정 대상으로 반환합니다.
+// externalTrafficPolicy: Local인 Service는 준비된 엔드포인트가 있는 노드만 타겟으로 쓰므로
+// 그 노드가 바뀐 경우에만 포함합니다 (엔드포인트 이동은 EndpointSlice 이벤트로 조정)
+func (r *ServiceReconciler) mapNodeToServices(ctx context.Context, node client.Object) []reconcile.Request {
+	logger := log.FromContext(ctx)
+
+	var nlbList naverv1alpha1.NaverLoadBalancerList
+	if err := r.List(ctx, &nlbList); err != nil {
+		logger.Error(err, "NaverLoadBalancer 목록 조회 실패")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(nlbList.Items))
+	for _, nlb := range nlbList.Items {
+		if len(nlb.Status.TargetGroups) == 0 {
+			continue
+		}
+		key := types.NamespacedName{Namespace: nlb.Namespace, Name: nlb.Spec.ServiceName}
+		if !r.nodeAffectsServiceTargets(ctx, key, node) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: key})
+	}
+	return requests
+}
+
+// nodeAffectsServiceTargets는 노드 변경이 Service의 타겟 구성을 바꿀 수 있는지 확인합니다.
+// 확인할 수 없으면 타겟 동기화를 놓치지 않도록 true를 반환합니다
+func (r *ServiceReconciler) nodeAffectsServiceTargets(ctx context.Context, key types.NamespacedName, node client.Object) bool {
+	var service corev1.Service
+	if err := r.Get(ctx, key, &service); err != nil {
+		return true
+	}
+	if !isLocalTrafficPolicy(&service) {
+		return true
+	}
+
+	endpointNodes, err := r.readyEndpointNodes(ctx, &service)
+	if err != nil {
+		log.FromContext(ctx).V(1).Info("Service의 엔드포인트 노드 조회 실패", "service", key, "error", err.Error())
+		return true
+	}
+	return endpointNodes[node.GetName()]
+}
+
+// nodeTargetChangedPredicate는 타겟 등록 여부나 인스턴스 식별 정보가 바뀐 노드 이벤트만 통과시킵니다
+func (r *ServiceReconciler) nodeTargetChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldNode, ok := e.ObjectOld.(*corev1.Node)
+			if !ok {
+				return false
+			}
+			newNode, ok := e.ObjectNew.(*corev1.Node)
+			if !ok {
+				return false
+			}
+			return r.isTargetNode(oldNode) != r.isTargetNode(newNode) ||
+				r.getNodeInternalIP(oldNode) != r.getNodeInternalIP(newNode) ||
+				r.getNaverCloudInstanceNo(oldNode) != r.getNaverCloudInstanceNo(newNode)
+		},
+		GenericFunc: func(event.GenericEvent) bool {
+			return false
+		},
+	}
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+var _ = Describe("Node Target Sync Tests", func() {
+	var (
+		reconciler  *ServiceReconciler
+		mockClient  *navercloud.MockClient
+		ctx         context.Context
+		credentials *NaverCloudCredentials
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockClient = navercloud.NewMockClient()
+
+		reconciler = &ServiceReconciler{
+			Client: k8sClient,
+			NaverCloudConfig: NaverCloudConfig{
+				APIKey:    "test-api-key",
+				APISecret: "test-api-secret",
+				Region:    "KR",
+				VpcNo:     "vpc-12345",
+				SubnetNo:  "subnet-67890",
+			},
+			NaverClient: mockClient,
+		}
+
+		var err error
+		credentials, err = reconciler.getCredentials(ctx)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	readyNode := func(name, instanceNo, ip string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       corev1.NodeSpec{ProviderID: "ncloud:///KR-1/" + instanceNo},
+			Status: corev1.NodeStatus{
+				Addresses:  []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: ip}},
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+			},
+		}
+	}
+
+	createNode := func(node *corev1.Node) *corev1.Node {
+		status := node.Status
+		Expect(k8sClient.Create(ctx, node)).To(Succeed())
+		node.Status = status
+		Expect(k8sClient.Status().Update(ctx, node)).To(Succeed())
+		return node
+	}
+
+	Context("When deciding which nodes receive traffic", func() {
+		It("should only accept ready, schedulable worker nodes", func() {
+			Expect(reconciler.isTargetNode(readyNode("worker", "1001", "10.0.1.10"))).To(BeTrue())
+
+			master := readyNode("master", "1000", "10.0.1.1")
+			master.Labels = map[string]string{"node-role.kubernetes.io/control-plane": ""}
+			Expect(reconciler.isTargetNode(master)).To(BeFalse())
+
+			cordoned := readyNode("cordoned", "1002", "10.0.1.12")
+			cordoned.Spec.Unschedulable = true
+			Expect(reconciler.isTargetNode(cordoned)).To(BeFalse())
+
+			notReady := readyNode("not-ready", "1003", "10.0.1.13")
+			notReady.Status.Conditions[0].Status = corev1.ConditionFalse
+			Expect(reconciler.isTargetNode(notReady)).To(BeFalse())
+
+			unknown := readyNode("unknown", "1004", "10.0.1.14")
+			unknown.Status.Conditions = nil
+			Expect(reconciler.isTargetNode(unknown)).To(BeFalse())
+
+			excluded := readyNode("excluded", "1005", "10.0.1.15")
+			excluded.Labels = map[string]string{excludeFromLoadBalancerLabel: ""}
+			Expect(reconciler.isTargetNode(excluded)).To(BeFalse())
+
+			noIP := readyNode("no-ip", "1006", "")
+			noIP.Status.Addresses = nil
+			Expect(reconciler.isTargetNode(noIP)).To(BeFalse())
+		})
+
+		It("should only pass node updates that change target membership", func() {
+			pred := reconciler.nodeTargetChangedPredicate()
+			oldNode := readyNode("worker", "1001", "10.0.1.10")
+
+			relabeled := oldNode.DeepCopy()
+			relabeled.Labels = map[string]string{"team": "a"}
+			Expect(pred.Update(event.UpdateEvent{ObjectOld: oldNode, ObjectNew: relabeled})).To(BeFalse())
+
+			cordoned := oldNode.DeepCopy()
+			cordoned.Spec.Unschedulable = true
+			Expect(pred.Update(event.UpdateEvent{ObjectOld: oldNode, ObjectNew: cordoned})).To(BeTrue())
+
+			Expect(pred.Create(event.CreateEvent{Object: oldNode})).To(BeTrue())
+			Expect(pred.Delete(event.DeleteEvent{Object: oldNode})).To(BeTrue())
+		})
+	})
+
+	Context("When nodes join, leave or are cordoned", func() {
+		It("should add and remove target group targets", func() {
+			first := createNode(readyNode("sync-worker-1", "1001", "10.0.1.10"))
+			defer func() { Expect(k8sClient.Delete(ctx, first)).To(Succeed()) }()
+			second := createNode(readyNode("sync-worker-2", "1002", "10.0.1.11"))
+			defer func() { Expect(k8sClient.Delete(ctx, second)).To(Succeed()) }()
+
+			// 이미 삭제된 노드(9999)가 타겟으로 남아 있는 상태
+			mockClient.Targets["tg-sync"] = []string{"1001", "9999"}
+
+			instanceNos, complete, err := reconciler.targetInstanceNos(ctx, mockClient, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(complete).To(BeTrue())
+			Expect(reconciler.syncTargetGroupTargets(ctx, mockClient, credentials, "tg-sync", instanceNos, complete)).To(Succeed())
+			Expect(mockClient.Targets["tg-sync"]).To(ConsistOf("1001", "1002"))
+			Expect(mockClient.RemoveTargetCalled).To(Equal(1))
+
+			By("Cordoning the second node")
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: second.Name}, second)).To(Succeed())
+			second.Spec.Unschedulable = true
+			Expect(k8sClient.Update(ctx, second)).To(Succeed())
+
+			instanceNos, complete, err = reconciler.targetInstanceNos(ctx, mockClient, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reconciler.syncTargetGroupTargets(ctx, mockClient, credentials, "tg-sync", instanceNos, complete)).To(Succeed())
+			Expect(mockClient.Targets["tg-sync"]).To(ConsistOf("1001"))
+		})
+
+		It("should not remove targets when node instances could not be resolved", func() {
+			mockClient.Targets["tg-partial"] = []string{"1001", "1002"}
+
+			Expect(reconciler.syncTargetGroupTargets(ctx, mockClient, credentials, "tg-partial", []string{"1001"}, false)).To(Succeed())
+			Expect(reconciler.syncTargetGroupTargets(ctx, mockClient, credentials, "tg-partial", nil, true)).To(Succeed())
+			Expect(mockClient.RemoveTargetCalled).To(Equal(0))
+			Expect(mockClient.Targets["tg-partial"]).To(ConsistOf("1001", "1002"))
+		})
+	})
+
+	Context("When mapping node events to Services", func() {
+		It("should enqueue every Service with managed target groups", func() {
+			managed := newService(ctx, "node-map-managed-service", nil, 80)
+			defer cleanupService(ctx, managed)
+			nlb, err := reconciler.ensureNaverLoadBalancer(ctx, managed)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reconciler.recordTargetGroup(ctx, nlb, managed, managed.Spec.Ports[0], "tg-map", "tg-map")).To(Succeed())
+
+			pending := newService(ctx, "node-map-pending-service", nil, 80)
+			defer cleanupService(ctx, pending)
+			_, err = reconciler.ensureNaverLoadBalancer(ctx, pending)
+			Expect(err).NotTo(HaveOccurred())
+
+			requests := reconciler.mapNodeToServices(ctx, readyNode("worker", "1001", "10.0.1.10"))
+			Expect(requests).To(ContainElement(HaveField("NamespacedName", types.NamespacedName{Namespace: "default", Name: managed.Name})))
+			Expect(requests).NotTo(ContainElement(HaveField("NamespacedName", types.NamespacedName{Namespace: "default", Name: pending.Name})))
+		})
+
+		It("should enqueue a Local policy Service only for nodes with ready endpoints", func() {
+			local := newService(ctx, "node-map-local-service", nil, 80)
+			defer cleanupService(ctx, local)
+			local.Spec.ExternalTrafficPolicy = corev1.ServiceExternalTrafficPolicyLocal
+			Expect(k8sClient.Update(ctx, local)).To(Succeed())
+			nlb, err := reconciler.ensureNaverLoadBalancer(ctx, local)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reconciler.recordTargetGroup(ctx, nlb, local, local.Spec.Ports[0], "tg-map-local", "tg-map-local")).To(Succeed())
+
+			nodeWithPod := "worker-with-pod"
+			slice := &discoveryv1.EndpointSlice{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "node-map-local-service-abc",
+					Namespace: "default",
+					Labels:    map[string]string{discoveryv1.LabelServiceName: local.Name},
+				},
+				AddressType: discoveryv1.AddressTypeIPv4,
+				Endpoints: []discoveryv1.Endpoint{
+					{Addresses: []string{"10.244.1.5"}, NodeName: &nodeWithPod},
+				},
+			}
+			Expect(k8sClient.Create(ctx, slice)).To(Succeed())
+			defer func() { Expect(k8sClient.Delete(ctx, slice)).To(Succeed()) }()
+
+			key := types.NamespacedName{Namespace: "default", Name: local.Name}
+			Expect(reconciler.mapNodeToServices(ctx, readyNode(nodeWithPod, "1001", "10.0.1.10"))).To(ContainElement(HaveField("NamespacedName", key)))
+			Expect(reconciler.mapNodeToServices(ctx, readyNode("worker-without-pod", "1002", "10.0.1.11"))).NotTo(ContainElement(HaveField("NamespacedName", key)))
+		})
+	})
+})
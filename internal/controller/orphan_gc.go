@@ -0,0 +1,251 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	naverv1alpha1 "github.com/suslmk-lee/kube-controller01/api/v1alpha1"
+)
+
+// OrphanCollector는 VPC에서 이 클러스터의 컨트롤러가 만들었지만 어떤 Service도 사용하지 않는 로드밸런서와 타겟 그룹을 주기적으로 정리합니다.
+// 생성 도중 컨트롤러가 중단되었거나 삭제 중 타겟 그룹 삭제를 포기한 경우 남는 리소스가 대상이며,
+// 설명에 다른 클러스터 이름이 기록된 리소스와 클러스터 이름을 기록하기 전 형식의 리소스는 건드리지 않으며,
+// 클러스터 이름이 없으면 같은 VPC의 다른 클러스터 리소스와 구분할 수 없으므로 DryRun과 같이 보고만 합니다
+type OrphanCollector struct {
+	Reconciler *ServiceReconciler
+
+	// Interval은 정리 주기입니다
+	Interval time.Duration
+	// GracePeriod는 사용하지 않는 리소스를 처음 발견한 뒤 삭제하기까지 기다리는 시간입니다 (생성 중인 리소스 보호)
+	GracePeriod time.Duration
+	// DryRun이면 삭제하지 않고 로그로만 알립니다
+	DryRun bool
+
+	// firstSeen은 사용하지 않는 리소스를 처음 발견한 시각입니다 (컨트롤러가 재시작되면 다시 유예 기간을 기다림)
+	firstSeen map[string]time.Time
+}
+
+// orphanResource는 정리 대상 리소스입니다
+type orphanResource struct {
+	Kind    string
+	No      string
+	Name    string
+	Service string
+}
+
+// key는 firstSeen에 사용할 키를 반환합니다
+func (o orphanResource) key() string {
+	return o.Kind + "/" + o.No
+}
+
+// Start는 Interval마다 고아 리소스를 정리합니다 (manager.Runnable)
+func (c *OrphanCollector) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("orphan-collector")
+	logger.Info("고아 리소스 정리 시작", "interval", c.Interval, "gracePeriod", c.GracePeriod, "dryRun", c.DryRun)
+
+	ticker := time.NewTicker(c.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := c.collect(log.IntoContext(ctx, logger), time.Now()); err != nil {
+				logger.Error(err, "고아 리소스 정리 실패")
+			}
+		}
+	}
+}
+
+// NeedLeaderElection은 리더만 정리하도록 합니다 (manager.LeaderElectionRunnable)
+func (c *OrphanCollector) NeedLeaderElection() bool {
+	return true
+}
+
+// collect는 고아 리소스를 찾아 유예 기간이 지난 것을 삭제(DryRun이면 보고)합니다
+func (c *OrphanCollector) collect(ctx context.Context, now time.Time) error {
+	logger := log.FromContext(ctx)
+
+	client, credentials, err := c.Reconciler.getNaverClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Service보다 클라우드 리소스를 먼저 조회 (조회 사이에 생성·기록된 리소스를 고아로 오인하지 않도록)
+	orphans, err := c.ownedResources(client, credentials)
+	if err != nil {
+		return err
+	}
+	referenced, err := c.referencedResources(ctx)
+	if err != nil {
+		return err
+	}
+
+	dryRun := c.DryRun || c.Reconciler.ClusterName == ""
+
+	if c.firstSeen == nil {
+		c.firstSeen = make(map[string]time.Time)
+	}
+	seen := make(map[string]time.Time)
+
+	for _, orphan := range orphans {
+		if referenced[orphan.No] {
+			continue
+		}
+		first, ok := c.firstSeen[orphan.key()]
+		if !ok {
+			first = now
+		}
+		seen[orphan.key()] = first
+		if now.Sub(first) < c.GracePeriod {
+			logger.Info("사용하지 않는 리소스 발견, 유예 기간 후 정리", "kind", orphan.Kind, "no", orphan.No, "name", orphan.Name, "service", orphan.Service)
+			continue
+		}
+		if dryRun {
+			logger.Info("고아 리소스 발견 (dry-run, 삭제하지 않음)", "kind", orphan.Kind, "no", orphan.No, "name", orphan.Name, "service", orphan.Service)
+			continue
+		}
+
+		if err := c.deleteOrphan(client, credentials, orphan); err != nil {
+			// 로드밸런서 삭제 중이거나 타겟 그룹이 아직 연결되어 있으면 다음 주기에 재시도
+			logger.Info("고아 리소스 삭제 실패, 다음 주기에 재시도", "kind", orphan.Kind, "no", orphan.No, "error", err.Error())
+			continue
+		}
+		logger.Info("고아 리소스 삭제 성공", "kind", orphan.Kind, "no", orphan.No, "name", orphan.Name, "service", orphan.Service)
+		delete(seen, orphan.key())
+	}
+
+	c.firstSeen = seen
+	return nil
+}
+
+// ownedResources는 VPC에서 이 클러스터의 컨트롤러가 만든 로드밸런서와 타겟 그룹을 조회합니다 (로드밸런서 먼저).
+// 이전 형식의 설명은 어느 클러스터가 만들었는지 알 수 없으므로 제외합니다
+func (c *OrphanCollector) ownedResources(client NaverCloudClient, credentials *NaverCloudCredentials) ([]orphanResource, error) {
+	var owned []orphanResource
+
+	lbResp, err := client.GetLoadBalancerInstanceList(&vloadbalancer.GetLoadBalancerInstanceListRequest{
+		RegionCode: ncloud.String(credentials.Region),
+		VpcNo:      ncloud.String(credentials.VpcNo),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("로드밸런서 목록 조회 실패: %w", err)
+	}
+	if lbResp != nil {
+		for _, lb := range lbResp.LoadBalancerInstanceList {
+			if lb == nil {
+				continue
+			}
+			owner, ok := c.Reconciler.ownedByCluster(ncloud.StringValue(lb.LoadBalancerDescription))
+			if !ok || owner.Legacy || ncloud.StringValue(lb.LoadBalancerInstanceNo) == "" {
+				continue
+			}
+			owned = append(owned, orphanResource{
+				Kind:    "LoadBalancer",
+				No:      ncloud.StringValue(lb.LoadBalancerInstanceNo),
+				Name:    ncloud.StringValue(lb.LoadBalancerName),
+				Service: owner.Namespace + "/" + owner.Name,
+			})
+		}
+	}
+
+	tgResp, err := client.GetTargetGroupList(&vloadbalancer.GetTargetGroupListRequest{
+		RegionCode: ncloud.String(credentials.Region),
+		VpcNo:      ncloud.String(credentials.VpcNo),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("타겟 그룹 목록 조회 실패: %w", err)
+	}
+	if tgResp != nil {
+		for _, tg := range tgResp.TargetGroupList {
+			if tg == nil {
+				continue
+			}
+			owner, ok := c.Reconciler.ownedByCluster(ncloud.StringValue(tg.TargetGroupDescription))
+			if !ok || owner.Legacy || ncloud.StringValue(tg.TargetGroupNo) == "" {
+				continue
+			}
+			owned = append(owned, orphanResource{
+				Kind:    "TargetGroup",
+				No:      ncloud.StringValue(tg.TargetGroupNo),
+				Name:    ncloud.StringValue(tg.TargetGroupName),
+				Service: owner.Namespace + "/" + owner.Name,
+			})
+		}
+	}
+
+	return owned, nil
+}
+
+// referencedResources는 NaverLoadBalancer 상태와 Service 어노테이션이 참조하는 로드밸런서와 타겟 그룹 번호를 반환합니다
+func (c *OrphanCollector) referencedResources(ctx context.Context) (map[string]bool, error) {
+	referenced := make(map[string]bool)
+
+	var nlbs naverv1alpha1.NaverLoadBalancerList
+	if err := c.Reconciler.List(ctx, &nlbs); err != nil {
+		return nil, fmt.Errorf("NaverLoadBalancer 목록 조회 실패: %w", err)
+	}
+	for _, nlb := range nlbs.Items {
+		if nlb.Status.LoadBalancerNo != "" {
+			referenced[nlb.Status.LoadBalancerNo] = true
+		}
+		for _, tgNo := range nlb.Status.TargetGroupNos() {
+			referenced[tgNo] = true
+		}
+	}
+
+	// 이전 버전이 Service 어노테이션에만 기록한 리소스
+	var services corev1.ServiceList
+	if err := c.Reconciler.List(ctx, &services); err != nil {
+		return nil, fmt.Errorf("Service 목록 조회 실패: %w", err)
+	}
+	for _, service := range services.Items {
+		if lbID := service.Annotations["naver.k-paas.org/lb-id"]; lbID != "" {
+			referenced[lbID] = true
+		}
+		for _, tgNo := range strings.Split(service.Annotations["naver.k-paas.org/target-groups"], ",") {
+			if tgNo != "" {
+				referenced[tgNo] = true
+			}
+		}
+	}
+
+	return referenced, nil
+}
+
+// deleteOrphan은 고아 리소스를 삭제합니다
+func (c *OrphanCollector) deleteOrphan(client NaverCloudClient, credentials *NaverCloudCredentials, orphan orphanResource) error {
+	if orphan.Kind == "LoadBalancer" {
+		return c.Reconciler.deleteLoadBalancer(client, credentials, orphan.No)
+	}
+	if _, err := client.DeleteTargetGroups(&vloadbalancer.DeleteTargetGroupsRequest{
+		RegionCode:        ncloud.String(credentials.Region),
+		TargetGroupNoList: []*string{ncloud.String(orphan.No)},
+	}); err != nil {
+		return fmt.Errorf("타겟 그룹 삭제 실패: %w", err)
+	}
+	return nil
+}
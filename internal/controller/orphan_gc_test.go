@@ -0,0 +1,178 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// emptyListClient는 목록 조회 응답이 비어 있는(nil) 경우를 흉내 내는 클라이언트입니다
+type emptyListClient struct {
+	*navercloud.MockClient
+}
+
+func (emptyListClient) GetLoadBalancerInstanceList(*vloadbalancer.GetLoadBalancerInstanceListRequest) (*vloadbalancer.GetLoadBalancerInstanceListResponse, error) {
+	return nil, nil
+}
+
+func (emptyListClient) GetTargetGroupList(*vloadbalancer.GetTargetGroupListRequest) (*vloadbalancer.GetTargetGroupListResponse, error) {
+	return nil, nil
+}
+
+var _ = Describe("Orphan Collector Tests", func() {
+	var (
+		reconciler *ServiceReconciler
+		mockClient *navercloud.MockClient
+		ctx        context.Context
+		live       *corev1.Service
+	)
+
+	targetGroupNos := func() []string {
+		var nos []string
+		for _, tg := range mockClient.TargetGroups {
+			nos = append(nos, *tg.TargetGroupNo)
+		}
+		return nos
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockClient = navercloud.NewMockClient()
+
+		reconciler = &ServiceReconciler{
+			Client: k8sClient,
+			NaverCloudConfig: NaverCloudConfig{
+				APIKey:    "test-api-key",
+				APISecret: "test-api-secret",
+				Region:    "KR",
+				VpcNo:     "vpc-12345",
+				SubnetNo:  "subnet-67890",
+			},
+			NaverClient: mockClient,
+			ClusterName: "cluster-a",
+		}
+		other := &ServiceReconciler{ClusterName: "cluster-b"}
+
+		By("Creating a Service that still uses its load balancer and target group")
+		live = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "orphan-gc-live",
+				Namespace: "default",
+				Annotations: map[string]string{
+					"naver.k-paas.org/lb-id":         "lb-live",
+					"naver.k-paas.org/target-groups": "tg-live",
+				},
+			},
+			Spec: corev1.ServiceSpec{
+				Type:  corev1.ServiceTypeLoadBalancer,
+				Ports: []corev1.ServicePort{{Port: 80, TargetPort: intstr.FromInt(8080)}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, live)).To(Succeed())
+
+		gone := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "orphan-gc-gone", Namespace: "default"}}
+		port := corev1.ServicePort{Port: 80}
+
+		mockClient.AddMockLoadBalancer("lb-live", "k8s-lb-orphan-gc-live", "Running")
+		mockClient.LoadBalancers[0].LoadBalancerDescription = ncloud.String(reconciler.loadBalancerDescription(live))
+		mockClient.AddMockLoadBalancer("lb-orphan", "k8s-lb-orphan-gc-gone", "Running")
+		mockClient.LoadBalancers[1].LoadBalancerDescription = ncloud.String(reconciler.loadBalancerDescription(gone))
+
+		for _, tg := range []struct{ no, description string }{
+			{"tg-live", reconciler.targetGroupDescription(live, port)},
+			{"tg-orphan", reconciler.targetGroupDescription(gone, port)},
+			{"tg-retained", reconciler.retainedResourceDescription(gone, time.Now())},
+			{"tg-other-cluster", other.targetGroupDescription(gone, port)},
+			{"tg-legacy", "Target group for default/orphan-gc-gone port 80"},
+			{"tg-foreign", "created by hand"},
+		} {
+			mockClient.AddMockTargetGroup(tg.no, tg.no, 80)
+			mockClient.TargetGroups[len(mockClient.TargetGroups)-1].TargetGroupDescription = ncloud.String(tg.description)
+		}
+	})
+
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, live)).To(Succeed())
+	})
+
+	It("should delete only unused controller resources after the grace period", func() {
+		collector := &OrphanCollector{Reconciler: reconciler, GracePeriod: time.Hour}
+		now := time.Now()
+
+		By("Waiting for the grace period on the first pass")
+		Expect(collector.collect(ctx, now)).To(Succeed())
+		Expect(mockClient.DeleteLBCalled).To(Equal(0))
+		Expect(mockClient.DeleteTGCalled).To(Equal(0))
+
+		By("Deleting the orphans once the grace period has passed")
+		Expect(collector.collect(ctx, now.Add(2*time.Hour))).To(Succeed())
+		Expect(mockClient.LoadBalancers).To(HaveLen(1))
+		Expect(*mockClient.LoadBalancers[0].LoadBalancerInstanceNo).To(Equal("lb-live"))
+		Expect(targetGroupNos()).To(ConsistOf("tg-live", "tg-retained", "tg-foreign", "tg-other-cluster", "tg-legacy"))
+	})
+
+	It("should only report orphans in dry-run mode", func() {
+		collector := &OrphanCollector{Reconciler: reconciler, GracePeriod: time.Hour, DryRun: true}
+		now := time.Now()
+
+		Expect(collector.collect(ctx, now)).To(Succeed())
+		Expect(collector.collect(ctx, now.Add(2*time.Hour))).To(Succeed())
+		Expect(mockClient.DeleteLBCalled).To(Equal(0))
+		Expect(mockClient.DeleteTGCalled).To(Equal(0))
+		Expect(mockClient.LoadBalancers).To(HaveLen(2))
+		Expect(mockClient.TargetGroups).To(HaveLen(6))
+	})
+
+	It("should only report orphans when the cluster has no name", func() {
+		unnamed := &ServiceReconciler{}
+		gone := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "orphan-gc-gone", Namespace: "default"}}
+		mockClient.AddMockTargetGroup("tg-unnamed", "tg-unnamed", 80)
+		mockClient.TargetGroups[len(mockClient.TargetGroups)-1].TargetGroupDescription = ncloud.String(unnamed.targetGroupDescription(gone, corev1.ServicePort{Port: 80}))
+		reconciler.ClusterName = ""
+
+		By("Never treating legacy descriptions as owned")
+		owned, err := (&OrphanCollector{Reconciler: reconciler}).ownedResources(mockClient, &NaverCloudCredentials{Region: "KR", VpcNo: "vpc-12345"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(owned).To(ConsistOf(HaveField("No", "tg-unnamed")))
+
+		By("Keeping the orphan even with dry-run off")
+		collector := &OrphanCollector{Reconciler: reconciler, GracePeriod: time.Hour}
+		now := time.Now()
+		Expect(collector.collect(ctx, now)).To(Succeed())
+		Expect(collector.collect(ctx, now.Add(2*time.Hour))).To(Succeed())
+		Expect(mockClient.DeleteTGCalled).To(Equal(0))
+		Expect(targetGroupNos()).To(ContainElements("tg-unnamed", "tg-legacy"))
+	})
+
+	It("should treat empty list responses as no resources", func() {
+		collector := &OrphanCollector{Reconciler: reconciler, GracePeriod: time.Hour}
+
+		owned, err := collector.ownedResources(emptyListClient{mockClient}, &NaverCloudCredentials{Region: "KR", VpcNo: "vpc-12345"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(owned).To(BeEmpty())
+	})
+})
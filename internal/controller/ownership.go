@@ -0,0 +1,159 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// ownershipDescriptionPrefix는 컨트롤러가 만든 리소스 설명의 시작입니다.
+// 설명은 "K-PaaS controller: cluster=<클러스터> service=<네임스페이스>/<이름> uid=<UID> [port=<포트>]" 형식입니다
+const ownershipDescriptionPrefix = "K-PaaS controller:"
+
+// 클러스터 이름을 기록하기 전 버전이 만든 리소스의 설명 형식.
+// 삭제 정책 Retain으로 남긴 리소스는 설명이 바뀌므로 어느 형식에도 해당하지 않음
+var (
+	legacyLoadBalancerDescription = regexp.MustCompile(`^Auto-created by K-PaaS controller for service ([^/\s]+)/(\S+)$`)
+	legacyTargetGroupDescription  = regexp.MustCompile(`^Target group for ([^/\s]+)/(\S+) port \d+$`)
+)
+
+// ValidateClusterName은 리소스 설명에 기록할 클러스터 이름을 검증합니다 (비어 있으면 이름 없는 클러스터)
+func ValidateClusterName(name string) error {
+	if name == "" {
+		return nil
+	}
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return fmt.Errorf("클러스터 이름이 올바르지 않음: %q (%s)", name, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// resourceOwner는 로드밸런서와 타겟 그룹의 설명에 기록한 소유 클러스터와 Service입니다
+type resourceOwner struct {
+	Cluster   string
+	Namespace string
+	Name      string
+	UID       types.UID
+	// Legacy는 클러스터와 UID가 없는 이전 형식의 설명이면 true입니다
+	Legacy bool
+}
+
+// String은 로그와 오류 메시지에 사용할 소유자 표기입니다
+func (o resourceOwner) String() string {
+	if o.Legacy {
+		return fmt.Sprintf("%s/%s (클러스터 미기록)", o.Namespace, o.Name)
+	}
+	cluster := o.Cluster
+	if cluster == "" {
+		cluster = "(이름 없음)"
+	}
+	return fmt.Sprintf("클러스터 %s의 %s/%s", cluster, o.Namespace, o.Name)
+}
+
+// resourceOwner는 이 컨트롤러의 클러스터에서 Service를 소유자로 나타냅니다
+func (r *ServiceReconciler) resourceOwner(service *corev1.Service) resourceOwner {
+	return resourceOwner{
+		Cluster:   r.ClusterName,
+		Namespace: service.Namespace,
+		Name:      service.Name,
+		UID:       service.UID,
+	}
+}
+
+// loadBalancerDescription은 컨트롤러가 만드는 로드밸런서의 설명입니다
+func (r *ServiceReconciler) loadBalancerDescription(service *corev1.Service) string {
+	owner := r.resourceOwner(service)
+	return fmt.Sprintf("%s cluster=%s service=%s/%s uid=%s",
+		ownershipDescriptionPrefix, owner.Cluster, owner.Namespace, owner.Name, owner.UID)
+}
+
+// targetGroupDescription은 컨트롤러가 만드는 타겟 그룹의 설명입니다
+func (r *ServiceReconciler) targetGroupDescription(service *corev1.Service, port corev1.ServicePort) string {
+	return fmt.Sprintf("%s port=%d", r.loadBalancerDescription(service), port.Port)
+}
+
+// parseResourceOwner는 리소스 설명에서 소유자를 읽습니다. 컨트롤러가 만든 리소스가 아니면 false를 반환합니다
+func parseResourceOwner(description string) (resourceOwner, bool) {
+	for _, legacy := range []*regexp.Regexp{legacyLoadBalancerDescription, legacyTargetGroupDescription} {
+		if match := legacy.FindStringSubmatch(description); match != nil {
+			return resourceOwner{Namespace: match[1], Name: match[2], Legacy: true}, true
+		}
+	}
+
+	fields, ok := strings.CutPrefix(description, ownershipDescriptionPrefix)
+	if !ok {
+		return resourceOwner{}, false
+	}
+	var owner resourceOwner
+	for _, field := range strings.Fields(fields) {
+		key, value, _ := strings.Cut(field, "=")
+		switch key {
+		case "cluster":
+			owner.Cluster = value
+		case "service":
+			owner.Namespace, owner.Name, _ = strings.Cut(value, "/")
+		case "uid":
+			owner.UID = types.UID(value)
+		case "port":
+			if _, err := strconv.ParseInt(value, 10, 32); err != nil {
+				return resourceOwner{}, false
+			}
+		default:
+			return resourceOwner{}, false
+		}
+	}
+	if owner.Namespace == "" || owner.Name == "" {
+		return resourceOwner{}, false
+	}
+	return owner, true
+}
+
+// ownedByCluster는 설명이 이 컨트롤러의 클러스터가 만든 리소스를 나타내는지 확인합니다.
+// 이전 형식의 설명은 클러스터 이름을 지정하지 않은 컨트롤러만 자기 것으로 봅니다
+func (r *ServiceReconciler) ownedByCluster(description string) (resourceOwner, bool) {
+	owner, ok := parseResourceOwner(description)
+	if !ok || owner.Cluster != r.ClusterName {
+		return owner, false
+	}
+	return owner, true
+}
+
+// ownedByService는 같은 이름으로 찾은 리소스를 이 Service가 만들었는지 확인합니다 (생성 응답을 받지 못한 경우의 재사용 판단용)
+func (r *ServiceReconciler) ownedByService(description string, service *corev1.Service) bool {
+	owner, ok := r.ownedByCluster(description)
+	if !ok || owner.Namespace != service.Namespace || owner.Name != service.Name {
+		return false
+	}
+	return owner.UID == "" || owner.UID == service.UID
+}
+
+// checkAdoptable은 어노테이션으로 지정한 리소스가 다른 클러스터 소유가 아닌지 확인합니다.
+// 사람이 만든 리소스나 이전 형식의 설명은 소유 클러스터를 알 수 없으므로 채택을 허용합니다
+func (r *ServiceReconciler) checkAdoptable(kind, no, description string) error {
+	owner, ok := parseResourceOwner(description)
+	if !ok || owner.Legacy || owner.Cluster == r.ClusterName {
+		return nil
+	}
+	return fmt.Errorf("%s %s는 %s가 소유하므로 채택할 수 없음", kind, no, owner)
+}
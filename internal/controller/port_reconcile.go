@@ -0,0 +1,303 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+
+	naverv1alpha1 "github.com/suslmk-lee/kube-controller01/api/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// reconcileServicePorts는 이미 생성된 로드밸런서의 타겟 그룹과 리스너를 Service 포트에 맞춥니다.
+// 새 포트에는 타겟 그룹과 리스너를 만들고, 제거된 포트의 리스너와 타겟 그룹은 삭제하며,
+// NodePort나 헬스 체크 프로토콜이 바뀐 포트는 새 타겟 그룹을 만든 뒤 리스너를 다시 연결하고,
+// 그 외의 헬스 체크 설정과 알고리즘/세션 유지/Proxy Protocol 설정 변경은 기존 타겟 그룹에 반영합니다.
+// 유지되는 TLS 리스너는 인증서와 TLS 정책이 바뀐 경우 설정을 변경합니다.
+// replace에 있는 타겟 그룹(콘솔에서 프로토콜이나 포트가 바뀐 타겟 그룹)은 설정과 관계없이 새로 만들어 교체합니다.
+// 반환값은 Service 포트 순서의 타겟 그룹 번호입니다
+func (r *ServiceReconciler) reconcileServicePorts(ctx context.Context, client NaverCloudClient, credentials *NaverCloudCredentials, service *corev1.Service, nlb *naverv1alpha1.NaverLoadBalancer, lbID string, settings *listenerSettings, lbSettings *loadBalancerSettings, replace map[string]bool) ([]string, error) {
+	logger := log.FromContext(ctx).WithValues("lb-id", lbID)
+
+	// 1. 포트별 타겟 그룹 결정 (NodePort와 헬스 체크 프로토콜이 같으면 기존 타겟 그룹의 설정만 갱신)
+	targetGroupIDs := make([]string, len(service.Spec.Ports))
+	desired := make([]naverv1alpha1.TargetGroupStatus, 0, len(service.Spec.Ports))
+	created := make(map[string]bool)
+
+	for i, port := range service.Spec.Ports {
+		healthCheck := healthCheckForPort(service, settings.LBType, port)
+		healthCheckPort := healthCheck.Port
+		tg := nlb.Status.FindTargetGroup(port.Port, port.Protocol)
+		if tg != nil && replace[tg.TargetGroupNo] {
+			logger.Info("구성 드리프트 감지, 타겟 그룹 교체", "port", port.Port, "targetGroupID", tg.TargetGroupNo)
+		} else if tg != nil && tg.TargetGroupNo != "" && (tg.NodePort == 0 || tg.NodePort == port.NodePort) {
+			replaceHealthCheck, err := r.syncTargetGroup(ctx, client, credentials, tg.TargetGroupNo, healthCheck, lbSettings)
+			if err != nil {
+				if saveErr := r.saveTargetGroups(ctx, nlb, desired, unusedTargetGroups(&nlb.Status, desired)); saveErr != nil {
+					logger.Error(saveErr, "타겟 그룹 상태 저장 실패")
+				}
+				return nil, err
+			}
+			if !replaceHealthCheck {
+				entry := *tg
+				entry.NodePort = port.NodePort
+				entry.HealthCheckPort = healthCheckPort
+				targetGroupIDs[i] = entry.TargetGroupNo
+				desired = append(desired, entry)
+				continue
+			}
+			logger.Info("헬스 체크 프로토콜 변경 감지, 타겟 그룹 교체", "port", port.Port, "targetGroupID", tg.TargetGroupNo, "protocol", healthCheck.Protocol)
+		}
+
+		// 같은 포트의 이전 타겟 그룹과 이름이 겹치지 않도록 NodePort(와 별도 헬스 체크 포트, 프로토콜)를 이름에 포함
+		suffix := fmt.Sprintf("%d-%d", port.Port, port.NodePort)
+		if healthCheckPort != port.NodePort {
+			suffix = fmt.Sprintf("%s-%d", suffix, healthCheckPort)
+		}
+		if healthCheck.Protocol != "TCP" {
+			suffix = fmt.Sprintf("%s-%s", suffix, healthCheck.Protocol)
+		}
+		// 드리프트로 교체하는 타겟 그룹과도 이름이 겹치지 않도록 이전 타겟 그룹 번호를 포함
+		if tg != nil && replace[tg.TargetGroupNo] {
+			suffix = fmt.Sprintf("%s-%s", suffix, tg.TargetGroupNo)
+		}
+		tgName := r.resourceName("tg", service, suffix)
+		logger.Info("포트 변경 감지, 타겟 그룹 생성", "port", port.Port, "nodePort", port.NodePort, "healthCheckPort", healthCheckPort, "name", tgName)
+
+		legacyName := r.generateValidName("tg", service.Namespace, service.Name, suffix)
+		targetGroupID, err := r.createTargetGroupForPort(ctx, client, credentials, service, port, tgName, legacyName)
+		if err != nil {
+			if saveErr := r.saveTargetGroups(ctx, nlb, desired, unusedTargetGroups(&nlb.Status, desired)); saveErr != nil {
+				logger.Error(saveErr, "타겟 그룹 상태 저장 실패")
+			}
+			return nil, err
+		}
+
+		targetGroupIDs[i] = targetGroupID
+		created[targetGroupID] = true
+		desired = append(desired, targetGroupStatusForPort(service, port, targetGroupID, tgName))
+	}
+
+	stale := unusedTargetGroups(&nlb.Status, desired)
+
+	// 2. 제거된 포트와 타겟 그룹이 바뀐 포트의 리스너 정리
+	listenerResp, err := client.GetLoadBalancerListenerList(&vloadbalancer.GetLoadBalancerListenerListRequest{
+		RegionCode:             ncloud.String(credentials.Region),
+		LoadBalancerInstanceNo: ncloud.String(lbID),
+	})
+	if err != nil {
+		if saveErr := r.saveTargetGroups(ctx, nlb, desired, stale); saveErr != nil {
+			logger.Error(saveErr, "타겟 그룹 상태 저장 실패")
+		}
+		return nil, fmt.Errorf("리스너 목록 조회 실패: %w", err)
+	}
+
+	portIndex := make(map[string]int, len(service.Spec.Ports))
+	for i, port := range service.Spec.Ports {
+		portIndex[settings.key(port)] = i
+	}
+	recordedTargetGroups := make(map[string]string, len(nlb.Status.Listeners))
+	for _, listener := range nlb.Status.Listeners {
+		recordedTargetGroups[listenerKey(listener.Port, listener.Protocol)] = listener.TargetGroupNo
+	}
+	// 채택한 로드밸런서에 원래 있던 리스너는 Service 포트가 아니면 그대로 둠
+	var adoptedListeners []string
+	if nlb.Status.Adoption != nil {
+		adoptedListeners = nlb.Status.Adoption.ListenerNos
+	}
+
+	existingListeners := make(map[string]bool)
+	var staleListeners []*string
+	var tlsListeners []*vloadbalancer.LoadBalancerListener
+	if listenerResp != nil {
+		for _, listener := range listenerResp.LoadBalancerListenerList {
+			if listener.Port == nil || listener.LoadBalancerListenerNo == nil {
+				continue
+			}
+			listenerPort := *listener.Port
+			key := apiListenerKey(listener)
+			i, ok := portIndex[key]
+			switch {
+			case !ok && containsString(adoptedListeners, *listener.LoadBalancerListenerNo):
+				continue
+			case !ok:
+				logger.Info("제거된 포트의 리스너 삭제 예정", "port", listenerPort, "listenerNo", *listener.LoadBalancerListenerNo)
+			case created[targetGroupIDs[i]]:
+				logger.Info("새 타겟 그룹으로 리스너 재연결 예정", "port", listenerPort, "targetGroupID", targetGroupIDs[i])
+			case recordedTargetGroups[key] != "" && recordedTargetGroups[key] != targetGroupIDs[i]:
+				logger.Info("다른 타겟 그룹에 연결된 리스너 재연결 예정", "port", listenerPort,
+					"current", recordedTargetGroups[key], "desired", targetGroupIDs[i])
+			default:
+				existingListeners[key] = true
+				if settings.needsTLSUpdate(listener, service.Spec.Ports[i]) {
+					tlsListeners = append(tlsListeners, listener)
+				}
+				continue
+			}
+			staleListeners = append(staleListeners, listener.LoadBalancerListenerNo)
+		}
+	}
+
+	if len(staleListeners) > 0 {
+		if err := r.checkLoadBalancerReady(client, credentials, lbID); err != nil {
+			if saveErr := r.saveTargetGroups(ctx, nlb, desired, stale); saveErr != nil {
+				logger.Error(saveErr, "타겟 그룹 상태 저장 실패")
+			}
+			return nil, err
+		}
+
+		if _, err := client.DeleteLoadBalancerListeners(&vloadbalancer.DeleteLoadBalancerListenersRequest{
+			RegionCode:                 ncloud.String(credentials.Region),
+			LoadBalancerListenerNoList: staleListeners,
+		}); err != nil {
+			if saveErr := r.saveTargetGroups(ctx, nlb, desired, stale); saveErr != nil {
+				logger.Error(saveErr, "타겟 그룹 상태 저장 실패")
+			}
+			return nil, fmt.Errorf("리스너 삭제 실패: %w", err)
+		}
+		logger.Info("리스너 삭제 성공", "count", len(staleListeners))
+	}
+
+	// 3. 리스너가 없는 포트에만 리스너 생성
+	var missingPorts []corev1.ServicePort
+	var missingTargetGroupIDs []string
+	for i, port := range service.Spec.Ports {
+		if !existingListeners[settings.key(port)] {
+			missingPorts = append(missingPorts, port)
+			missingTargetGroupIDs = append(missingTargetGroupIDs, targetGroupIDs[i])
+		}
+	}
+
+	if len(missingPorts) > 0 {
+		if err := r.createListeners(ctx, client, credentials, lbID, settings, missingPorts, missingTargetGroupIDs, existingListeners); err != nil {
+			if saveErr := r.saveTargetGroups(ctx, nlb, desired, stale); saveErr != nil {
+				logger.Error(saveErr, "타겟 그룹 상태 저장 실패")
+			}
+			return nil, err
+		}
+	}
+
+	// 4. 유지되는 TLS 리스너의 인증서와 TLS 정책 갱신
+	for _, listener := range tlsListeners {
+		if err := r.checkLoadBalancerReady(client, credentials, lbID); err != nil {
+			if saveErr := r.saveTargetGroups(ctx, nlb, desired, stale); saveErr != nil {
+				logger.Error(saveErr, "타겟 그룹 상태 저장 실패")
+			}
+			return nil, err
+		}
+
+		req := &vloadbalancer.ChangeLoadBalancerListenerConfigurationRequest{
+			RegionCode:             ncloud.String(credentials.Region),
+			LoadBalancerListenerNo: listener.LoadBalancerListenerNo,
+			ProtocolTypeCode:       listener.ProtocolType.Code,
+			Port:                   listener.Port,
+			SslCertificateNo:       ncloud.String(settings.CertificateNo),
+			TlsMinVersionTypeCode:  ncloud.String(settings.TLSMinVersion),
+		}
+		if len(settings.CipherSuites) > 0 {
+			req.CipherSuiteList = ncloud.StringList(settings.CipherSuites)
+		}
+		if _, err := client.ChangeLoadBalancerListenerConfiguration(req); err != nil {
+			if saveErr := r.saveTargetGroups(ctx, nlb, desired, stale); saveErr != nil {
+				logger.Error(saveErr, "타겟 그룹 상태 저장 실패")
+			}
+			return nil, fmt.Errorf("리스너 TLS 설정 변경 실패: %w", err)
+		}
+		logger.Info("리스너 TLS 설정 변경 성공", "port", *listener.Port, "certificateNo", settings.CertificateNo)
+	}
+
+	// 5. 더 이상 사용하지 않는 타겟 그룹 삭제 (실패하면 다음 조정에서 재시도하도록 상태에 남김, 채택한 타겟 그룹은 남겨 둠)
+	adopted := adoptedTargetGroups(&nlb.Status)
+	var remaining []naverv1alpha1.TargetGroupStatus
+	for _, tg := range stale {
+		if adopted[tg.TargetGroupNo] {
+			logger.Info("사용하지 않는 채택 타겟 그룹은 삭제하지 않음", "targetGroupID", tg.TargetGroupNo, "port", tg.Port)
+			continue
+		}
+		if _, err := client.DeleteTargetGroups(&vloadbalancer.DeleteTargetGroupsRequest{
+			RegionCode:        ncloud.String(credentials.Region),
+			TargetGroupNoList: []*string{ncloud.String(tg.TargetGroupNo)},
+		}); err != nil {
+			logger.Info("사용하지 않는 타겟 그룹 삭제 실패, 다음 조정에서 재시도", "targetGroupID", tg.TargetGroupNo, "error", err.Error())
+			remaining = append(remaining, tg)
+			continue
+		}
+		logger.Info("사용하지 않는 타겟 그룹 삭제 성공", "targetGroupID", tg.TargetGroupNo, "port", tg.Port)
+	}
+
+	if err := r.saveTargetGroups(ctx, nlb, desired, remaining); err != nil {
+		return nil, err
+	}
+
+	return targetGroupIDs, nil
+}
+
+// syncTargetGroup은 기존 타겟 그룹의 헬스 체크와 알고리즘/세션 유지/Proxy Protocol 설정을 원하는 설정으로 변경합니다.
+// 헬스 체크 프로토콜이 달라 타겟 그룹을 새로 만들어야 하면 true를 반환합니다
+func (r *ServiceReconciler) syncTargetGroup(ctx context.Context, client NaverCloudClient, credentials *NaverCloudCredentials, targetGroupNo string, healthCheck healthCheckConfig, lbSettings *loadBalancerSettings) (bool, error) {
+	detailResp, err := client.GetTargetGroupDetail(&vloadbalancer.GetTargetGroupDetailRequest{
+		RegionCode:    ncloud.String(credentials.Region),
+		TargetGroupNo: ncloud.String(targetGroupNo),
+	})
+	if err != nil {
+		return false, fmt.Errorf("타겟 그룹 상세 정보 조회 실패: %w", err)
+	}
+	if detailResp == nil || len(detailResp.TargetGroupList) == 0 {
+		return false, nil
+	}
+	current := detailResp.TargetGroupList[0]
+
+	if replace, err := r.syncTargetGroupHealthCheck(ctx, client, credentials, current, healthCheck); err != nil || replace {
+		return replace, err
+	}
+	return false, r.syncTargetGroupConfiguration(ctx, client, credentials, current, lbSettings)
+}
+
+// saveTargetGroups는 Service 포트에 연결된 타겟 그룹과 아직 삭제하지 못한 타겟 그룹을 상태에 기록합니다.
+// 포트별 조회가 현재 타겟 그룹을 먼저 찾도록 사용 중인 타겟 그룹을 앞에 둡니다
+func (r *ServiceReconciler) saveTargetGroups(ctx context.Context, nlb *naverv1alpha1.NaverLoadBalancer, desired, remaining []naverv1alpha1.TargetGroupStatus) error {
+	targetGroups := make([]naverv1alpha1.TargetGroupStatus, 0, len(desired)+len(remaining))
+	targetGroups = append(targetGroups, desired...)
+	targetGroups = append(targetGroups, remaining...)
+
+	return r.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+		latest.Status.TargetGroups = targetGroups
+	})
+}
+
+// unusedTargetGroups는 상태에 기록된 타겟 그룹 중 desired에 없는 타겟 그룹을 반환합니다
+func unusedTargetGroups(status *naverv1alpha1.NaverLoadBalancerStatus, desired []naverv1alpha1.TargetGroupStatus) []naverv1alpha1.TargetGroupStatus {
+	inUse := make(map[string]bool, len(desired))
+	for _, tg := range desired {
+		inUse[tg.TargetGroupNo] = true
+	}
+
+	var unused []naverv1alpha1.TargetGroupStatus
+	for _, tg := range status.TargetGroups {
+		if tg.TargetGroupNo == "" || inUse[tg.TargetGroupNo] {
+			continue
+		}
+		unused = append(unused, tg)
+	}
+	return unused
+}
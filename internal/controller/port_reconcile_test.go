@@ -0,0 +1,187 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	naverv1alpha1 "github.com/suslmk-lee/kube-controller01/api/v1alpha1"
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ = Describe("Service Port Reconcile Tests", func() {
+	var (
+		reconciler *ServiceReconciler
+		mockClient *navercloud.MockClient
+		ctx        context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockClient = navercloud.NewMockClient()
+
+		reconciler = &ServiceReconciler{
+			Client: k8sClient,
+			NaverCloudConfig: NaverCloudConfig{
+				APIKey:    "test-api-key",
+				APISecret: "test-api-secret",
+				Region:    "KR",
+				VpcNo:     "vpc-12345",
+				SubnetNo:  "subnet-67890",
+			},
+			NaverClient: mockClient,
+		}
+	})
+
+	// provision은 Service 포트마다 타겟 그룹과 리스너가 구성된 로드밸런서를 만들고 상태에 기록합니다
+	provision := func(service *corev1.Service) {
+		mockClient.AddMockLoadBalancer("lb-ports", "k8s-lb-"+service.Name, "Running")
+
+		nlb, err := reconciler.ensureNaverLoadBalancer(ctx, service)
+		Expect(err).NotTo(HaveOccurred())
+
+		var targetGroups []naverv1alpha1.TargetGroupStatus
+		var listeners []naverv1alpha1.ListenerStatus
+		for _, port := range service.Spec.Ports {
+			tgNo := fmt.Sprintf("tg-old-%d", port.Port)
+			listenerNo := fmt.Sprintf("listener-old-%d", port.Port)
+			mockClient.AddMockTargetGroup(tgNo, tgNo, port.NodePort)
+			mockClient.AddMockListener("lb-ports", listenerNo, port.Port, tgNo)
+
+			targetGroups = append(targetGroups, naverv1alpha1.TargetGroupStatus{
+				Port: port.Port, Protocol: corev1.ProtocolTCP, NodePort: port.NodePort, TargetGroupNo: tgNo,
+			})
+			listeners = append(listeners, naverv1alpha1.ListenerStatus{
+				Port: port.Port, Protocol: "TCP", ListenerNo: listenerNo, TargetGroupNo: tgNo,
+			})
+		}
+
+		Expect(reconciler.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+			latest.Status.LoadBalancerNo = "lb-ports"
+			latest.Status.TargetGroups = targetGroups
+			latest.Status.Listeners = listeners
+			latest.Status.ObservedGeneration = latest.Generation
+		})).To(Succeed())
+	}
+
+	getStatus := func(service *corev1.Service) *naverv1alpha1.NaverLoadBalancerStatus {
+		nlb, err := reconciler.getNaverLoadBalancer(ctx, service)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nlb).NotTo(BeNil())
+		return &nlb.Status
+	}
+
+	Context("When a port is added to the Service", func() {
+		It("should create a target group and listener only for the new port", func() {
+			service := newService(ctx, "ports-add-service", nil, 80)
+			defer cleanupService(ctx, service)
+			provision(service)
+
+			service.Spec.Ports = append(service.Spec.Ports, corev1.ServicePort{
+				Name: "port-443", Port: 443, TargetPort: intstr.FromInt32(443), Protocol: corev1.ProtocolTCP,
+			})
+			Expect(k8sClient.Update(ctx, service)).To(Succeed())
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.CreateTGCalled).To(Equal(1))
+			Expect(mockClient.CreateListenerCalled).To(Equal(1))
+			Expect(mockClient.DeleteListenerCalled).To(Equal(0))
+			Expect(mockClient.DeleteTGCalled).To(Equal(0))
+
+			status := getStatus(service)
+			Expect(status.TargetGroupNos()).To(Equal([]string{"tg-old-80", "tg-12345"}))
+			Expect(status.Listeners).To(ConsistOf(
+				naverv1alpha1.ListenerStatus{Port: 80, Protocol: "TCP", ListenerNo: "listener-old-80", TargetGroupNo: "tg-old-80"},
+				naverv1alpha1.ListenerStatus{Port: 443, Protocol: "TCP", ListenerNo: "listener-12345", TargetGroupNo: "tg-12345"},
+			))
+			Expect(mockClient.ListenerTargets).To(HaveKeyWithValue("listener-12345", "tg-12345"))
+		})
+	})
+
+	Context("When a port is removed from the Service", func() {
+		It("should delete the listener and target group of the removed port", func() {
+			service := newService(ctx, "ports-remove-service", nil, 80, 443)
+			defer cleanupService(ctx, service)
+			provision(service)
+
+			service.Spec.Ports = service.Spec.Ports[1:]
+			Expect(k8sClient.Update(ctx, service)).To(Succeed())
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.CreateTGCalled).To(Equal(0))
+			Expect(mockClient.CreateListenerCalled).To(Equal(0))
+			Expect(mockClient.DeleteListenerCalled).To(Equal(1))
+			Expect(mockClient.DeleteTGCalled).To(Equal(1))
+			Expect(mockClient.ListenerTargets).To(Equal(map[string]string{"listener-old-443": "tg-old-443"}))
+			Expect(mockClient.TargetGroups).To(ConsistOf(HaveField("TargetGroupNo", HaveValue(Equal("tg-old-443")))))
+
+			status := getStatus(service)
+			Expect(status.TargetGroupNos()).To(Equal([]string{"tg-old-443"}))
+			Expect(status.Listeners).To(ConsistOf(HaveField("Port", int32(443))))
+		})
+
+		It("should keep a target group that could not be deleted for the next reconcile", func() {
+			service := newService(ctx, "ports-remove-retry-service", nil, 80, 443)
+			defer cleanupService(ctx, service)
+			provision(service)
+
+			service.Spec.Ports = service.Spec.Ports[1:]
+			mockClient.ShouldFailDeleteTG = true
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getStatus(service).TargetGroupNos()).To(Equal([]string{"tg-old-443", "tg-old-80"}))
+
+			mockClient.ShouldFailDeleteTG = false
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getStatus(service).TargetGroupNos()).To(Equal([]string{"tg-old-443"}))
+		})
+	})
+
+	Context("When the NodePort of a port changes", func() {
+		It("should re-point the listener to a new target group", func() {
+			service := newService(ctx, "ports-nodeport-service", nil, 80)
+			defer cleanupService(ctx, service)
+			provision(service)
+
+			service.Spec.Ports[0].NodePort++
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.CreateTGCalled).To(Equal(1))
+			Expect(mockClient.DeleteListenerCalled).To(Equal(1))
+			Expect(mockClient.CreateListenerCalled).To(Equal(1))
+			Expect(mockClient.DeleteTGCalled).To(Equal(1))
+			Expect(*mockClient.TargetGroups[0].TargetGroupPort).To(Equal(service.Spec.Ports[0].NodePort))
+			Expect(mockClient.ListenerTargets).To(Equal(map[string]string{"listener-12345": "tg-12345"}))
+
+			status := getStatus(service)
+			Expect(status.TargetGroups).To(ConsistOf(HaveField("NodePort", service.Spec.Ports[0].NodePort)))
+			Expect(status.Listeners).To(ConsistOf(naverv1alpha1.ListenerStatus{
+				Port: 80, Protocol: "TCP", ListenerNo: "listener-12345", TargetGroupNo: "tg-12345",
+			}))
+		})
+	})
+})
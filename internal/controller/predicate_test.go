@@ -31,29 +31,8 @@ var _ = Describe("Predicate Tests", func() {
 		var predicateFunc func(client.Object) bool
 
 		BeforeEach(func() {
-			// SetupWithManager에서 사용되는 predicate 로직을 복제
-			predicateFunc = func(object client.Object) bool {
-				service, ok := object.(*corev1.Service)
-				if !ok {
-					return false
-				}
-
-				// 현재 LoadBalancer 타입인 경우
-				if service.Spec.Type == corev1.ServiceTypeLoadBalancer {
-					return true
-				}
-
-				// LoadBalancer 타입이 아니지만 이전에 LoadBalancer였던 경우 (어노테이션 확인)
-				if service.Annotations != nil {
-					_, lbExists := service.Annotations["naver.k-paas.org/lb-id"]
-					_, tgExists := service.Annotations["naver.k-paas.org/target-groups"]
-					if lbExists || tgExists {
-						return true
-					}
-				}
-
-				return false
-			}
+			// SetupWithManager에서 사용되는 predicate 로직
+			predicateFunc = isRelevantService
 		})
 
 		It("should accept LoadBalancer type service", func() {
@@ -156,6 +135,29 @@ var _ = Describe("Predicate Tests", func() {
 			Expect(result).To(BeTrue())
 		})
 
+		It("should accept service that still has the finalizer", func() {
+			By("Creating a ClusterIP service whose resources were never recorded")
+			service := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "clusterip-with-finalizer",
+					Namespace:  "default",
+					Finalizers: []string{naverLBFinalizer},
+				},
+				Spec: corev1.ServiceSpec{
+					Type: corev1.ServiceTypeClusterIP,
+					Ports: []corev1.ServicePort{
+						{
+							Port:       80,
+							TargetPort: intstr.FromInt(8080),
+						},
+					},
+				},
+			}
+
+			result := predicateFunc(service)
+			Expect(result).To(BeTrue())
+		})
+
 		It("should reject ClusterIP service without annotations", func() {
 			By("Creating a ClusterIP service without annotations")
 			service := &corev1.Service{
@@ -0,0 +1,164 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	naverv1alpha1 "github.com/suslmk-lee/kube-controller01/api/v1alpha1"
+)
+
+const (
+	// provisioningRequeueInterval은 로드밸런서가 생성·변경·삭제 중일 때 다시 조정할 때까지의 간격입니다
+	provisioningRequeueInterval = 15 * time.Second
+	// targetGroupDeletionTimeout은 삭제 단계에서 사용 중인 타겟 그룹 삭제를 다시 시도하는 최대 시간입니다
+	targetGroupDeletionTimeout = 5 * time.Minute
+)
+
+// errLoadBalancerBusy는 로드밸런서가 생성·변경·삭제 중이라 다음 단계를 진행할 수 없음을 나타냅니다.
+// 조정 안에서 기다리지 않고 반환하며, Reconcile은 provisioningRequeueInterval 후 다시 조정합니다
+var errLoadBalancerBusy = errors.New("로드밸런서 작업 진행 중")
+
+// isLoadBalancerBusy는 오류가 로드밸런서 작업 진행 중이라 다시 조정해야 하는 경우인지 확인합니다
+func isLoadBalancerBusy(err error) bool {
+	return errors.Is(err, errLoadBalancerBusy)
+}
+
+// setPhase는 프로비저닝 단계를 바꾸고 단계가 바뀐 시각을 기록합니다
+func setPhase(nlb *naverv1alpha1.NaverLoadBalancer, phase string) {
+	if nlb.Status.Phase == phase {
+		return
+	}
+	now := metav1.Now()
+	nlb.Status.Phase = phase
+	nlb.Status.PhaseTransitionTime = &now
+}
+
+// recordPhase는 프로비저닝 단계를 상태에 기록합니다 (같은 단계면 무시)
+func (r *ServiceReconciler) recordPhase(ctx context.Context, nlb *naverv1alpha1.NaverLoadBalancer, phase string) error {
+	if nlb == nil || nlb.Status.Phase == phase {
+		return nil
+	}
+	return r.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+		setPhase(latest, phase)
+	})
+}
+
+// targetGroupDeletionTimedOut은 삭제 단계가 targetGroupDeletionTimeout보다 오래 지났는지 확인합니다.
+// NaverLoadBalancer가 없으면 기준 시각이 없으므로 계속 재시도합니다
+func targetGroupDeletionTimedOut(nlb *naverv1alpha1.NaverLoadBalancer) bool {
+	if nlb == nil || nlb.Status.Phase != naverv1alpha1.PhaseDeleting || nlb.Status.PhaseTransitionTime == nil {
+		return false
+	}
+	return time.Since(nlb.Status.PhaseTransitionTime.Time) > targetGroupDeletionTimeout
+}
+
+// checkLoadBalancerReady는 로드밸런서가 리스너와 설정을 변경할 수 있는 상태인지 한 번만 확인합니다.
+// 생성 또는 변경 중이면 errLoadBalancerBusy를 감싼 오류를 반환합니다
+func (r *ServiceReconciler) checkLoadBalancerReady(client NaverCloudClient, credentials *NaverCloudCredentials, lbID string) error {
+	resp, err := client.GetLoadBalancerInstanceDetail(&vloadbalancer.GetLoadBalancerInstanceDetailRequest{
+		RegionCode:             ncloud.String(credentials.Region),
+		LoadBalancerInstanceNo: ncloud.String(lbID),
+	})
+	if err != nil {
+		return fmt.Errorf("로드밸런서 상태 조회 실패: %w", err)
+	}
+	if resp == nil || len(resp.LoadBalancerInstanceList) == 0 {
+		return fmt.Errorf("로드밸런서를 찾을 수 없음: %s", lbID)
+	}
+
+	code, name := loadBalancerInstanceStatus(resp.LoadBalancerInstanceList[0])
+	switch {
+	case code == "ERROR" || code == "TERMINATING":
+		return fmt.Errorf("로드밸런서가 오류 상태: %s (%s)", code, name)
+	case (code == "RUN" || code == "USED") && name != "Changing":
+		return nil
+	default:
+		return fmt.Errorf("%w: 로드밸런서 %s 상태 %s (%s)", errLoadBalancerBusy, lbID, code, name)
+	}
+}
+
+// deleteLoadBalancer는 로드밸런서 삭제를 요청하고 삭제가 끝났는지 한 번만 확인합니다.
+// 변경 중이거나 아직 삭제 중이면 errLoadBalancerBusy를 감싼 오류를 반환합니다
+func (r *ServiceReconciler) deleteLoadBalancer(client NaverCloudClient, credentials *NaverCloudCredentials, lbID string) error {
+	lb, err := r.lookupLoadBalancer(client, credentials, lbID)
+	if err != nil || lb == nil {
+		return err
+	}
+
+	code, name := loadBalancerInstanceStatus(lb)
+	switch {
+	case code == "TERMINATING":
+		return fmt.Errorf("%w: 로드밸런서 %s 삭제 중", errLoadBalancerBusy, lbID)
+	case code != "ERROR" && (code != "RUN" && code != "USED" || name == "Changing"):
+		// 생성 또는 변경 중인 로드밸런서는 삭제할 수 없음
+		return fmt.Errorf("%w: 로드밸런서 %s 상태 %s (%s)", errLoadBalancerBusy, lbID, code, name)
+	}
+
+	if _, err := client.DeleteLoadBalancerInstances(&vloadbalancer.DeleteLoadBalancerInstancesRequest{
+		RegionCode:                 ncloud.String(credentials.Region),
+		LoadBalancerInstanceNoList: []*string{ncloud.String(lbID)},
+	}); err != nil {
+		return fmt.Errorf("로드밸런서 삭제 실패: %w", err)
+	}
+
+	if lb, err = r.lookupLoadBalancer(client, credentials, lbID); err != nil || lb == nil {
+		return err
+	}
+	return fmt.Errorf("%w: 로드밸런서 %s 삭제 중", errLoadBalancerBusy, lbID)
+}
+
+// lookupLoadBalancer는 번호로 로드밸런서를 조회합니다 (없으면 nil)
+func (r *ServiceReconciler) lookupLoadBalancer(client NaverCloudClient, credentials *NaverCloudCredentials, lbID string) (*vloadbalancer.LoadBalancerInstance, error) {
+	resp, err := client.GetLoadBalancerInstanceList(&vloadbalancer.GetLoadBalancerInstanceListRequest{
+		RegionCode:                 ncloud.String(credentials.Region),
+		LoadBalancerInstanceNoList: []*string{ncloud.String(lbID)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("로드밸런서 목록 조회 실패: %w", err)
+	}
+	if resp == nil {
+		return nil, nil
+	}
+	for _, lb := range resp.LoadBalancerInstanceList {
+		if ncloud.StringValue(lb.LoadBalancerInstanceNo) == lbID {
+			return lb, nil
+		}
+	}
+	return nil, nil
+}
+
+// loadBalancerInstanceStatus는 로드밸런서 상태 코드와 이름을 반환합니다
+func loadBalancerInstanceStatus(lb *vloadbalancer.LoadBalancerInstance) (string, string) {
+	code, name := "UNKNOWN", ncloud.StringValue(lb.LoadBalancerInstanceStatusName)
+	if lb.LoadBalancerInstanceStatus != nil {
+		if lb.LoadBalancerInstanceStatus.Code != nil {
+			code = *lb.LoadBalancerInstanceStatus.Code
+		}
+		if lb.LoadBalancerInstanceStatus.CodeName != nil {
+			name = *lb.LoadBalancerInstanceStatus.CodeName
+		}
+	}
+	return code, name
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	corev1 "k8s.io/api/core/v1"
+
+	naverv1alpha1 "github.com/suslmk-lee/kube-controller01/api/v1alpha1"
+)
+
+// publicIPAnnotation은 로드밸런서에 연결할 예약 공인 IP 주소입니다 (spec.loadBalancerIP보다 우선)
+const publicIPAnnotation = "naver.k-paas.org/public-ip"
+
+// requestedPublicIP는 어노테이션 또는 spec.loadBalancerIP로 지정한 예약 공인 IP를 반환합니다 (없으면 빈 문자열).
+// 공인 IP는 PUBLIC NETWORK 로드밸런서에만 연결할 수 있습니다
+func requestedPublicIP(service *corev1.Service, lbType, networkType string) (string, error) {
+	value := strings.TrimSpace(service.Annotations[publicIPAnnotation])
+	specValue := strings.TrimSpace(service.Spec.LoadBalancerIP)
+	if value == "" {
+		value = specValue
+	} else if specValue != "" && specValue != value {
+		return "", fmt.Errorf("%s 어노테이션(%s)과 spec.loadBalancerIP(%s)가 다름", publicIPAnnotation, value, specValue)
+	}
+	if value == "" {
+		return "", nil
+	}
+
+	ip := net.ParseIP(value)
+	if ip == nil || ip.To4() == nil {
+		return "", fmt.Errorf("예약 공인 IP 값이 올바르지 않음: %q (IPv4 주소)", value)
+	}
+	if lbType != lbTypeNetwork {
+		return "", fmt.Errorf("예약 공인 IP는 NETWORK 로드밸런서에만 연결할 수 있음: %s", lbType)
+	}
+	if networkType != lbNetworkTypePublic {
+		return "", fmt.Errorf("예약 공인 IP는 public 로드밸런서에만 연결할 수 있음")
+	}
+	return ip.String(), nil
+}
+
+// resolvePublicIP는 예약 공인 IP 인스턴스를 찾아 서버나 다른 Service에 연결되어 있지 않은지 확인합니다
+func (r *ServiceReconciler) resolvePublicIP(ctx context.Context, client NaverCloudClient, credentials *NaverCloudCredentials, nlb *naverv1alpha1.NaverLoadBalancer, publicIP string) (*naverv1alpha1.ReservedPublicIPStatus, error) {
+	resp, err := client.GetPublicIpInstanceList(&vserver.GetPublicIpInstanceListRequest{
+		RegionCode: ncloud.String(credentials.Region),
+		PublicIp:   ncloud.String(publicIP),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("공인 IP 조회 실패: %w", err)
+	}
+
+	var instance *vserver.PublicIpInstance
+	if resp != nil {
+		for _, candidate := range resp.PublicIpInstanceList {
+			if candidate != nil && ncloud.StringValue(candidate.PublicIp) == publicIP {
+				instance = candidate
+				break
+			}
+		}
+	}
+	if instance == nil {
+		return nil, fmt.Errorf("예약 공인 IP %s를 찾을 수 없음 (먼저 공인 IP를 신청하세요)", publicIP)
+	}
+	if serverNo := ncloud.StringValue(instance.ServerInstanceNo); serverNo != "" {
+		return nil, fmt.Errorf("예약 공인 IP %s가 서버 %s에 할당되어 있음", publicIP, serverNo)
+	}
+
+	var nlbList naverv1alpha1.NaverLoadBalancerList
+	if err := r.List(ctx, &nlbList); err != nil {
+		return nil, fmt.Errorf("NaverLoadBalancer 목록 조회 실패: %w", err)
+	}
+	for _, other := range nlbList.Items {
+		if other.Namespace == nlb.Namespace && other.Name == nlb.Name {
+			continue
+		}
+		if other.Status.ReservedPublicIP != nil && other.Status.ReservedPublicIP.PublicIP == publicIP {
+			return nil, fmt.Errorf("예약 공인 IP %s를 Service %s/%s가 사용 중", publicIP, other.Namespace, other.Spec.ServiceName)
+		}
+	}
+
+	return &naverv1alpha1.ReservedPublicIPStatus{
+		PublicIPInstanceNo: ncloud.StringValue(instance.PublicIpInstanceNo),
+		PublicIP:           publicIP,
+	}, nil
+}
+
+// applyPublicIP는 로드밸런서 생성 요청의 서브넷에 예약 공인 IP를 지정합니다 (서브넷 하나만 허용되므로 호출 전에 확인)
+func applyPublicIP(req *vloadbalancer.CreateLoadBalancerInstanceRequest, subnetNos []string, publicIP *naverv1alpha1.ReservedPublicIPStatus) {
+	if publicIP == nil || len(subnetNos) != 1 {
+		return
+	}
+	req.SubnetNoList = nil
+	req.LoadBalancerSubnetList = []*vloadbalancer.LoadBalancerSubnetParameter{{
+		SubnetNo:           ncloud.String(subnetNos[0]),
+		PublicIpInstanceNo: ncloud.String(publicIP.PublicIPInstanceNo),
+	}}
+}
+
+// recordedPublicIP는 상태에 기록된 예약 공인 IP 주소를 반환합니다 (없으면 빈 문자열)
+func recordedPublicIP(status *naverv1alpha1.NaverLoadBalancerStatus) string {
+	if status.ReservedPublicIP == nil {
+		return ""
+	}
+	return status.ReservedPublicIP.PublicIP
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	naverv1alpha1 "github.com/suslmk-lee/kube-controller01/api/v1alpha1"
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("Public IP Tests", func() {
+	var (
+		reconciler *ServiceReconciler
+		mockClient *navercloud.MockClient
+		recorder   *record.FakeRecorder
+		ctx        context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockClient = navercloud.NewMockClient()
+		mockClient.PublicIPs = append(mockClient.PublicIPs,
+			vserver.PublicIpInstance{PublicIpInstanceNo: ncloud.String("pip-1"), PublicIp: ncloud.String("203.0.113.10")},
+			vserver.PublicIpInstance{PublicIpInstanceNo: ncloud.String("pip-2"), PublicIp: ncloud.String("203.0.113.20"), ServerInstanceNo: ncloud.String("server-1")},
+		)
+		recorder = record.NewFakeRecorder(10)
+
+		reconciler = &ServiceReconciler{
+			Client: k8sClient,
+			NaverCloudConfig: NaverCloudConfig{
+				APIKey:    "test-api-key",
+				APISecret: "test-api-secret",
+				Region:    "KR",
+				VpcNo:     "vpc-12345",
+				SubnetNo:  "subnet-67890",
+			},
+			NaverClient: mockClient,
+			Recorder:    recorder,
+		}
+	})
+
+	Context("When reading the requested public IP", func() {
+		It("should accept the annotation or spec.loadBalancerIP for public NETWORK load balancers only", func() {
+			service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{publicIPAnnotation: "203.0.113.10"},
+			}}
+			Expect(requestedPublicIP(service, lbTypeNetwork, lbNetworkTypePublic)).To(Equal("203.0.113.10"))
+
+			service.Spec.LoadBalancerIP = "203.0.113.10"
+			Expect(requestedPublicIP(service, lbTypeNetwork, lbNetworkTypePublic)).To(Equal("203.0.113.10"))
+
+			By("Rejecting conflicting or invalid values")
+			service.Spec.LoadBalancerIP = "203.0.113.11"
+			_, err := requestedPublicIP(service, lbTypeNetwork, lbNetworkTypePublic)
+			Expect(err).To(HaveOccurred())
+
+			service.Annotations = nil
+			Expect(requestedPublicIP(service, lbTypeNetwork, lbNetworkTypePublic)).To(Equal("203.0.113.11"))
+			_, err = requestedPublicIP(service, lbTypeNetworkProxy, lbNetworkTypePublic)
+			Expect(err).To(HaveOccurred())
+			_, err = requestedPublicIP(service, lbTypeNetwork, lbNetworkTypePrivate)
+			Expect(err).To(HaveOccurred())
+
+			service.Spec.LoadBalancerIP = "2001:db8::1"
+			_, err = requestedPublicIP(service, lbTypeNetwork, lbNetworkTypePublic)
+			Expect(err).To(HaveOccurred())
+
+			Expect(requestedPublicIP(&corev1.Service{}, lbTypeNetworkProxy, lbNetworkTypePublic)).To(BeEmpty())
+		})
+	})
+
+	Context("When a reserved public IP is requested", func() {
+		It("should attach it to the load balancer and refuse to change it", func() {
+			service := newService(ctx, "public-ip-service", map[string]string{
+				lbTypeAnnotation:   lbTypeNetwork,
+				publicIPAnnotation: "203.0.113.10",
+			}, 80)
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.LoadBalancers).To(HaveLen(1))
+			Expect(mockClient.LoadBalancers[0].LoadBalancerIpList).To(HaveExactElements(HaveValue(Equal("203.0.113.10"))))
+			Expect(mockClient.LoadBalancers[0].SubnetNoList).To(HaveExactElements(HaveValue(Equal("subnet-67890"))))
+
+			nlb, err := reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Status.ReservedPublicIP).To(Equal(&naverv1alpha1.ReservedPublicIPStatus{PublicIPInstanceNo: "pip-1", PublicIP: "203.0.113.10"}))
+
+			By("Rejecting a different IP for the existing load balancer")
+			service.Annotations[publicIPAnnotation] = "203.0.113.11"
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).To(HaveOccurred())
+			Expect(recorder.Events).To(Receive(ContainSubstring("InvalidPublicIP")))
+
+			By("Leaving the reserved IP in place when the Service is deleted")
+			Expect(reconciler.deleteNaverCloudLB(ctx, service)).To(Succeed())
+			Expect(mockClient.PublicIPs).To(HaveLen(2))
+		})
+
+		It("should not create anything when the IP is missing or in use", func() {
+			service := newService(ctx, "public-ip-invalid-service", map[string]string{
+				lbTypeAnnotation:   lbTypeNetwork,
+				publicIPAnnotation: "203.0.113.99",
+			}, 80)
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).To(HaveOccurred())
+			Expect(recorder.Events).To(Receive(ContainSubstring("InvalidPublicIP")))
+
+			service.Annotations[publicIPAnnotation] = "203.0.113.20"
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).To(MatchError(ContainSubstring("server-1")))
+
+			Expect(mockClient.CreateTGCalled).To(Equal(0))
+			Expect(mockClient.CreateLBCalled).To(Equal(0))
+		})
+	})
+})
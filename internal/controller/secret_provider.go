@@ -39,6 +39,14 @@ type NaverCloudCredentials struct {
 	Region    string
 	VpcNo     string
 	SubnetNo  string
+	// PrivateSubnetNo is the subnet for private (internal) load balancers
+	PrivateSubnetNo string
+	// EndpointProfile selects the API gateway (public, gov, fin, custom)
+	EndpointProfile string
+	// APIEndpoint overrides the API gateway base URL
+	APIEndpoint string
+	// NodeAccessControlGroupNo is the worker node ACG whose NodePort inbound rules the controller manages (optional)
+	NodeAccessControlGroupNo string
 }
 
 // SecretProvider interface for retrieving secrets from different backends
@@ -220,6 +228,12 @@ func (p *OpenBaoProvider) readSecret(ctx context.Context, token string) (*NaverC
 		Region:    getStringValue(data, "NAVER_CLOUD_REGION"),
 		VpcNo:     getStringValue(data, "NAVER_CLOUD_VPC_NO"),
 		SubnetNo:  getStringValue(data, "NAVER_CLOUD_SUBNET_NO"),
+
+		PrivateSubnetNo: getStringValue(data, "NAVER_CLOUD_PRIVATE_SUBNET_NO"),
+		EndpointProfile: getStringValue(data, "NAVER_CLOUD_ENDPOINT_PROFILE"),
+		APIEndpoint:     getStringValue(data, "NAVER_CLOUD_API_ENDPOINT"),
+
+		NodeAccessControlGroupNo: getStringValue(data, "NAVER_CLOUD_NODE_ACG_NO"),
 	}
 
 	// Set default region if not specified
@@ -274,6 +288,12 @@ func (p *KubernetesSecretProvider) GetCredentials(ctx context.Context) (*NaverCl
 		Region:    string(secret.Data["NAVER_CLOUD_REGION"]),
 		VpcNo:     string(secret.Data["NAVER_CLOUD_VPC_NO"]),
 		SubnetNo:  string(secret.Data["NAVER_CLOUD_SUBNET_NO"]),
+
+		PrivateSubnetNo: string(secret.Data["NAVER_CLOUD_PRIVATE_SUBNET_NO"]),
+		EndpointProfile: string(secret.Data["NAVER_CLOUD_ENDPOINT_PROFILE"]),
+		APIEndpoint:     string(secret.Data["NAVER_CLOUD_API_ENDPOINT"]),
+
+		NodeAccessControlGroupNo: string(secret.Data["NAVER_CLOUD_NODE_ACG_NO"]),
 	}
 
 	// Set default region if not specified
@@ -400,6 +420,31 @@ func (p *AutoSecretProvider) supplementFromConfigMap(ctx context.Context, creds
 		}
 	}
 
+	if creds.PrivateSubnetNo == "" {
+		if privateSubnetNo, ok := configMap.Data["NAVER_CLOUD_PRIVATE_SUBNET_NO"]; ok {
+			creds.PrivateSubnetNo = privateSubnetNo
+			logger.Info("ConfigMap", "privateSubnetNo", privateSubnetNo)
+		}
+	}
+
+	if creds.NodeAccessControlGroupNo == "" {
+		if nodeACGNo, ok := configMap.Data["NAVER_CLOUD_NODE_ACG_NO"]; ok {
+			creds.NodeAccessControlGroupNo = nodeACGNo
+			logger.Info("ConfigMap", "nodeAccessControlGroupNo", nodeACGNo)
+		}
+	}
+
+	if creds.EndpointProfile == "" && creds.APIEndpoint == "" {
+		if profile, ok := configMap.Data["NAVER_CLOUD_ENDPOINT_PROFILE"]; ok {
+			creds.EndpointProfile = profile
+			logger.Info("ConfigMap", "endpointProfile", profile)
+		}
+		if endpoint, ok := configMap.Data["NAVER_CLOUD_API_ENDPOINT"]; ok {
+			creds.APIEndpoint = endpoint
+			logger.Info("ConfigMap", "apiEndpoint", endpoint)
+		}
+	}
+
 	if creds.Region == "" {
 		if region, ok := configMap.Data["NAVER_CLOUD_REGION"]; ok {
 			creds.Region = region
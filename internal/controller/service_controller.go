@@ -26,15 +26,20 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	naverv1alpha1 "github.com/suslmk-lee/kube-controller01/api/v1alpha1"
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud"
 
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 
-	"github.com/go-logr/logr"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 )
@@ -51,6 +56,16 @@ type ServiceReconciler struct {
 	SecretConfig SecretConfig
 	// 컨트롤러 네임스페이스 (Secret 조회용)
 	ControllerNamespace string
+	// Service 이벤트 기록기 (테스트에서는 nil 가능)
+	Recorder record.EventRecorder
+	// 삭제 정책 어노테이션이 없는 Service에 적용할 기본 삭제 정책 (비어 있으면 Delete)
+	DefaultDeletionPolicy string
+	// 리소스 설명에 기록할 클러스터 이름 (같은 VPC의 다른 클러스터가 만든 리소스와 구분)
+	ClusterName string
+	// 드리프트 정책 어노테이션이 없는 Service에 적용할 기본 드리프트 정책 (비어 있으면 Repair)
+	DefaultDriftPolicy string
+	// 구성 드리프트를 다시 확인하는 주기 (0이면 주기적으로 확인하지 않음)
+	DriftCheckInterval time.Duration
 }
 
 // NaverCloudConfig 구조체는 Naver Cloud API 접근을 위한 설정을 담고 있습니다
@@ -59,10 +74,20 @@ type NaverCloudConfig struct {
 	APISecret string
 	Region    string
 	// VPC 환경을 위한 설정
-	VpcNo    string // VPC 번호
-	SubnetNo string // 서브넷 번호
+	VpcNo           string // VPC 번호
+	SubnetNo        string // 서브넷 번호 (public 로드밸런서)
+	PrivateSubnetNo string // private 로드밸런서용 서브넷 번호
+	// 워커 노드 ACG 번호 (설정하면 NodePort 인바운드 규칙을 자동 관리)
+	NodeAccessControlGroupNo string
+	// API 엔드포인트 설정
+	EndpointProfile string // 엔드포인트 프로파일 (public, gov, fin, custom)
+	APIEndpoint     string // 사용자 지정 API Gateway URL (custom 프로파일 또는 프로파일 오버라이드)
 }
 
+// naverLBFinalizer는 Naver Cloud 리소스를 정리할 때까지 Service 삭제를 막는 finalizer입니다.
+// 리소스를 만들기 전에 추가되므로 이전에 LoadBalancer였던 Service를 식별하는 데에도 사용합니다
+const naverLBFinalizer = "naver.k-paas.org/lb-finalizer"
+
 // LoadBalancerStatus는 Naver Cloud 로드 밸런서의 상태를 추적합니다
 type LoadBalancerStatus struct {
 	ProvisioningStatus string
@@ -74,6 +99,12 @@ type LoadBalancerStatus struct {
 // +kubebuilder:rbac:groups=core,resources=services/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=core,resources=services/finalizers,verbs=update
 // +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;list;watch
+// +kubebuilder:rbac:groups=naver.k-paas.org,resources=naverloadbalancers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=naver.k-paas.org,resources=naverloadbalancers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=naver.k-paas.org,resources=naverloadbalancers/finalizers,verbs=update
 
 // Reconcile는 쿠버네티스 조정 루프의 일부로, 클러스터의 현재 상태를 원하는 상태에 가깝게 이동시키는 것을 목표로 합니다.
 // Service 객체가 지정한 상태와 실제 클러스터 상태를 비교하고,
@@ -99,7 +130,24 @@ func (r *ServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		// LoadBalancer 타입이 아니지만 이전에 LoadBalancer였던 경우 정리 필요
 		lbID, lbExists := service.Annotations["naver.k-paas.org/lb-id"]
 		targetGroups, targetGroupsExists := service.Annotations["naver.k-paas.org/target-groups"]
-		if (lbExists && lbID != "") || (targetGroupsExists && targetGroups != "") {
+
+		// NaverLoadBalancer에 기록된 리소스도 확인
+		nlb, err := r.getNaverLoadBalancer(ctx, &service)
+		if err != nil {
+			logger.Error(err, "NaverLoadBalancer 조회 실패")
+			return ctrl.Result{RequeueAfter: 5 * time.Second}, err
+		}
+		if nlb != nil {
+			if nlb.Status.LoadBalancerNo != "" {
+				lbID, lbExists = nlb.Status.LoadBalancerNo, true
+			}
+			if tgNos := nlb.Status.TargetGroupNos(); len(tgNos) > 0 {
+				targetGroups, targetGroupsExists = strings.Join(tgNos, ","), true
+			}
+		}
+
+		// 생성 도중 타입이 바뀌어 리소스 번호가 기록되지 않았더라도 NaverLoadBalancer나 finalizer가 남아 있으면 정리
+		if (lbExists && lbID != "") || (targetGroupsExists && targetGroups != "") || nlb != nil || containsString(service.Finalizers, naverLBFinalizer) {
 			logger.Info("서비스 타입이 변경됨, 기존 LoadBalancer 정리 시작",
 				"service-type", service.Spec.Type,
 				"previous-lb-id", lbID,
@@ -107,6 +155,10 @@ func (r *ServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 
 			// 기존 LoadBalancer 삭제
 			if err := r.deleteNaverCloudLB(ctx, &service); err != nil {
+				if isLoadBalancerBusy(err) {
+					logger.Info("기존 LoadBalancer 삭제 진행 중, 재시도 예정", "reason", err.Error())
+					return ctrl.Result{RequeueAfter: provisioningRequeueInterval}, nil
+				}
 				logger.Error(err, "기존 LoadBalancer 삭제 실패")
 				return ctrl.Result{RequeueAfter: 30 * time.Second}, err
 			}
@@ -126,7 +178,6 @@ func (r *ServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 			delete(latestService.Annotations, "naver.k-paas.org/target-groups")
 
 			// Finalizer 제거
-			naverLBFinalizer := "naver.k-paas.org/lb-finalizer"
 			latestService.Finalizers = removeString(latestService.Finalizers, naverLBFinalizer)
 
 			// 서비스 상태에서 LoadBalancer 정보 제거
@@ -152,15 +203,16 @@ func (r *ServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return ctrl.Result{}, nil
 	}
 
-	// Finalizer 처리
-	naverLBFinalizer := "naver.k-paas.org/lb-finalizer"
-
 	// 서비스가 삭제 중인지 확인
 	if !service.ObjectMeta.DeletionTimestamp.IsZero() {
 		// 삭제 중이고 finalizer가 있는 경우
 		if containsString(service.Finalizers, naverLBFinalizer) {
 			// Naver Cloud LB 삭제 로직 실행
 			if err := r.deleteNaverCloudLB(ctx, &service); err != nil {
+				if isLoadBalancerBusy(err) {
+					logger.Info("Naver Cloud LB 삭제 진행 중, 재시도 예정", "reason", err.Error())
+					return ctrl.Result{RequeueAfter: provisioningRequeueInterval}, nil
+				}
 				logger.Error(err, "Naver Cloud LB 삭제 실패")
 				return ctrl.Result{RequeueAfter: 30 * time.Second}, err
 			}
@@ -188,11 +240,17 @@ func (r *ServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 
 	// Naver Cloud LB 생성 또는 업데이트 로직
 	lbStatus, err := r.reconcileNaverCloudLB(ctx, &service)
+	if isLoadBalancerBusy(err) {
+		// 로드밸런서 변경이 끝나기를 기다리지 않고 다음 조정에서 이어서 진행
+		logger.Info("Naver Cloud LB 변경 진행 중, 재시도 예정", "reason", err.Error())
+		return ctrl.Result{RequeueAfter: provisioningRequeueInterval}, nil
+	}
 	if err != nil {
 		logger.Error(err, "Naver Cloud LB 조정 실패",
 			"service-name", service.Name,
 			"service-namespace", service.Namespace,
 			"service-type", service.Spec.Type)
+		r.markNaverLoadBalancerNotReady(ctx, &service, naverv1alpha1.ReasonReconcileFailed, err)
 		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
 	}
 
@@ -202,8 +260,8 @@ func (r *ServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 			"status", lbStatus.ProvisioningStatus,
 			"lb-id", lbStatus.LBID,
 			"external-ip", lbStatus.ExternalIP,
-			"requeue-after", "30s")
-		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+			"requeue-after", provisioningRequeueInterval.String())
+		return ctrl.Result{RequeueAfter: provisioningRequeueInterval}, nil
 	}
 
 	// 로드 밸런서가 오류 상태인 경우
@@ -247,11 +305,6 @@ func (r *ServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 			latestService.Annotations["naver.k-paas.org/lb-id"] = lbStatus.LBID
 		}
 
-		// 포트 정보 어노테이션 이전
-		if portInfo, ok := service.Annotations["naver.k-paas.org/ports"]; ok {
-			latestService.Annotations["naver.k-paas.org/ports"] = portInfo
-		}
-
 		// 기존 설정과 다른 경우에만 업데이트
 		if len(latestService.Status.LoadBalancer.Ingress) == 0 ||
 			(ingress.IP != "" && (len(latestService.Status.LoadBalancer.Ingress) == 0 || latestService.Status.LoadBalancer.Ingress[0].IP != ingress.IP)) ||
@@ -280,7 +333,8 @@ func (r *ServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		}
 	}
 
-	return ctrl.Result{}, nil
+	// 콘솔 변경이나 삭제를 감지할 수 있도록 주기적으로 다시 조정 (0이면 비활성화)
+	return ctrl.Result{RequeueAfter: r.DriftCheckInterval}, nil
 }
 
 // reconcileNaverCloudLB는 Naver Cloud 로드 밸런서를 생성하거나 업데이트합니다
@@ -288,34 +342,145 @@ func (r *ServiceReconciler) reconcileNaverCloudLB(ctx context.Context, service *
 	logger := log.FromContext(ctx).WithValues("service", types.NamespacedName{Namespace: service.Namespace, Name: service.Name})
 	logger.Info("Naver Cloud LB 조정 시작")
 
-	// SecretProvider를 통해 인증 정보 가져오기
-	credentials, err := r.getCredentials(ctx)
+	// Naver Cloud API 클라이언트 가져오기 (주입된 클라이언트 우선)
+	client, credentials, err := r.getNaverClient(ctx)
 	if err != nil {
-		return LoadBalancerStatus{}, fmt.Errorf("인증 정보 조회 실패: %w", err)
+		return LoadBalancerStatus{}, err
 	}
 
-	// Naver Cloud API 접근을 위한 인증 정보 설정
-	apiKeys := &ncloud.APIKey{
-		AccessKey: credentials.APIKey,
-		SecretKey: credentials.APISecret,
+	// 서비스에 대응하는 NaverLoadBalancer 조회 (없으면 생성, 기존 어노테이션은 마이그레이션)
+	nlb, err := r.ensureNaverLoadBalancer(ctx, service)
+	if err != nil {
+		return LoadBalancerStatus{}, err
 	}
 
-	// Naver Cloud VLoadBalancer API 클라이언트 생성
-	config := vloadbalancer.NewConfiguration(apiKeys)
-	// 공공망 엔드포인트 설정
-	config.BasePath = "https://ncloud.apigw.gov-ntruss.com/vloadbalancer/v2"
-	client := vloadbalancer.NewAPIClient(config)
+	// 리스너 구성까지 완료된 로드밸런서만 업데이트 경로로 처리 (중단된 생성은 이어서 진행)
+	lbID := nlb.Status.LoadBalancerNo
+	lbExists := lbID != "" && (nlb.Status.ObservedGeneration > 0 || nlb.Status.Adoption != nil)
 
-	// 타겟 그룹 ID 및 로드밸런서 ID가 서비스 어노테이션에 있는지 확인
-	targetGroupsStr, targetGroupsExist := service.Annotations["naver.k-paas.org/target-groups"]
-	lbID, lbExists := service.Annotations["naver.k-paas.org/lb-id"]
+	// 어노테이션으로 지정한 로드밸런서 타입과 포트 프로토콜 조합 확인
+	lbType, err := loadBalancerType(service)
+	if err != nil {
+		return LoadBalancerStatus{}, err
+	}
+	networkType, err := loadBalancerNetworkType(service)
+	if err != nil {
+		return LoadBalancerStatus{}, err
+	}
+	if err := validateLoadBalancerPorts(lbType, service.Spec.Ports); err != nil {
+		r.recordWarning(service, "UnsupportedProtocol", "%v", err)
+		return LoadBalancerStatus{}, err
+	}
+	if err := validateHealthCheckPorts(service); err != nil {
+		r.recordWarning(service, "InvalidHealthCheck", "%v", err)
+		return LoadBalancerStatus{}, err
+	}
+	if err := validateHealthCheckAnnotations(service, lbType); err != nil {
+		r.recordWarning(service, "InvalidHealthCheck", "%v", err)
+		return LoadBalancerStatus{}, err
+	}
+	lbSettings, err := parseLoadBalancerSettings(service, lbType)
+	if err != nil {
+		r.recordWarning(service, "InvalidLoadBalancerSettings", "%v", err)
+		return LoadBalancerStatus{}, err
+	}
+	if _, err := sourceRangesForService(service); err != nil {
+		r.recordWarning(service, "InvalidSourceRanges", "%v", err)
+		return LoadBalancerStatus{}, err
+	}
+	publicIP, err := requestedPublicIP(service, lbType, networkType)
+	if err != nil {
+		r.recordWarning(service, "InvalidPublicIP", "%v", err)
+		return LoadBalancerStatus{}, err
+	}
+	if _, err := r.deletionPolicy(service); err != nil {
+		r.recordWarning(service, "InvalidDeletionPolicy", "%v", err)
+		return LoadBalancerStatus{}, err
+	}
+	if _, err := r.driftPolicy(service); err != nil {
+		r.recordWarning(service, "InvalidDriftPolicy", "%v", err)
+		return LoadBalancerStatus{}, err
+	}
+	adoption, err := parseAdoptionRequest(service)
+	if err == nil && adoption != nil && publicIP != "" {
+		err = fmt.Errorf("예약 공인 IP는 기존 로드밸런서를 채택할 때 사용할 수 없음")
+	}
+	if err != nil {
+		r.recordWarning(service, "InvalidAdoption", "%v", err)
+		return LoadBalancerStatus{}, err
+	}
 
-	// 이미 생성된 타겟 그룹 ID 배열 생성
-	targetGroupIDs := []string{}
-	if targetGroupsExist && targetGroupsStr != "" {
-		targetGroupIDs = strings.Split(targetGroupsStr, ",")
+	// 기존 로드밸런서를 채택하면 생성 없이 업데이트 경로로 처리
+	if lbID == "" && adoption != nil {
+		if err := r.adoptLoadBalancer(ctx, client, credentials, service, nlb, adoption, lbType, networkType); err != nil {
+			r.recordWarning(service, "InvalidAdoption", "%v", err)
+			return LoadBalancerStatus{}, err
+		}
+		lbID, lbExists = nlb.Status.LoadBalancerNo, true
+	}
+	if lbID != "" {
+		// 로드밸런서 타입은 생성 후 변경할 수 없음
+		if currentType := recordedLoadBalancerType(&nlb.Status); currentType != lbType {
+			return LoadBalancerStatus{}, fmt.Errorf("로드밸런서 타입 변경은 지원하지 않음: %s -> %s (Service를 다시 생성하세요)", currentType, lbType)
+		}
+		if currentNetworkType := recordedNetworkType(&nlb.Status); currentNetworkType != networkType {
+			return LoadBalancerStatus{}, fmt.Errorf("로드밸런서 네트워크 타입 변경은 지원하지 않음: %s -> %s (Service를 다시 생성하세요)", currentNetworkType, networkType)
+		}
+		// 채택한 로드밸런서는 다른 로드밸런서로 바꿀 수 없음
+		if adoption != nil && adoption.LoadBalancerNo != lbID {
+			err := fmt.Errorf("사용 중인 로드밸런서 변경은 지원하지 않음: %s -> %s (Service를 다시 생성하세요)", lbID, adoption.LoadBalancerNo)
+			r.recordWarning(service, "InvalidAdoption", "%v", err)
+			return LoadBalancerStatus{}, err
+		}
+		// 공인 IP는 로드밸런서 생성 시에만 지정 가능
+		if currentPublicIP := recordedPublicIP(&nlb.Status); currentPublicIP != publicIP {
+			err := fmt.Errorf("예약 공인 IP 변경은 지원하지 않음: %q -> %q (Service를 다시 생성하세요)", currentPublicIP, publicIP)
+			r.recordWarning(service, "InvalidPublicIP", "%v", err)
+			return LoadBalancerStatus{}, err
+		}
 	}
 
+	// TLS 포트의 인증서 확인 (TLS Secret은 Certificate Manager로 가져옴)
+	settings, err := parseListenerSettings(service, lbType)
+	if err != nil {
+		r.recordWarning(service, "InvalidTLSConfiguration", "%v", err)
+		return LoadBalancerStatus{}, err
+	}
+	if err := r.resolveCertificate(ctx, client, service, nlb, settings); err != nil {
+		r.recordWarning(service, "InvalidTLSConfiguration", "%v", err)
+		return LoadBalancerStatus{}, err
+	}
+
+	// 로드밸런서를 새로 만들어야 하면 서브넷부터 확인 (타겟 그룹만 생성되고 멈추는 것을 방지)
+	var subnetNos []string
+	var reservedIP *naverv1alpha1.ReservedPublicIPStatus
+	if lbID == "" {
+		if subnetNos, err = r.loadBalancerSubnets(ctx, client, credentials, service, networkType); err != nil {
+			r.recordWarning(service, "InvalidSubnet", "%v", err)
+			return LoadBalancerStatus{}, err
+		}
+		// 다른 Service의 소스 범위 규칙이 있는 Network ACL에서는 트래픽이 차단됨
+		if err := r.checkSourceRangeSubnets(ctx, client, credentials, nlb, subnetNos); err != nil {
+			r.recordWarning(service, "SharedSubnetSourceRanges", "%v", err)
+			return LoadBalancerStatus{}, err
+		}
+		// 예약 공인 IP는 서브넷 하나에만 연결할 수 있고 다른 곳에서 사용 중이면 안 됨
+		if publicIP != "" {
+			if len(subnetNos) != 1 {
+				err := fmt.Errorf("예약 공인 IP는 로드밸런서 서브넷이 하나일 때만 연결할 수 있음: %v", subnetNos)
+				r.recordWarning(service, "InvalidPublicIP", "%v", err)
+				return LoadBalancerStatus{}, err
+			}
+			if reservedIP, err = r.resolvePublicIP(ctx, client, credentials, nlb, publicIP); err != nil {
+				r.recordWarning(service, "InvalidPublicIP", "%v", err)
+				return LoadBalancerStatus{}, err
+			}
+		}
+	}
+
+	// 이미 생성된 타겟 그룹 ID 배열 생성 (Service 포트 순서)
+	targetGroupIDs := targetGroupIDsForPorts(&nlb.Status, service.Spec.Ports)
+
 	// 서비스에서 포트 정보 가져오기
 	var ports []int32
 	var protocols []string
@@ -332,275 +497,211 @@ func (r *ServiceReconciler) reconcileNaverCloudLB(ctx context.Context, service *
 
 	if !lbExists {
 		// 새 로드 밸런서 생성 (이름 길이 제한 고려)
-		lbName := r.generateValidName("k8s-lb", service.Namespace, service.Name, "")
+		lbName := r.resourceName("k8s-lb", service, "")
 
-		// 1. 각 포트마다 타겟 그룹 먼저 생성
-		targetGroupIDs = []string{}
+		// 1. 각 포트마다 타겟 그룹 먼저 생성 (이미 기록된 타겟 그룹은 재사용)
 		for i, port := range service.Spec.Ports {
-			tgName := r.generateValidName("tg", service.Namespace, service.Name, fmt.Sprintf("%d", i))
-			logger.Info("타겟 그룹 이름 생성", "original-parts", fmt.Sprintf("tg-%s-%s-%d", service.Namespace, service.Name, i), "generated-name", tgName)
-
-			// 프로토콜 설정
-			protocolType := string(port.Protocol)
-			if protocolType == "" {
-				protocolType = "TCP"
-			}
-
-			// 타겟 그룹 생성 요청 - SDK의 정확한 필드명 사용
-			tgReq := vloadbalancer.CreateTargetGroupRequest{
-				RegionCode:                  ncloud.String(credentials.Region),
-				VpcNo:                       ncloud.String(credentials.VpcNo),
-				TargetGroupName:             ncloud.String(tgName),
-				TargetTypeCode:              ncloud.String("VSVR"), // 가상 서버 타입
-				TargetGroupPort:             ncloud.Int32(port.NodePort),
-				TargetGroupProtocolTypeCode: ncloud.String("PROXY_TCP"),
-				TargetGroupDescription:      ncloud.String(fmt.Sprintf("Target group for %s/%s port %d", service.Namespace, service.Name, port.Port)),
-				HealthCheckProtocolTypeCode: ncloud.String(protocolType),
-				HealthCheckPort:             ncloud.Int32(port.NodePort),
+			if targetGroupIDs[i] != "" {
+				continue
 			}
 
-			// 타겟 그룹 생성 API 호출
-			tgResp, err := client.V2Api.CreateTargetGroup(&tgReq)
-			var targetGroupID string
+			tgName := r.resourceName("tg", service, fmt.Sprintf("%d", port.Port))
+			logger.Info("타겟 그룹 이름 생성", "original-parts", fmt.Sprintf("tg-%s-%s-%d", service.Namespace, service.Name, port.Port), "generated-name", tgName)
 
+			legacyName := r.generateValidName("tg", service.Namespace, service.Name, fmt.Sprintf("%d", port.Port))
+			targetGroupID, err := r.createTargetGroupForPort(ctx, client, credentials, service, port, tgName, legacyName)
 			if err != nil {
-				logger.Info("타겟 그룹 생성 API 에러 발생, 실제 생성 여부 확인 중", "port", port.Port, "error", err)
-
-				// 에러가 발생해도 실제로는 생성되었을 수 있으므로 조회해봄
-				// 타겟 그룹 이름으로 조회 시도
-				listReq := vloadbalancer.GetTargetGroupListRequest{
-					RegionCode: ncloud.String(credentials.Region),
-					VpcNo:      ncloud.String(credentials.VpcNo),
-				}
-
-				listResp, listErr := client.V2Api.GetTargetGroupList(&listReq)
-				if listErr == nil && listResp != nil {
-					// 생성하려던 이름과 일치하는 타겟 그룹 찾기
-					for _, tg := range listResp.TargetGroupList {
-						if tg.TargetGroupName != nil && *tg.TargetGroupName == tgName {
-							targetGroupID = *tg.TargetGroupNo
-							logger.Info("기존 타겟 그룹 발견됨", "targetGroupID", targetGroupID, "name", tgName)
-							break
-						}
-					}
-				}
-
-				// 여전히 타겟 그룹을 찾을 수 없으면 에러 반환
-				if targetGroupID == "" {
-					logger.Error(err, "타겟 그룹 생성 및 조회 모두 실패", "port", port.Port)
-					return LoadBalancerStatus{}, fmt.Errorf("타겟 그룹 생성 실패: %w", err)
-				}
-			} else {
-				// 정상 응답 처리
-				if tgResp == nil || len(tgResp.TargetGroupList) == 0 {
-					logger.Error(nil, "타겟 그룹 응답이 올바르지 않음")
-					return LoadBalancerStatus{}, fmt.Errorf("타겟 그룹 생성 응답이 올바르지 않음")
-				}
-				targetGroupID = *tgResp.TargetGroupList[0].TargetGroupNo
+				return LoadBalancerStatus{}, err
 			}
 
-			// 타겟 그룹 ID 저장
-			targetGroupIDs = append(targetGroupIDs, targetGroupID)
-
-			// 상태 업데이트 전에 가장 최신 버전의 서비스 객체를 다시 가져옵니다
-			var tempService corev1.Service
-			if err := r.Get(ctx, types.NamespacedName{Namespace: service.Namespace, Name: service.Name}, &tempService); err != nil {
-				logger.Error(err, "최신 서비스 객체 가져오기 실패")
-			} else {
-				// 타겟 그룹 ID를 어노테이션에 저장
-				if tempService.Annotations == nil {
-					tempService.Annotations = make(map[string]string)
-				}
-
-				// 기존 타겟 그룹 정보가 있는지 확인
-				existingGroups := []string{}
-				if tgStr, ok := tempService.Annotations["naver.k-paas.org/target-groups"]; ok && tgStr != "" {
-					existingGroups = strings.Split(tgStr, ",")
-				}
-
-				// 이미 있는지 확인
-				alreadyExists := false
-				for _, existingID := range existingGroups {
-					if existingID == targetGroupID {
-						alreadyExists = true
-						break
-					}
-				}
-
-				// 없으면 추가
-				if !alreadyExists {
-					existingGroups = append(existingGroups, targetGroupID)
-					tempService.Annotations["naver.k-paas.org/target-groups"] = strings.Join(existingGroups, ",")
-
-					// 어노테이션 업데이트
-					if err := r.Update(ctx, &tempService); err != nil {
-						logger.Error(err, "타겟 그룹 어노테이션 업데이트 실패")
-					}
-				}
+			// 타겟 그룹 ID 저장 (중단되더라도 다음 조정에서 재사용할 수 있도록 즉시 상태에 기록)
+			targetGroupIDs[i] = targetGroupID
+			if err := r.recordTargetGroup(ctx, nlb, service, port, targetGroupID, tgName); err != nil {
+				return LoadBalancerStatus{}, err
 			}
 
 			logger.Info("타겟 그룹 생성 성공", "targetGroupID", targetGroupID, "port", port.Port)
+		}
 
-			// NetworkProxy 타입에서도 타겟 추가가 필요할 수 있음 - 다시 시도
-			if err := r.addNodesToTargetGroup(ctx, client, targetGroupID, port.NodePort); err != nil {
-				logger.Error(err, "타겟 그룹에 노드 추가 실패", "targetGroupID", targetGroupID)
-				// 노드 추가 실패는 전체 프로세스를 중단하지 않지만 경고 로그 출력
-			} else {
-				logger.Info("타겟 그룹에 노드 추가 성공", "targetGroupID", targetGroupID)
+		// 생성 요청 후 번호를 기록하기 전에 중단되었으면 이 Service가 만든 같은 이름(해시를 붙이기 전 이름 포함)의 로드밸런서를 재사용
+		if lbID == "" && nlb.Status.Phase == naverv1alpha1.PhaseTargetGroupsCreated {
+			lb, err := r.findOwnedLoadBalancer(client, credentials, service, lbName, r.generateValidName("k8s-lb", service.Namespace, service.Name, ""))
+			if err != nil {
+				return LoadBalancerStatus{}, err
+			}
+			if lb != nil {
+				lbID = ncloud.StringValue(lb.LoadBalancerInstanceNo)
+				logger.Info("생성이 중단된 로드밸런서 발견", "lb-id", lbID, "lb-name", ncloud.StringValue(lb.LoadBalancerName))
+				if err := r.recordLoadBalancer(ctx, nlb, lbID, ncloud.StringValue(lb.LoadBalancerName), lbType, networkType, subnetNos, reservedIP); err != nil {
+					return LoadBalancerStatus{}, err
+				}
 			}
 		}
 
-		// 로드밸런서 생성 요청 구성 (디버깅용 로그 추가)
-		logger.Info("로드밸런서 생성 요청 구성",
-			"VpcNo", credentials.VpcNo,
-			"SubnetNo", credentials.SubnetNo,
-			"Region", credentials.Region)
+		// 2. 로드밸런서 생성 요청 (생성 중 중단되어 상태에 기록된 로드밸런서가 있으면 재사용)
+		if lbID == "" {
+			if err := r.recordPhase(ctx, nlb, naverv1alpha1.PhaseTargetGroupsCreated); err != nil {
+				return LoadBalancerStatus{}, err
+			}
 
-		req := vloadbalancer.CreateLoadBalancerInstanceRequest{
-			RegionCode:              ncloud.String(credentials.Region),
-			LoadBalancerName:        ncloud.String(lbName),
-			LoadBalancerDescription: ncloud.String(fmt.Sprintf("Auto-created by K-PaaS controller for service %s/%s", service.Namespace, service.Name)),
-			VpcNo:                   ncloud.String(credentials.VpcNo),
-			LoadBalancerTypeCode:    ncloud.String("NETWORK_PROXY"),                 // 네트워크 프록시 로드밸런서 사용
-			SubnetNoList:            []*string{ncloud.String(credentials.SubnetNo)}, // 서브넷 정보 추가
-		}
+			// 로드밸런서 생성 요청 구성 (디버깅용 로그 추가)
+			logger.Info("로드밸런서 생성 요청 구성",
+				"VpcNo", credentials.VpcNo,
+				"SubnetNos", subnetNos,
+				"NetworkType", networkType,
+				"Region", credentials.Region)
 
-		// Naver Cloud API를 호출하여 로드밸런서 생성
-		resp, err := client.V2Api.CreateLoadBalancerInstance(&req)
-		if err != nil {
-			// 중복 이름 오류인 경우 기존 로드밸런서 찾기
-			if strings.Contains(err.Error(), "1200013") || strings.Contains(err.Error(), "Duplicate load balancer name") {
-				logger.Info("중복 로드밸런서 이름 오류, 기존 로드밸런서 검색", "lb-name", lbName)
-
-				// 기존 로드밸런서 조회
-				listReq := vloadbalancer.GetLoadBalancerInstanceListRequest{
-					RegionCode: ncloud.String(credentials.Region),
-					VpcNo:      ncloud.String(credentials.VpcNo),
-				}
+			req := vloadbalancer.CreateLoadBalancerInstanceRequest{
+				RegionCode:                  ncloud.String(credentials.Region),
+				LoadBalancerName:            ncloud.String(lbName),
+				LoadBalancerDescription:     ncloud.String(r.loadBalancerDescription(service)),
+				VpcNo:                       ncloud.String(credentials.VpcNo),
+				LoadBalancerTypeCode:        ncloud.String(lbType),      // 어노테이션으로 선택 (기본값 NETWORK_PROXY)
+				LoadBalancerNetworkTypeCode: ncloud.String(networkType), // 어노테이션으로 선택 (기본값 PUBLIC)
+				SubnetNoList:                ncloud.StringList(subnetNos),
+			}
+			lbSettings.applyToLoadBalancer(&req)
+			applyPublicIP(&req, subnetNos, reservedIP)
+
+			// Naver Cloud API를 호출하여 로드밸런서 생성
+			resp, err := client.CreateLoadBalancerInstance(&req)
+			if err != nil {
+				// 중복 이름 오류인 경우 기존 로드밸런서 찾기
+				if strings.Contains(err.Error(), "1200013") || strings.Contains(err.Error(), "Duplicate load balancer name") {
+					logger.Info("중복 로드밸런서 이름 오류, 기존 로드밸런서 검색", "lb-name", lbName)
+
+					// 기존 로드밸런서 조회
+					listReq := vloadbalancer.GetLoadBalancerInstanceListRequest{
+						RegionCode: ncloud.String(credentials.Region),
+						VpcNo:      ncloud.String(credentials.VpcNo),
+					}
 
-				listResp, listErr := client.V2Api.GetLoadBalancerInstanceList(&listReq)
-				if listErr == nil && listResp != nil {
-					// 같은 이름의 로드밸런서 찾기
-					for _, lb := range listResp.LoadBalancerInstanceList {
-						if lb.LoadBalancerName != nil && *lb.LoadBalancerName == lbName {
-							lbID = *lb.LoadBalancerInstanceNo
-							logger.Info("기존 로드밸런서 발견됨", "lb-id", lbID, "lb-name", lbName)
-
-							// 기존 로드밸런서를 사용하므로 resp 구조체 생성
-							resp = &vloadbalancer.CreateLoadBalancerInstanceResponse{
-								LoadBalancerInstanceList: []*vloadbalancer.LoadBalancerInstance{lb},
+					listResp, listErr := client.GetLoadBalancerInstanceList(&listReq)
+					if listErr == nil && listResp != nil {
+						// 같은 이름의 로드밸런서 찾기 (다른 클러스터나 Service가 만든 로드밸런서는 사용하지 않음)
+						for _, lb := range listResp.LoadBalancerInstanceList {
+							// 삭제 중인 로드밸런서는 재사용할 수 없음 (삭제가 끝나면 다음 조정에서 생성)
+							if code, _ := loadBalancerInstanceStatus(lb); code == "TERMINATING" {
+								continue
+							}
+							if lb.LoadBalancerName != nil && *lb.LoadBalancerName == lbName {
+								if !r.ownedByService(ncloud.StringValue(lb.LoadBalancerDescription), service) {
+									owner, _ := parseResourceOwner(ncloud.StringValue(lb.LoadBalancerDescription))
+									logger.Info("같은 이름의 로드밸런서가 이 Service 소유가 아님", "lb-id", ncloud.StringValue(lb.LoadBalancerInstanceNo), "owner", owner.String())
+									err = fmt.Errorf("같은 이름의 로드밸런서 %s가 이 Service가 만든 것이 아님 (%s): %w", ncloud.StringValue(lb.LoadBalancerInstanceNo), ncloud.StringValue(lb.LoadBalancerDescription), err)
+									break
+								}
+								lbID = *lb.LoadBalancerInstanceNo
+								logger.Info("기존 로드밸런서 발견됨", "lb-id", lbID, "lb-name", lbName)
+
+								// 기존 로드밸런서를 사용하므로 resp 구조체 생성
+								resp = &vloadbalancer.CreateLoadBalancerInstanceResponse{
+									LoadBalancerInstanceList: []*vloadbalancer.LoadBalancerInstance{lb},
+								}
+								err = nil // 에러 클리어
+								break
 							}
-							err = nil // 에러 클리어
-							break
 						}
 					}
-				}
 
-				// 여전히 찾을 수 없으면 원래 에러 반환
-				if err != nil {
+					// 여전히 찾을 수 없으면 원래 에러 반환
+					if err != nil {
+						return LoadBalancerStatus{}, fmt.Errorf("로드밸런서 생성 실패: %w", err)
+					}
+				} else {
 					return LoadBalancerStatus{}, fmt.Errorf("로드밸런서 생성 실패: %w", err)
 				}
-			} else {
-				return LoadBalancerStatus{}, fmt.Errorf("로드밸런서 생성 실패: %w", err)
 			}
-		}
 
-		// 응답 확인
-		if resp == nil || len(resp.LoadBalancerInstanceList) == 0 {
-			return LoadBalancerStatus{}, fmt.Errorf("로드밸런서 생성 응답이 올바르지 않음")
-		}
+			// 응답 확인
+			if resp == nil || len(resp.LoadBalancerInstanceList) == 0 {
+				return LoadBalancerStatus{}, fmt.Errorf("로드밸런서 생성 응답이 올바르지 않음")
+			}
 
-		// 생성된 로드밸런서 정보 가져오기
-		lbInstance := resp.LoadBalancerInstanceList[0]
-		lbID = *lbInstance.LoadBalancerInstanceNo
+			// 생성된 로드밸런서 정보 가져오기
+			lbInstance := resp.LoadBalancerInstanceList[0]
+			lbID = *lbInstance.LoadBalancerInstanceNo
 
-		// 로드밸런서가 준비될 때까지 대기 (상태가 Running이 될 때까지)
-		if err := r.waitForLoadBalancerReady(ctx, client, lbID, 10); err != nil {
-			logger.Info("로드밸런서 준비 대기 실패, 리스너 생성 계속 시도", "error", err.Error())
-			// 계속 진행하되 리스너 생성은 나중에 재시도
+			// 로드밸런서 번호 기록 (LBCreating 단계)
+			if err := r.recordLoadBalancer(ctx, nlb, lbID, lbName, lbType, networkType, subnetNos, reservedIP); err != nil {
+				return LoadBalancerStatus{}, err
+			}
 		} else {
-			logger.Info("로드밸런서 준비 완료, 리스너 생성 시작")
+			logger.Info("상태에 기록된 로드밸런서 재사용", "lb-id", lbID)
+		}
+
+		// 로드밸런서가 준비되지 않았으면 기다리지 않고 다음 조정에서 이어서 진행
+		if err := r.checkLoadBalancerReady(client, credentials, lbID); err != nil {
+			if isLoadBalancerBusy(err) {
+				logger.Info("로드밸런서 준비 중, 다음 조정에서 리스너 생성", "lb-id", lbID, "reason", err.Error())
+				return LoadBalancerStatus{ProvisioningStatus: "PENDING", LBID: lbID}, nil
+			}
+			return LoadBalancerStatus{}, err
 		}
 
-		// 2. 로드밸런서 생성 후 리스너 추가
+		// 3. 로드밸런서가 준비되면 리스너 추가
 		logger.Info("리스너 생성 시작", "targetGroupCount", len(targetGroupIDs), "portCount", len(service.Spec.Ports))
 
 		// 기존 리스너 조회
-		existingListeners := make(map[int32]bool) // 포트별 기존 리스너 맵
-		listenerListReq := vloadbalancer.GetLoadBalancerListenerListRequest{
+		existingListeners := make(map[string]bool) // 포트/프로토콜별 기존 리스너 맵
+		listenerListResp, err := client.GetLoadBalancerListenerList(&vloadbalancer.GetLoadBalancerListenerListRequest{
 			RegionCode:             ncloud.String(credentials.Region),
 			LoadBalancerInstanceNo: &lbID,
+		})
+		if err != nil {
+			return LoadBalancerStatus{}, fmt.Errorf("리스너 목록 조회 실패: %w", err)
 		}
-
-		listenerListResp, listErr := client.V2Api.GetLoadBalancerListenerList(&listenerListReq)
-		if listErr == nil && listenerListResp != nil {
-			for _, listener := range listenerListResp.LoadBalancerListenerList {
-				if listener.Port != nil {
-					existingListeners[*listener.Port] = true
-					logger.Info("기존 리스너 발견", "port", *listener.Port)
-				}
+		for _, listener := range listenerListResp.LoadBalancerListenerList {
+			if listener.Port != nil {
+				existingListeners[apiListenerKey(listener)] = true
 			}
-		} else {
-			logger.Info("기존 리스너 조회 실패 또는 없음", "error", listErr)
 		}
 
-		if len(targetGroupIDs) > 0 {
-			// 순차적 리스너 생성 (안정성을 위해 각 리스너 생성 후 대기)
-			err = r.createListenersSequentially(ctx, client, lbID, service.Spec.Ports, targetGroupIDs, existingListeners, logger)
-			if err != nil {
-				logger.Error(err, "리스너 순차 생성 실패")
-				// 일부 리스너 실패해도 LoadBalancer 자체는 사용 가능하므로 계속 진행
+		// 리스너를 만들면 로드밸런서가 다시 변경 중이 되므로 남은 리스너는 다음 조정에서 생성
+		if err := r.createListeners(ctx, client, credentials, lbID, settings, service.Spec.Ports, targetGroupIDs, existingListeners); err != nil {
+			if isLoadBalancerBusy(err) {
+				logger.Info("로드밸런서 변경 중, 다음 조정에서 리스너 생성 계속", "lb-id", lbID, "reason", err.Error())
+				return LoadBalancerStatus{ProvisioningStatus: "PENDING", LBID: lbID}, nil
 			}
+			return LoadBalancerStatus{}, err
 		}
-
-		// 서비스 어노테이션 설정
-		if service.Annotations == nil {
-			service.Annotations = make(map[string]string)
+		if err := r.recordPhase(ctx, nlb, naverv1alpha1.PhaseListenersCreated); err != nil {
+			return LoadBalancerStatus{}, err
 		}
 
-		// 로드밸런서 ID 저장
-		service.Annotations["naver.k-paas.org/lb-id"] = lbID
-
-		// 타겟 그룹 ID 저장
-		if len(targetGroupIDs) > 0 {
-			service.Annotations["naver.k-paas.org/target-groups"] = strings.Join(targetGroupIDs, ",")
+		// 4. 타겟 그룹에 워커 노드 등록
+		targets, err := r.syncServiceTargets(ctx, client, credentials, service, targetGroupIDs, adoptedTargetGroups(&nlb.Status))
+		if err != nil {
+			logger.Error(err, "타겟 그룹 타겟 등록 실패")
+			return LoadBalancerStatus{}, err
+		}
+		if err := r.recordPhase(ctx, nlb, naverv1alpha1.PhaseTargetsRegistered); err != nil {
+			return LoadBalancerStatus{}, err
 		}
 
-		// 로그에 로드밸런서 생성 정보 출력
-		logger.Info("네이버 클라우드 NetworkProxy LB 생성 완료",
-			"lb-id", lbID,
-			"lb-name", lbName,
-			"target-groups", targetGroupIDs)
-
-		// 네트워크 프록시 LB는 리스너 설정을 다르게 해야 합니다
-		// 실제 네트워크 프록시 LB 생성 후 추가 작업이 필요할 수 있음
-		// 이 부분은 네트워크 프록시 LB의 정확한 API 구조에 맞게 추후 구현해야 함
-
-		// 시작하는 로드밸런서를 생성하고 서비스 어노테이션에 정보 추가
-		logger.Info("로드밸런서 생성 완료, 포트 설정 정보 저장", "port-infos", service.Annotations["naver.k-paas.org/ports"])
-
-		// 실제 External IP/Domain 가져오기 (재시도 포함)
-		extIP := ""
-		getIPErr := error(nil)
-
-		// 로드밸런서가 완전히 준비된 후 외부 주소 획득 시도
-		for retry := 0; retry < 5; retry++ {
-			extIP, getIPErr = r.getLoadBalancerExternalAddress(ctx, client, lbID)
-			if getIPErr == nil {
-				break
-			}
+		// 가져온 인증서 기록 (교체된 이전 인증서는 삭제)
+		if err := r.recordCertificate(ctx, client, nlb, settings); err != nil {
+			return LoadBalancerStatus{}, err
+		}
 
-			logger.Info("외부 주소 획득 재시도", "retry", retry+1, "error", getIPErr.Error())
-			time.Sleep(time.Duration(10+retry*5) * time.Second)
+		// loadBalancerSourceRanges를 로드밸런서 서브넷의 Network ACL에 반영
+		if err := r.syncSourceRanges(ctx, client, credentials, service, nlb); err != nil {
+			logger.Error(err, "소스 범위 접근 제어 적용 실패")
+			return LoadBalancerStatus{}, err
 		}
 
-		if getIPErr != nil {
-			logger.Error(getIPErr, "로드밸런서 외부 주소 획득 최종 실패")
-			// 임시 도메인 생성 (fallback)
-			extIP = fmt.Sprintf("lb-%s.ncloud.com", lbID)
+		// 워커 노드 ACG에서 NodePort 허용 (NAVER_CLOUD_NODE_ACG_NO 설정 시)
+		if err := r.syncNodeAccessRules(ctx, client, credentials, service, nlb); err != nil {
+			logger.Error(err, "워커 노드 ACG 규칙 적용 실패")
+			return LoadBalancerStatus{}, err
 		}
 
-		logger.Info("로드밸런서 생성 완료", "lb-id", lbID, "external-address", extIP)
+		// 5. 외부 주소 확인 (아직 없으면 다음 조정에서 다시 확인)
+		extIP, err := r.getLoadBalancerExternalAddress(ctx, client, lbID, networkType)
+		if err != nil {
+			logger.Info("외부 주소 획득 실패로 PENDING 상태 설정", "lb-id", lbID, "error", err.Error())
+			return LoadBalancerStatus{ProvisioningStatus: "PENDING", LBID: lbID}, nil
+		}
 
 		// 서비스 어노테이션 업데이트 (최신 버전 가져와서 업데이트)
 		if err := r.updateServiceAnnotations(ctx, service, map[string]string{
@@ -609,20 +710,15 @@ func (r *ServiceReconciler) reconcileNaverCloudLB(ctx context.Context, service *
 			return LoadBalancerStatus{}, fmt.Errorf("서비스 어노테이션 업데이트 실패: %w", err)
 		}
 
-		logger.Info("새 Naver Cloud LB 생성됨", "lb-id", lbID, "external-ip", extIP)
+		logger.Info("새 Naver Cloud LB 생성됨", "lb-id", lbID, "lb-name", lbName, "target-groups", targetGroupIDs, "external-ip", extIP)
 
-		// 로드밸런서 상태 확인
-		provisioningStatus := "ACTIVE"
-		if getIPErr != nil {
-			// 외부 주소를 가져올 수 없으면 아직 준비 중일 수 있음
-			logger.Info("외부 주소 획득 실패로 PENDING 상태 설정", "error", getIPErr.Error())
-			provisioningStatus = "PENDING"
-		} else {
-			logger.Info("외부 주소 획득 성공, ACTIVE 상태 설정", "external-ip", extIP)
+		// 주소, 리스너 정보와 Ready 조건을 NaverLoadBalancer 상태에 반영 (Ready 단계)
+		if err := r.syncNaverLoadBalancerStatus(ctx, client, nlb, lbID, settings, service.Spec.Ports, targetGroupIDs, targets); err != nil {
+			return LoadBalancerStatus{}, err
 		}
 
 		return LoadBalancerStatus{
-			ProvisioningStatus: provisioningStatus,
+			ProvisioningStatus: "ACTIVE",
 			LBID:               lbID,
 			ExternalIP:         extIP,
 		}, nil
@@ -635,13 +731,69 @@ func (r *ServiceReconciler) reconcileNaverCloudLB(ctx context.Context, service *
 	// Naver Cloud VLoadBalancer API 클라이언트 생성 (기존 client 재사용)
 	updateClient := client
 
+	// 콘솔 변경이나 삭제로 실제 구성이 기록과 달라졌는지 확인하고 드리프트 정책에 따라 처리
+	replaceTargetGroups, skip, err := r.reconcileDrift(ctx, updateClient, credentials, service, nlb, settings)
+	if err != nil {
+		return LoadBalancerStatus{}, err
+	}
+	if skip {
+		if nlb.Status.LoadBalancerNo == "" {
+			// 삭제된 로드밸런서는 다음 조정에서 다시 생성
+			return LoadBalancerStatus{ProvisioningStatus: "PENDING"}, nil
+		}
+		// Report 정책: 드리프트가 남아 있는 동안 로드밸런서를 변경하지 않음
+		return LoadBalancerStatus{ProvisioningStatus: "ACTIVE", LBID: lbID}, nil
+	}
+
 	// 실제 External IP/Domain 가져오기
-	extIP, err := r.getLoadBalancerExternalAddress(ctx, updateClient, lbID)
+	extIP, err := r.getLoadBalancerExternalAddress(ctx, updateClient, lbID, networkType)
 	if err != nil {
 		logger.Error(err, "기존 로드밸런서 외부 주소 획득 실패")
 		return LoadBalancerStatus{}, err
 	}
 
+	// 유휴 타임아웃과 처리량 타입 변경을 로드밸런서에 반영
+	if err := r.syncLoadBalancerConfiguration(ctx, updateClient, credentials, lbID, lbSettings); err != nil {
+		logger.Error(err, "기존 로드밸런서 설정 변경 실패")
+		return LoadBalancerStatus{}, err
+	}
+
+	// 포트 추가/삭제/NodePort 변경을 타겟 그룹과 리스너에 반영
+	targetGroupIDs, err = r.reconcileServicePorts(ctx, updateClient, credentials, service, nlb, lbID, settings, lbSettings, replaceTargetGroups)
+	if err != nil {
+		logger.Error(err, "기존 로드밸런서 포트 조정 실패")
+		return LoadBalancerStatus{}, err
+	}
+
+	// 리스너가 새 인증서로 바뀌었으면 기록하고 이전에 가져온 인증서 삭제
+	if err := r.recordCertificate(ctx, updateClient, nlb, settings); err != nil {
+		return LoadBalancerStatus{}, err
+	}
+
+	// loadBalancerSourceRanges 변경을 로드밸런서 서브넷의 Network ACL에 반영
+	if err := r.syncSourceRanges(ctx, updateClient, credentials, service, nlb); err != nil {
+		logger.Error(err, "소스 범위 접근 제어 동기화 실패")
+		return LoadBalancerStatus{}, err
+	}
+
+	// NodePort 변경을 워커 노드 ACG 규칙에 반영
+	if err := r.syncNodeAccessRules(ctx, updateClient, credentials, service, nlb); err != nil {
+		logger.Error(err, "워커 노드 ACG 규칙 동기화 실패")
+		return LoadBalancerStatus{}, err
+	}
+
+	// 노드 추가/삭제/NotReady/cordon을 타겟 그룹 타겟에 반영
+	targets, err := r.syncServiceTargets(ctx, updateClient, credentials, service, targetGroupIDs, adoptedTargetGroups(&nlb.Status))
+	if err != nil {
+		logger.Error(err, "타겟 그룹 타겟 동기화 실패")
+		return LoadBalancerStatus{}, err
+	}
+
+	// NaverLoadBalancer 상태 갱신
+	if err := r.syncNaverLoadBalancerStatus(ctx, updateClient, nlb, lbID, settings, service.Spec.Ports, targetGroupIDs, targets); err != nil {
+		return LoadBalancerStatus{}, err
+	}
+
 	return LoadBalancerStatus{
 		ProvisioningStatus: "ACTIVE",
 		LBID:               lbID,
@@ -649,8 +801,9 @@ func (r *ServiceReconciler) reconcileNaverCloudLB(ctx context.Context, service *
 	}, nil
 }
 
-// getLoadBalancerExternalAddress는 로드밸런서의 외부 접근 주소를 가져옵니다
-func (r *ServiceReconciler) getLoadBalancerExternalAddress(ctx context.Context, client *vloadbalancer.APIClient, lbID string) (string, error) {
+// getLoadBalancerExternalAddress는 로드밸런서의 외부 접근 주소를 가져옵니다.
+// private 로드밸런서는 VPC 내부에서 접근할 사설 IP를 도메인보다 우선합니다
+func (r *ServiceReconciler) getLoadBalancerExternalAddress(ctx context.Context, client NaverCloudClient, lbID, networkType string) (string, error) {
 	logger := log.FromContext(ctx)
 
 	// 로드밸런서 상세 정보 조회
@@ -658,7 +811,7 @@ func (r *ServiceReconciler) getLoadBalancerExternalAddress(ctx context.Context,
 		LoadBalancerInstanceNo: &lbID,
 	}
 
-	detailResp, err := client.V2Api.GetLoadBalancerInstanceDetail(&detailReq)
+	detailResp, err := client.GetLoadBalancerInstanceDetail(&detailReq)
 	if err != nil {
 		return "", fmt.Errorf("로드밸런서 상세 정보 조회 실패: %w", err)
 	}
@@ -705,6 +858,17 @@ func (r *ServiceReconciler) getLoadBalancerExternalAddress(ctx context.Context,
 			return 0
 		}())
 
+	// private 로드밸런서는 할당된 사설 IP 사용
+	if networkType == lbNetworkTypePrivate {
+		for _, ip := range lbInstance.LoadBalancerIpList {
+			if ip != nil && *ip != "" {
+				logger.Info("private 로드밸런서 사설 IP 획득", "ip", *ip)
+				return *ip, nil
+			}
+		}
+		logger.Info("private 로드밸런서 사설 IP가 아직 없음, 도메인 확인", "lb-id", lbID)
+	}
+
 	// 1. LoadBalancerDomain 확인 (도메인 기반 접근)
 	if lbInstance.LoadBalancerDomain != nil && *lbInstance.LoadBalancerDomain != "" {
 		logger.Info("로드밸런서 Domain 획득", "domain", *lbInstance.LoadBalancerDomain)
@@ -742,179 +906,213 @@ func (r *ServiceReconciler) getLoadBalancerExternalAddress(ctx context.Context,
 	return defaultDomain, nil
 }
 
-// waitForLoadBalancerReady는 로드밸런서가 준비될 때까지 대기합니다
-func (r *ServiceReconciler) waitForLoadBalancerReady(ctx context.Context, client *vloadbalancer.APIClient, lbID string, maxRetries int) error {
-	logger := log.FromContext(ctx)
-
-	for i := 0; i < maxRetries; i++ {
-		detailReq := vloadbalancer.GetLoadBalancerInstanceDetailRequest{
-			LoadBalancerInstanceNo: &lbID,
-		}
-
-		detailResp, err := client.V2Api.GetLoadBalancerInstanceDetail(&detailReq)
-		if err != nil {
-			logger.Error(err, "로드밸런서 상태 확인 실패", "retry", i+1)
-			time.Sleep(15 * time.Second)
-			continue
-		}
-
-		if detailResp != nil && len(detailResp.LoadBalancerInstanceList) > 0 {
-			lbInstance := detailResp.LoadBalancerInstanceList[0]
-			if lbInstance.LoadBalancerInstanceStatus != nil {
-				statusCode := *lbInstance.LoadBalancerInstanceStatus.Code
-				statusName := "unknown"
-				if lbInstance.LoadBalancerInstanceStatusName != nil {
-					statusName = *lbInstance.LoadBalancerInstanceStatusName
-				}
-
-				logger.Info("로드밸런서 상태 확인",
-					"status-code", statusCode,
-					"status-name", statusName,
-					"retry", i+1,
-					"max-retries", maxRetries)
-
-				// 로드밸런서가 완전히 준비된 상태인지 확인
-				if (statusCode == "RUN" || statusCode == "USED") && statusName == "Running" {
-					logger.Info("로드밸런서 준비 완료", "status-code", statusCode, "status-name", statusName)
-					return nil
-				}
-
-				// 아직 변경 중인 경우
-				if statusCode == "USED" && statusName == "Changing" {
-					logger.Info("로드밸런서 변경 중, 대기 필요", "status-code", statusCode, "status-name", statusName)
-				}
-
-				if statusCode == "ERROR" || statusCode == "TERMINATING" {
-					return fmt.Errorf("로드밸런서가 오류 상태: %s (%s)", statusCode, statusName)
-				}
-			} else {
-				logger.Info("로드밸런서 상태 정보 없음", "retry", i+1)
-			}
-		} else {
-			logger.Info("로드밸런서 응답 정보 없음", "retry", i+1)
-		}
-
-		// 대기 시간을 점진적으로 증가
-		waitTime := time.Duration(10+i*5) * time.Second
-		logger.Info("로드밸런서 준비 대기", "wait-seconds", waitTime.Seconds())
-		time.Sleep(waitTime)
-	}
-
-	return fmt.Errorf("로드밸런서 준비 대기 시간 초과 (최대 %d회 시도)", maxRetries)
-}
-
 // deleteNaverCloudLB는 Naver Cloud 로드 밸런서를 삭제합니다
 func (r *ServiceReconciler) deleteNaverCloudLB(ctx context.Context, service *corev1.Service) error {
 	logger := log.FromContext(ctx).WithValues("service", types.NamespacedName{Namespace: service.Namespace, Name: service.Name})
 
-	// 로드 밸런서 ID가 서비스 어노테이션에 있는지 확인
+	// NaverLoadBalancer 상태에서 삭제할 리소스 확인 (없으면 이전 버전의 서비스 어노테이션 사용)
+	nlb, err := r.getNaverLoadBalancer(ctx, service)
+	if err != nil {
+		return err
+	}
+
 	lbID, lbExists := service.Annotations["naver.k-paas.org/lb-id"]
 	targetGroupsStr, tgExists := service.Annotations["naver.k-paas.org/target-groups"]
+	if nlb != nil {
+		if nlb.Status.LoadBalancerNo != "" {
+			lbID, lbExists = nlb.Status.LoadBalancerNo, true
+		}
+		if tgNos := nlb.Status.TargetGroupNos(); len(tgNos) > 0 {
+			targetGroupsStr, tgExists = strings.Join(tgNos, ","), true
+		}
+	}
 
-	if !lbExists && !tgExists {
+	if !lbExists && !tgExists && (nlb == nil || (nlb.Status.Certificate == nil && len(nlb.Status.SourceRangeRules) == 0 && len(nlb.Status.NodeAccessRules) == 0)) {
 		// 삭제할 리소스가 없으면 이미 삭제되었거나 생성된 적이 없는 것으로 간주
 		logger.Info("삭제할 Naver Cloud 리소스를 찾을 수 없음")
-		return nil
+		return r.deleteNaverLoadBalancer(ctx, nlb)
 	}
 
-	// SecretProvider를 통해 인증 정보 가져오기
-	credentials, err := r.getCredentials(ctx)
+	// Naver Cloud API 클라이언트 가져오기 (주입된 클라이언트 우선)
+	client, credentials, err := r.getNaverClient(ctx)
 	if err != nil {
-		return fmt.Errorf("인증 정보 조회 실패: %w", err)
+		return err
+	}
+
+	// Retain 정책이면 리소스를 남겨 두고 NaverLoadBalancer만 정리 (정책 값이 잘못되었으면 안전하게 남겨 둠)
+	policy, err := r.deletionPolicy(service)
+	if err != nil {
+		logger.Error(err, "삭제 정책 확인 실패, 리소스를 남겨 둠")
+		r.recordWarning(service, "InvalidDeletionPolicy", "%v", err)
+		policy = DeletionPolicyRetain
+	}
+	if policy == DeletionPolicyRetain {
+		var targetGroupIDs []string
+		if tgExists && targetGroupsStr != "" {
+			targetGroupIDs = strings.Split(targetGroupsStr, ",")
+		}
+		if !lbExists {
+			lbID = ""
+		}
+		return r.retainNaverCloudLB(ctx, client, credentials, service, nlb, lbID, targetGroupIDs)
 	}
 
-	// Naver Cloud API 접근을 위한 인증 정보 설정
-	apiKeys := &ncloud.APIKey{
-		AccessKey: credentials.APIKey,
-		SecretKey: credentials.APISecret,
+	// 삭제 단계 기록 (사용 중인 타겟 그룹 삭제를 재시도하는 시간의 기준)
+	if err := r.recordPhase(ctx, nlb, naverv1alpha1.PhaseDeleting); err != nil {
+		return err
 	}
 
-	// Naver Cloud VLoadBalancer API 클라이언트 생성
-	config := vloadbalancer.NewConfiguration(apiKeys)
-	config.BasePath = "https://ncloud.apigw.gov-ntruss.com/vloadbalancer/v2"
-	client := vloadbalancer.NewAPIClient(config)
+	// 채택한 리소스는 delete-adopted-resources 어노테이션이 없으면 남겨 둠
+	keepAdopted := nlb != nil && nlb.Status.Adoption != nil && !deleteAdoptedResources(service)
+	adopted := map[string]bool{}
+	if keepAdopted {
+		adopted = adoptedTargetGroups(&nlb.Status)
+	}
 
-	// 1. 로드밸런서 삭제 (리스너도 함께 삭제됨)
-	if lbExists && lbID != "" {
-		req := vloadbalancer.DeleteLoadBalancerInstancesRequest{
-			RegionCode:                 ncloud.String(credentials.Region),
-			LoadBalancerInstanceNoList: []*string{ncloud.String(lbID)},
+	// 1. 로드밸런서 삭제 (리스너도 함께 삭제됨, 채택한 로드밸런서는 컨트롤러가 만든 리스너만 삭제)
+	if lbExists && lbID != "" && keepAdopted {
+		if err := r.releaseAdoptedLoadBalancer(ctx, client, credentials, nlb); err != nil {
+			return err
 		}
-
-		_, err := client.V2Api.DeleteLoadBalancerInstances(&req)
-		if err != nil {
-			logger.Error(err, "Naver Cloud LB 삭제 실패")
-			return fmt.Errorf("로드밸런서 삭제 실패: %w", err)
+	} else if lbExists && lbID != "" {
+		// 삭제가 끝날 때까지 기다리지 않고 다음 조정에서 다시 확인 (리스너는 로드밸런서와 함께 삭제됨)
+		if err := r.deleteLoadBalancer(client, credentials, lbID); err != nil {
+			if !isLoadBalancerBusy(err) {
+				logger.Error(err, "Naver Cloud LB 삭제 실패")
+			}
+			return err
 		}
 
 		logger.Info("Naver Cloud LB 삭제 성공", "lb-id", lbID)
 
-		// 로드밸런서 삭제 후 리스너가 완전히 정리될 때까지 대기
-		logger.Info("로드밸런서 삭제 후 리스너 정리 대기", "lb-id", lbID, "wait-seconds", 30)
-		time.Sleep(30 * time.Second)
+		if nlb != nil {
+			// 상태 갱신 후에는 nlb가 최신 객체로 바뀌므로 예약 공인 IP를 미리 확인
+			var reservedPublicIP string
+			if nlb.Status.ReservedPublicIP != nil {
+				reservedPublicIP = nlb.Status.ReservedPublicIP.PublicIP
+			}
+			if err := r.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+				latest.Status.LoadBalancerNo = ""
+				latest.Status.Listeners = nil
+				latest.Status.Domain = ""
+				latest.Status.IPs = nil
+				latest.Status.ReservedPublicIP = nil
+			}); err != nil {
+				logger.Error(err, "NaverLoadBalancer 상태에서 로드밸런서 제거 실패")
+			}
+			// 예약 공인 IP는 컨트롤러가 할당한 것이 아니므로 반환하지 않음 (로드밸런서 삭제 시 연결만 해제됨)
+			if reservedPublicIP != "" {
+				logger.Info("예약 공인 IP 연결 해제, 반환하지 않음", "publicIp", reservedPublicIP)
+			}
+		}
+	}
+
+	// 2. 컨트롤러가 TLS Secret에서 가져온 인증서 삭제 (리스너가 삭제된 후에만 가능, 실패해도 계속 진행)
+	if nlb != nil && nlb.Status.Certificate != nil {
+		certificateNo := nlb.Status.Certificate.CertificateNo
+		if err := client.DeleteCertificate(certificateNo); err != nil {
+			logger.Error(err, "가져온 인증서 삭제 실패, 수동 정리 필요", "certificateNo", certificateNo)
+		} else {
+			logger.Info("가져온 인증서 삭제 성공", "certificateNo", certificateNo)
+			if err := r.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+				latest.Status.Certificate = nil
+			}); err != nil {
+				logger.Error(err, "NaverLoadBalancer 상태에서 인증서 제거 실패")
+			}
+		}
+	}
+
+	// 3. loadBalancerSourceRanges를 위해 추가한 Network ACL 규칙 삭제 (같은 서브넷의 다른 로드밸런서를 막지 않도록 실패하면 재시도)
+	if nlb != nil && len(nlb.Status.SourceRangeRules) > 0 {
+		if err := r.removeNetworkACLRules(client, credentials, nlb.Status.SourceRangeRules); err != nil {
+			logger.Error(err, "소스 범위 Network ACL 규칙 삭제 실패")
+			return err
+		}
+		logger.Info("소스 범위 Network ACL 규칙 삭제 성공", "count", len(nlb.Status.SourceRangeRules))
+		if err := r.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+			latest.Status.SourceRangeRules = nil
+		}); err != nil {
+			return err
+		}
 	}
 
-	// 2. 타겟 그룹 삭제 (재시도 로직 포함)
+	// 4. 워커 노드 ACG에 추가한 NodePort 규칙 삭제 (다른 로드밸런서가 쓰는 규칙은 남김, 실패하면 재시도)
+	if nlb != nil && len(nlb.Status.NodeAccessRules) > 0 {
+		if err := r.releaseNodeAccessRules(ctx, client, credentials, nlb); err != nil {
+			logger.Error(err, "워커 노드 ACG 규칙 삭제 실패")
+			return err
+		}
+		logger.Info("워커 노드 ACG 규칙 삭제 성공")
+	}
+
+	// 5. 타겟 그룹 삭제 (로드밸런서에서 분리되는 동안 사용 중이면 다음 조정에서 재시도)
 	if tgExists && targetGroupsStr != "" {
 		targetGroupIDs := strings.Split(targetGroupsStr, ",")
 		logger.Info("타겟 그룹 삭제 시작", "target-group-count", len(targetGroupIDs))
 
+		var remaining, inUse []string
+
 		for _, tgID := range targetGroupIDs {
 			if tgID == "" {
 				continue
 			}
+			if adopted[tgID] {
+				logger.Info("채택한 타겟 그룹은 삭제하지 않음", "target-group-id", tgID)
+				continue
+			}
 
-			// 타겟 그룹 삭제 재시도 (최대 5회)
-			deleted := false
-			for retry := 1; retry <= 5; retry++ {
-				tgReq := vloadbalancer.DeleteTargetGroupsRequest{
-					RegionCode:        ncloud.String(credentials.Region),
-					TargetGroupNoList: []*string{ncloud.String(tgID)},
-				}
-
-				_, err := client.V2Api.DeleteTargetGroups(&tgReq)
-				if err != nil {
-					if strings.Contains(err.Error(), "1200059") || strings.Contains(err.Error(), "Target group in use") {
-						logger.Info("타겟 그룹이 아직 사용 중, 재시도 예정",
-							"target-group-id", tgID,
-							"retry", retry,
-							"max-retries", 5,
-							"wait-seconds", 10)
-
-						if retry < 5 {
-							time.Sleep(10 * time.Second)
-							continue
-						}
-					}
-
-					logger.Error(err, "타겟 그룹 삭제 최종 실패",
-						"target-group-id", tgID,
-						"total-retries", retry)
-					// 타겟 그룹 삭제 실패는 전체 삭제를 중단하지 않음
-					break
-				}
-
-				logger.Info("타겟 그룹 삭제 성공",
-					"target-group-id", tgID,
-					"retry", retry)
-				deleted = true
-				break
+			_, err := client.DeleteTargetGroups(&vloadbalancer.DeleteTargetGroupsRequest{
+				RegionCode:        ncloud.String(credentials.Region),
+				TargetGroupNoList: []*string{ncloud.String(tgID)},
+			})
+			if err == nil {
+				logger.Info("타겟 그룹 삭제 성공", "target-group-id", tgID)
+				continue
 			}
 
-			if !deleted {
-				logger.Info("타겟 그룹 삭제 실패로 수동 정리 필요",
-					"target-group-id", tgID,
-					"reason", "네이버 클라우드 콘솔에서 수동으로 삭제하세요")
+			if (strings.Contains(err.Error(), "1200059") || strings.Contains(err.Error(), "Target group in use")) && !targetGroupDeletionTimedOut(nlb) {
+				logger.Info("타겟 그룹이 아직 사용 중, 재시도 예정", "target-group-id", tgID)
+				inUse = append(inUse, tgID)
+				continue
 			}
+
+			// 타겟 그룹 삭제 실패는 전체 삭제를 중단하지 않음
+			logger.Error(err, "타겟 그룹 삭제 최종 실패", "target-group-id", tgID)
+			remaining = append(remaining, tgID)
+			logger.Info("타겟 그룹 삭제 실패로 수동 정리 필요",
+				"target-group-id", tgID,
+				"reason", "네이버 클라우드 콘솔에서 수동으로 삭제하세요")
 		}
 
 		logger.Info("타겟 그룹 삭제 프로세스 완료", "target-group-count", len(targetGroupIDs))
+
+		// 삭제한 타겟 그룹은 상태에서 제거하고, 재시도하거나 수동 정리할 타겟 그룹만 남김
+		if kept := append(remaining, inUse...); nlb != nil && len(kept) > 0 {
+			if err := r.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+				var tgs []naverv1alpha1.TargetGroupStatus
+				for _, tg := range latest.Status.TargetGroups {
+					if containsString(kept, tg.TargetGroupNo) {
+						tgs = append(tgs, tg)
+					}
+				}
+				latest.Status.TargetGroups = tgs
+			}); err != nil {
+				logger.Error(err, "NaverLoadBalancer 상태에서 타겟 그룹 제거 실패")
+			}
+		}
+		if len(inUse) > 0 {
+			return fmt.Errorf("%w: 타겟 그룹 사용 중 %v", errLoadBalancerBusy, inUse)
+		}
+		if nlb != nil && len(remaining) > 0 {
+			return nil
+		}
 	}
 
-	return nil
+	return r.deleteNaverLoadBalancer(ctx, nlb)
 }
 
-// generateValidName은 네이버 클라우드 리소스 이름 규칙에 맞는 유효한 이름을 생성합니다
+// generateValidName은 네이버 클라우드 리소스 이름 규칙에 맞는 유효한 이름을 생성합니다.
+// 길면 잘리므로 로드밸런서와 타겟 그룹 이름은 해시를 붙이는 resourceName을 사용합니다 (이전 버전은 이 이름을 그대로 사용)
 func (r *ServiceReconciler) generateValidName(prefix, namespace, serviceName, suffix string) string {
 	// 네이버 클라우드 타겟 그룹 이름 규칙 (더 보수적 접근):
 	// - 영문자로 시작
@@ -1003,37 +1201,67 @@ func removeString(slice []string, s string) []string {
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	// LoadBalancer 타입이거나 이전에 LoadBalancer였던 서비스를 감시하는 필터
-	isRelevantService := predicate.NewPredicateFuncs(func(object client.Object) bool {
-		service, ok := object.(*corev1.Service)
-		if !ok {
-			return false
-		}
-
-		// 현재 LoadBalancer 타입인 경우
-		if service.Spec.Type == corev1.ServiceTypeLoadBalancer {
-			return true
-		}
-
-		// LoadBalancer 타입이 아니지만 이전에 LoadBalancer였던 경우 (어노테이션 확인)
-		if service.Annotations != nil {
-			_, lbExists := service.Annotations["naver.k-paas.org/lb-id"]
-			_, tgExists := service.Annotations["naver.k-paas.org/target-groups"]
-			if lbExists || tgExists {
-				return true
-			}
-		}
-
-		return false
+	// TLS Secret 변경 시 해당 Secret을 참조하는 Service의 인증서를 다시 가져옵니다
+	isTLSSecret := predicate.NewPredicateFuncs(func(object client.Object) bool {
+		secret, ok := object.(*corev1.Secret)
+		return ok && secret.Type == corev1.SecretTypeTLS
 	})
 
+	// LoadBalancer 타입이거나 이전에 LoadBalancer였던 Service만 조정하며,
+	// 노드 변경은 그 노드가 타겟 구성에 영향을 주는 Service의 타겟 동기화로 이어지고,
+	// EndpointSlice 변경은 externalTrafficPolicy: Local인 Service의 타겟 동기화로 이어집니다
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&corev1.Service{}).
-		WithEventFilter(isRelevantService).
+		For(&corev1.Service{}, builder.WithPredicates(predicate.NewPredicateFuncs(isRelevantService))).
+		Watches(&corev1.Node{},
+			handler.EnqueueRequestsFromMapFunc(r.mapNodeToServices),
+			builder.WithPredicates(r.nodeTargetChangedPredicate())).
+		Watches(&discoveryv1.EndpointSlice{},
+			handler.EnqueueRequestsFromMapFunc(r.mapEndpointSliceToService)).
+		Watches(&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.mapSecretToServices),
+			builder.WithPredicates(isTLSSecret)).
 		Named("service").
 		Complete(r)
 }
 
+// isRelevantService는 LoadBalancer 타입이거나 이전에 LoadBalancer였던 Service인지 확인합니다.
+// 타입이 바뀌거나 삭제되어도 만든 리소스를 정리할 수 있도록 finalizer가 남아 있는 Service도 포함합니다
+func isRelevantService(object client.Object) bool {
+	service, ok := object.(*corev1.Service)
+	if !ok {
+		return false
+	}
+
+	// 현재 LoadBalancer 타입인 경우
+	if service.Spec.Type == corev1.ServiceTypeLoadBalancer {
+		return true
+	}
+
+	// 리소스 생성 전에 추가한 finalizer가 남아 있는 경우 (아직 리소스 번호가 기록되지 않았을 수 있음)
+	if containsString(service.Finalizers, naverLBFinalizer) {
+		return true
+	}
+
+	// 이전 버전 컨트롤러가 어노테이션에 리소스를 기록한 경우
+	if service.Annotations != nil {
+		_, lbExists := service.Annotations["naver.k-paas.org/lb-id"]
+		_, tgExists := service.Annotations["naver.k-paas.org/target-groups"]
+		if lbExists || tgExists {
+			return true
+		}
+	}
+
+	return false
+}
+
+// recordWarning은 Service에 Warning 이벤트를 기록합니다 (Recorder가 없으면 무시)
+func (r *ServiceReconciler) recordWarning(service *corev1.Service, reason, messageFmt string, args ...interface{}) {
+	if r.Recorder == nil {
+		return
+	}
+	r.Recorder.Eventf(service, corev1.EventTypeWarning, reason, messageFmt, args...)
+}
+
 // updateServiceAnnotations는 Service 어노테이션을 안전하게 업데이트합니다
 func (r *ServiceReconciler) updateServiceAnnotations(ctx context.Context, service *corev1.Service, annotations map[string]string) error {
 	logger := log.FromContext(ctx)
@@ -1072,67 +1300,6 @@ func (r *ServiceReconciler) updateServiceAnnotations(ctx context.Context, servic
 	return nil
 }
 
-// addNodesToTargetGroup은 Kubernetes 워커 노드들을 타겟 그룹에 추가합니다
-func (r *ServiceReconciler) addNodesToTargetGroup(ctx context.Context, client *vloadbalancer.APIClient, targetGroupID string, nodePort int32) error {
-	logger := log.FromContext(ctx)
-
-	// Kubernetes 노드 목록 조회
-	var nodeList corev1.NodeList
-	if err := r.List(ctx, &nodeList); err != nil {
-		return fmt.Errorf("노드 목록 조회 실패: %w", err)
-	}
-
-	if len(nodeList.Items) == 0 {
-		logger.Info("등록할 워커 노드가 없음")
-		return nil
-	}
-
-	// 타겟으로 추가할 노드들 준비
-	var targets []string
-
-	for _, node := range nodeList.Items {
-		// 마스터 노드 제외 (taint 또는 label로 식별)
-		if r.isMasterNode(&node) {
-			logger.Info("마스터 노드 제외", "node", node.Name)
-			continue
-		}
-
-		// 노드의 내부 IP 가져오기
-		nodeIP := r.getNodeInternalIP(&node)
-		if nodeIP == "" {
-			logger.Info("노드 IP를 찾을 수 없음", "node", node.Name)
-			continue
-		}
-
-		// 네이버 클라우드 서버 인스턴스 번호 필요
-		// 1. 먼저 노드 메타데이터에서 찾기 시도
-		instanceNo := r.getNaverCloudInstanceNo(&node)
-
-		// 2. 찾을 수 없으면 API를 통해 IP로 찾기 시도
-		if instanceNo == "" {
-			logger.Info("노드 메타데이터에서 인스턴스 번호를 찾을 수 없음, API로 검색 시도", "node", node.Name, "ip", nodeIP)
-
-			apiInstanceNo, err := r.getNaverCloudInstanceNoByIP(ctx, nodeIP)
-			if err != nil {
-				logger.Error(err, "API를 통한 인스턴스 번호 찾기 실패", "node", node.Name, "ip", nodeIP)
-				continue
-			}
-			instanceNo = apiInstanceNo
-		}
-
-		targets = append(targets, instanceNo)
-
-		logger.Info("타겟 추가 준비", "node", node.Name, "ip", nodeIP, "instance", instanceNo, "port", nodePort)
-	}
-
-	if len(targets) == 0 {
-		return fmt.Errorf("등록할 유효한 타겟이 없음")
-	}
-
-	// 재시도 메커니즘을 통한 타겟 추가
-	return r.addTargetsWithRetry(ctx, client, targetGroupID, targets, nodePort)
-}
-
 // isMasterNode는 노드가 마스터 노드인지 확인합니다
 func (r *ServiceReconciler) isMasterNode(node *corev1.Node) bool {
 	// 마스터 노드 식별 방법:
@@ -1193,46 +1360,35 @@ func (r *ServiceReconciler) getNaverCloudInstanceNo(node *corev1.Node) string {
 
 // getNaverCloudInstanceNoByIP는 내부 IP를 통해 네이버 클라우드 인스턴스 번호를 찾습니다
 // NetworkInterface API를 활용하여 정확한 IP-인스턴스 매칭을 수행합니다
-func (r *ServiceReconciler) getNaverCloudInstanceNoByIP(ctx context.Context, nodeIP string) (string, error) {
+func (r *ServiceReconciler) getNaverCloudInstanceNoByIP(ctx context.Context, client NaverCloudClient, nodeIP string) (string, error) {
 	logger := log.FromContext(ctx)
 
 	logger.Info("NetworkInterface API를 통한 인스턴스 검색 시작", "nodeIP", nodeIP)
 
-	// SecretProvider를 통해 인증 정보 가져오기
+	// SecretProvider를 통해 인증 정보 가져오기 (Region만 필요)
 	credentials, err := r.getCredentials(ctx)
 	if err != nil {
 		return "", fmt.Errorf("인증 정보 조회 실패: %w", err)
 	}
 
-	// Naver Cloud API 접근을 위한 인증 정보 설정
-	apiKeys := &ncloud.APIKey{
-		AccessKey: credentials.APIKey,
-		SecretKey: credentials.APISecret,
-	}
-
-	// 1. VServer API 클라이언트 생성 (NetworkInterface 조회용)
-	vserverConfig := vserver.NewConfiguration(apiKeys)
-	vserverConfig.BasePath = "https://ncloud.apigw.gov-ntruss.com/vserver/v2"
-	vserverClient := vserver.NewAPIClient(vserverConfig)
-
-	// 2. NetworkInterface 목록 조회 (VPC 환경의 모든 NetworkInterface)
+	// NetworkInterface 목록 조회 (VPC 환경의 모든 NetworkInterface)
 	niListReq := vserver.GetNetworkInterfaceListRequest{
 		RegionCode: ncloud.String(credentials.Region),
 	}
 
-	niListResp, err := vserverClient.V2Api.GetNetworkInterfaceList(&niListReq)
+	niListResp, err := client.GetNetworkInterfaceList(&niListReq)
 	if err != nil {
 		logger.Error(err, "NetworkInterface 목록 조회 실패")
 		// NetworkInterface API 실패 시 fallback으로 VServer API 사용
-		return r.getInstanceNoByServerListFallback(ctx, nodeIP)
+		return r.getInstanceNoByServerListFallback(ctx, client, nodeIP)
 	}
 
 	if niListResp == nil || len(niListResp.NetworkInterfaceList) == 0 {
 		logger.Info("NetworkInterface 목록이 비어있음, fallback 사용")
-		return r.getInstanceNoByServerListFallback(ctx, nodeIP)
+		return r.getInstanceNoByServerListFallback(ctx, client, nodeIP)
 	}
 
-	// 3. NetworkInterface에서 IP 매칭하여 인스턴스 번호 찾기
+	// NetworkInterface에서 IP 매칭하여 인스턴스 번호 찾기
 	for _, ni := range niListResp.NetworkInterfaceList {
 		if ni == nil {
 			continue
@@ -1295,39 +1451,28 @@ func (r *ServiceReconciler) getNaverCloudInstanceNoByIP(ctx context.Context, nod
 	}
 
 	logger.Info("NetworkInterface API에서 일치하는 IP를 찾지 못함, fallback 사용", "nodeIP", nodeIP)
-	return r.getInstanceNoByServerListFallback(ctx, nodeIP)
+	return r.getInstanceNoByServerListFallback(ctx, client, nodeIP)
 }
 
 // getInstanceNoByServerListFallback은 NetworkInterface API 실패 시 VServer API를 사용하는 fallback 함수입니다
-func (r *ServiceReconciler) getInstanceNoByServerListFallback(ctx context.Context, nodeIP string) (string, error) {
+func (r *ServiceReconciler) getInstanceNoByServerListFallback(ctx context.Context, client NaverCloudClient, nodeIP string) (string, error) {
 	logger := log.FromContext(ctx)
 
 	logger.Info("VServer API fallback 사용", "nodeIP", nodeIP)
 
-	// SecretProvider를 통해 인증 정보 가져오기
+	// SecretProvider를 통해 인증 정보 가져오기 (Region, VpcNo만 필요)
 	credentials, err := r.getCredentials(ctx)
 	if err != nil {
 		return "", fmt.Errorf("인증 정보 조회 실패: %w", err)
 	}
 
-	// Naver Cloud API 접근을 위한 인증 정보 설정
-	apiKeys := &ncloud.APIKey{
-		AccessKey: credentials.APIKey,
-		SecretKey: credentials.APISecret,
-	}
-
-	// VServer API 클라이언트 생성
-	config := vserver.NewConfiguration(apiKeys)
-	config.BasePath = "https://ncloud.apigw.gov-ntruss.com/vserver/v2"
-	client := vserver.NewAPIClient(config)
-
 	// 서버 인스턴스 목록 조회
 	listReq := vserver.GetServerInstanceListRequest{
 		RegionCode: ncloud.String(credentials.Region),
 		VpcNo:      ncloud.String(credentials.VpcNo),
 	}
 
-	listResp, err := client.V2Api.GetServerInstanceList(&listReq)
+	listResp, err := client.GetServerInstanceList(&listReq)
 	if err != nil {
 		return "", fmt.Errorf("서버 인스턴스 목록 조회 실패: %w", err)
 	}
@@ -1349,7 +1494,7 @@ func (r *ServiceReconciler) getInstanceNoByServerListFallback(ctx context.Contex
 		}
 
 		// 해당 인스턴스의 NetworkInterface 상세 조회
-		if found, err := r.checkInstanceNetworkInterface(ctx, instanceNo, nodeIP); err == nil && found {
+		if found, err := r.checkInstanceNetworkInterface(ctx, client, instanceNo, nodeIP); err == nil && found {
 			logger.Info("VServer fallback으로 인스턴스 번호 찾음",
 				"nodeIP", nodeIP,
 				"instanceNo", instanceNo,
@@ -1367,33 +1512,22 @@ func (r *ServiceReconciler) getInstanceNoByServerListFallback(ctx context.Contex
 }
 
 // checkInstanceNetworkInterface는 특정 인스턴스의 NetworkInterface에서 IP를 확인합니다
-func (r *ServiceReconciler) checkInstanceNetworkInterface(ctx context.Context, instanceNo, targetIP string) (bool, error) {
+func (r *ServiceReconciler) checkInstanceNetworkInterface(ctx context.Context, client NaverCloudClient, instanceNo, targetIP string) (bool, error) {
 	logger := log.FromContext(ctx)
 
-	// SecretProvider를 통해 인증 정보 가져오기
+	// SecretProvider를 통해 인증 정보 가져오기 (Region만 필요)
 	credentials, err := r.getCredentials(ctx)
 	if err != nil {
 		return false, fmt.Errorf("인증 정보 조회 실패: %w", err)
 	}
 
-	// Naver Cloud API 접근을 위한 인증 정보 설정
-	apiKeys := &ncloud.APIKey{
-		AccessKey: credentials.APIKey,
-		SecretKey: credentials.APISecret,
-	}
-
-	// VServer API 클라이언트 생성
-	vserverConfig := vserver.NewConfiguration(apiKeys)
-	vserverConfig.BasePath = "https://ncloud.apigw.gov-ntruss.com/vserver/v2"
-	vserverClient := vserver.NewAPIClient(vserverConfig)
-
 	// 특정 인스턴스의 NetworkInterface 조회
 	niListReq := vserver.GetNetworkInterfaceListRequest{
 		RegionCode: ncloud.String(credentials.Region),
 		InstanceNo: ncloud.String(instanceNo),
 	}
 
-	niListResp, err := vserverClient.V2Api.GetNetworkInterfaceList(&niListReq)
+	niListResp, err := client.GetNetworkInterfaceList(&niListReq)
 	if err != nil {
 		logger.Info("인스턴스별 NetworkInterface 조회 실패", "instanceNo", instanceNo, "error", err.Error())
 		return false, err
@@ -1427,7 +1561,7 @@ func (r *ServiceReconciler) checkInstanceNetworkInterface(ctx context.Context, i
 }
 
 // checkTargetGroupStatus는 타겟 그룹의 상태를 확인하고 디버깅 정보를 제공합니다
-func (r *ServiceReconciler) checkTargetGroupStatus(ctx context.Context, client *vloadbalancer.APIClient, targetGroupID string) error {
+func (r *ServiceReconciler) checkTargetGroupStatus(ctx context.Context, client NaverCloudClient, targetGroupID string) error {
 	logger := log.FromContext(ctx)
 
 	// SecretProvider를 통해 인증 정보 가져오기 (Region만 필요)
@@ -1442,7 +1576,7 @@ func (r *ServiceReconciler) checkTargetGroupStatus(ctx context.Context, client *
 		TargetGroupNo: ncloud.String(targetGroupID),
 	}
 
-	detailResp, err := client.V2Api.GetTargetGroupDetail(&detailReq)
+	detailResp, err := client.GetTargetGroupDetail(&detailReq)
 	if err != nil {
 		return fmt.Errorf("타겟 그룹 상세 정보 조회 실패: %w", err)
 	}
@@ -1509,7 +1643,7 @@ func (r *ServiceReconciler) checkTargetGroupStatus(ctx context.Context, client *
 		TargetGroupNo: ncloud.String(targetGroupID),
 	}
 
-	targetListResp, err := client.V2Api.GetTargetList(&targetListReq)
+	targetListResp, err := client.GetTargetList(&targetListReq)
 	if err != nil {
 		logger.Error(err, "타겟 목록 조회 실패", "targetGroupID", targetGroupID)
 		return fmt.Errorf("타겟 목록 조회 실패: %w", err)
@@ -1618,15 +1752,16 @@ func (r *ServiceReconciler) checkTargetGroupStatus(ctx context.Context, client *
 			"step1", "워커 노드가 실행 중인지 확인: kubectl get nodes",
 			"step2", "NodePort 서비스가 정상 동작하는지 확인: kubectl get svc",
 			"step3", "워커 노드에서 애플리케이션 포트가 열려있는지 확인",
-			"step4", "네이버 클라우드 콘솔에서 보안 그룹 설정 확인",
+			"step4", "워커 노드 ACG가 로드밸런서 서브넷에서 NodePort를 허용하는지 확인 (NAVER_CLOUD_NODE_ACG_NO 설정 시 자동 관리)",
 			"step5", "헬스체크 설정이 올바른지 확인")
 	}
 
 	return nil
 }
 
-// addTargetsWithRetry는 재시도 메커니즘을 통해 타겟을 타겟 그룹에 추가합니다
-func (r *ServiceReconciler) addTargetsWithRetry(ctx context.Context, client *vloadbalancer.APIClient, targetGroupID string, targets []string, nodePort int32) error {
+// addTargets는 타겟을 타겟 그룹에 추가하고 등록 결과를 확인합니다.
+// 실패하면 기다려서 재시도하지 않고 오류를 반환하며, 다음 조정에서 남은 타겟을 다시 추가합니다
+func (r *ServiceReconciler) addTargets(ctx context.Context, client NaverCloudClient, targetGroupID string, targets []string) error {
 	logger := log.FromContext(ctx)
 
 	if len(targets) == 0 {
@@ -1640,115 +1775,27 @@ func (r *ServiceReconciler) addTargetsWithRetry(ctx context.Context, client *vlo
 		return fmt.Errorf("인증 정보 조회 실패: %w", err)
 	}
 
-	logger.Info("재시도 메커니즘을 통한 타겟 추가 시작",
-		"targetGroupID", targetGroupID,
-		"targetCount", len(targets),
-		"maxRetries", 3)
-
-	// 재시도할 타겟 목록 (초기에는 모든 타겟)
-	remainingTargets := make([]string, len(targets))
-	copy(remainingTargets, targets)
-
-	var lastErr error
-
-	// 최대 3회 재시도 (기존 External IP 패턴과 일관성 유지)
-	for retry := 0; retry < 3; retry++ {
-		if len(remainingTargets) == 0 {
-			logger.Info("모든 타겟이 성공적으로 추가됨", "targetGroupID", targetGroupID)
-			break
-		}
-
-		logger.Info("타겟 추가 시도",
-			"targetGroupID", targetGroupID,
-			"retry", retry+1,
-			"remainingTargets", len(remainingTargets),
-			"totalTargets", len(targets))
-
-		// 타겟 추가 요청 구성
-		addReq := vloadbalancer.AddTargetRequest{
-			RegionCode:    ncloud.String(credentials.Region),
-			TargetGroupNo: ncloud.String(targetGroupID),
-			TargetNoList:  make([]*string, len(remainingTargets)),
-		}
-
-		// 남은 타겟 목록 설정
-		for i, instanceNo := range remainingTargets {
-			addReq.TargetNoList[i] = ncloud.String(instanceNo)
-		}
-
-		// 타겟 추가 API 호출
-		_, err := client.V2Api.AddTarget(&addReq)
-		if err != nil {
-			lastErr = err
-			logger.Info("타겟 추가 실패, 재시도 예정",
-				"targetGroupID", targetGroupID,
-				"retry", retry+1,
-				"error", err.Error(),
-				"remainingTargets", len(remainingTargets))
-
-			// 재시도 간 대기 시간 (기존 패턴과 일관성: 10초 + retry*5초)
-			if retry < 2 { // 마지막 재시도가 아닌 경우에만 대기
-				waitTime := time.Duration(10+retry*5) * time.Second
-				logger.Info("재시도 대기",
-					"targetGroupID", targetGroupID,
-					"waitSeconds", waitTime.Seconds(),
-					"nextRetry", retry+2)
-				time.Sleep(waitTime)
-			}
-			continue
-		}
-
-		// 타겟 추가 성공
-		logger.Info("타겟 추가 성공",
-			"targetGroupID", targetGroupID,
-			"retry", retry+1,
-			"addedTargets", len(remainingTargets))
+	logger.Info("타겟 추가 시도", "targetGroupID", targetGroupID, "targetCount", len(targets))
 
-		// 타겟 추가 후 상태 확인하여 실제로 등록되었는지 검증
-		successfulTargets, failedTargets, err := r.verifyTargetRegistration(ctx, client, targetGroupID, remainingTargets)
-		if err != nil {
-			logger.Error(err, "타겟 등록 검증 실패", "targetGroupID", targetGroupID)
-			// 검증 실패 시에도 다음 재시도 진행
-		} else {
-			logger.Info("타겟 등록 검증 완료",
-				"targetGroupID", targetGroupID,
-				"successfulTargets", len(successfulTargets),
-				"failedTargets", len(failedTargets))
-
-			// 실패한 타겟만 다음 재시도에서 처리
-			remainingTargets = failedTargets
-		}
-
-		// 모든 타겟이 성공적으로 등록된 경우
-		if len(remainingTargets) == 0 {
-			logger.Info("모든 타겟 등록 완료",
-				"targetGroupID", targetGroupID,
-				"totalTargets", len(targets),
-				"finalRetry", retry+1)
-			break
-		}
+	// 타겟 추가 요청 구성
+	addReq := vloadbalancer.AddTargetRequest{
+		RegionCode:    ncloud.String(credentials.Region),
+		TargetGroupNo: ncloud.String(targetGroupID),
+		TargetNoList:  ncloud.StringList(targets),
+	}
+	if _, err := client.AddTarget(&addReq); err != nil {
+		return fmt.Errorf("타겟 추가 실패: %w", err)
 	}
 
-	// 최종 결과 확인
-	if len(remainingTargets) > 0 {
-		logger.Error(lastErr, "타겟 추가 최종 실패",
+	// 타겟 추가 후 상태 확인하여 실제로 등록되었는지 검증 (누락된 타겟은 다음 조정에서 다시 추가)
+	successfulTargets, failedTargets, err := r.verifyTargetRegistration(ctx, client, targetGroupID, targets)
+	if err != nil {
+		logger.Error(err, "타겟 등록 검증 실패", "targetGroupID", targetGroupID)
+	} else {
+		logger.Info("타겟 등록 검증 완료",
 			"targetGroupID", targetGroupID,
-			"failedTargets", len(remainingTargets),
-			"totalTargets", len(targets),
-			"successfulTargets", len(targets)-len(remainingTargets))
-
-		// 부분 성공인 경우 경고로 처리
-		if len(remainingTargets) < len(targets) {
-			logger.Info("부분 성공 - 일부 타겟만 등록됨",
-				"targetGroupID", targetGroupID,
-				"successfulTargets", len(targets)-len(remainingTargets),
-				"failedTargets", len(remainingTargets),
-				"level", "WARNING")
-			// 부분 성공은 에러로 처리하지 않음
-		} else {
-			// 완전 실패인 경우에만 에러 반환
-			return fmt.Errorf("모든 타겟 추가 실패 (재시도 3회 완료): %w", lastErr)
-		}
+			"successfulTargets", len(successfulTargets),
+			"failedTargets", len(failedTargets))
 	}
 
 	// 최종 타겟 그룹 상태 확인
@@ -1757,16 +1804,11 @@ func (r *ServiceReconciler) addTargetsWithRetry(ctx context.Context, client *vlo
 		// 상태 확인 실패는 전체 프로세스를 중단하지 않음
 	}
 
-	logger.Info("타겟 추가 프로세스 완료",
-		"targetGroupID", targetGroupID,
-		"totalTargets", len(targets),
-		"finalSuccessfulTargets", len(targets)-len(remainingTargets))
-
 	return nil
 }
 
 // verifyTargetRegistration은 타겟이 실제로 타겟 그룹에 등록되었는지 검증합니다
-func (r *ServiceReconciler) verifyTargetRegistration(ctx context.Context, client *vloadbalancer.APIClient, targetGroupID string, expectedTargets []string) ([]string, []string, error) {
+func (r *ServiceReconciler) verifyTargetRegistration(ctx context.Context, client NaverCloudClient, targetGroupID string, expectedTargets []string) ([]string, []string, error) {
 	logger := log.FromContext(ctx)
 
 	// SecretProvider를 통해 인증 정보 가져오기 (Region만 필요)
@@ -1781,7 +1823,7 @@ func (r *ServiceReconciler) verifyTargetRegistration(ctx context.Context, client
 		TargetGroupNo: ncloud.String(targetGroupID),
 	}
 
-	targetListResp, err := client.V2Api.GetTargetList(&targetListReq)
+	targetListResp, err := client.GetTargetList(&targetListReq)
 	if err != nil {
 		return nil, expectedTargets, fmt.Errorf("타겟 목록 조회 실패: %w", err)
 	}
@@ -1820,52 +1862,131 @@ func (r *ServiceReconciler) verifyTargetRegistration(ctx context.Context, client
 	return successfulTargets, failedTargets, nil
 }
 
-// createListenersSequentially는 리스너를 순차적으로 생성합니다
-// LoadBalancer 상태 변경에 대한 충분한 대기 시간을 포함합니다
-func (r *ServiceReconciler) createListenersSequentially(ctx context.Context, client *vloadbalancer.APIClient, lbID string, ports []corev1.ServicePort, targetGroupIDs []string, existingListeners map[int32]bool, logger logr.Logger) error {
-	logger.Info("리스너 순차 생성 시작", "totalPorts", len(ports), "targetGroupCount", len(targetGroupIDs))
+// createTargetGroupForPort는 Service 포트의 NodePort로 트래픽을 전달하는 타겟 그룹을 생성합니다.
+// 중단된 이전 조정에서 tgName이나 legacyNames(해시를 붙이기 전 이름)로 만든 타겟 그룹이 있으면 재사용하고,
+// 생성 API가 실패해도 실제로는 생성되었을 수 있으므로 같은 이름의 타겟 그룹을 조회하여 사용합니다
+func (r *ServiceReconciler) createTargetGroupForPort(ctx context.Context, client NaverCloudClient, credentials *NaverCloudCredentials, service *corev1.Service, port corev1.ServicePort, tgName string, legacyNames ...string) (string, error) {
+	logger := log.FromContext(ctx)
 
-	// SecretProvider를 통해 인증 정보 가져오기 (Region만 필요)
-	credentials, err := r.getCredentials(ctx)
+	// 로드밸런서 타입에 맞는 타겟 그룹 프로토콜과 헬스 체크 설정
+	lbType, err := loadBalancerType(service)
 	if err != nil {
-		return fmt.Errorf("인증 정보 조회 실패: %w", err)
+		return "", err
+	}
+	healthCheck := healthCheckForPort(service, lbType, port)
+	lbSettings, err := parseLoadBalancerSettings(service, lbType)
+	if err != nil {
+		return "", err
+	}
+
+	// 이전 조정에서 만들고 기록하지 못한 타겟 그룹 확인
+	names := append([]string{tgName}, legacyNames...)
+	created, err := r.findOwnedTargetGroup(client, credentials, service, port.NodePort, healthCheck.Port, names...)
+	if err != nil {
+		return "", err
+	}
+	if created != nil {
+		logger.Info("이전에 만든 타겟 그룹 재사용", "targetGroupID", ncloud.StringValue(created.TargetGroupNo), "name", ncloud.StringValue(created.TargetGroupName))
+	} else {
+		created, err = r.createTargetGroup(ctx, client, credentials, service, port, tgName, lbType, healthCheck)
+		if err != nil {
+			return "", err
+		}
+	}
+	targetGroupID := ncloud.StringValue(created.TargetGroupNo)
+
+	// 생성 API는 알고리즘, 세션 유지, Proxy Protocol을 받지 않으므로 생성 후 설정 변경 API로 적용
+	if err := r.syncTargetGroupConfiguration(ctx, client, credentials, created, lbSettings); err != nil {
+		return "", err
+	}
+
+	return targetGroupID, nil
+}
+
+// createTargetGroup은 포트의 헬스 체크 설정으로 타겟 그룹 생성 API를 호출하고 생성된 타겟 그룹을 반환합니다.
+// 생성 API가 실패해도 실제로는 생성되었을 수 있으므로 같은 이름의 타겟 그룹을 조회하여 사용합니다
+func (r *ServiceReconciler) createTargetGroup(ctx context.Context, client NaverCloudClient, credentials *NaverCloudCredentials, service *corev1.Service, port corev1.ServicePort, tgName, lbType string, healthCheck healthCheckConfig) (*vloadbalancer.TargetGroup, error) {
+	logger := log.FromContext(ctx)
+
+	// 타겟 그룹 생성 요청 - SDK의 정확한 필드명 사용
+	tgReq := vloadbalancer.CreateTargetGroupRequest{
+		RegionCode:                  ncloud.String(credentials.Region),
+		VpcNo:                       ncloud.String(credentials.VpcNo),
+		TargetGroupName:             ncloud.String(tgName),
+		TargetTypeCode:              ncloud.String("VSVR"), // 가상 서버 타입
+		TargetGroupPort:             ncloud.Int32(port.NodePort),
+		TargetGroupProtocolTypeCode: ncloud.String(targetGroupProtocolForPort(lbType, port)),
+		TargetGroupDescription:      ncloud.String(r.targetGroupDescription(service, port)),
+		HealthCheckProtocolTypeCode: ncloud.String(healthCheck.Protocol),
+		HealthCheckPort:             ncloud.Int32(healthCheck.Port),
+	}
+	if healthCheck.URLPath != "" {
+		tgReq.HealthCheckUrlPath = ncloud.String(healthCheck.URLPath)
+		tgReq.HealthCheckHttpMethodTypeCode = ncloud.String(healthCheck.HTTPMethod)
+	}
+	if healthCheck.Interval != 0 {
+		tgReq.HealthCheckCycle = ncloud.Int32(healthCheck.Interval)
 	}
+	if healthCheck.HealthyThreshold != 0 {
+		tgReq.HealthCheckUpThreshold = ncloud.Int32(healthCheck.HealthyThreshold)
+	}
+	if healthCheck.UnhealthyThreshold != 0 {
+		tgReq.HealthCheckDownThreshold = ncloud.Int32(healthCheck.UnhealthyThreshold)
+	}
+
+	// 타겟 그룹 생성 API 호출
+	tgResp, err := client.CreateTargetGroup(&tgReq)
+	if err != nil {
+		logger.Info("타겟 그룹 생성 API 에러 발생, 실제 생성 여부 확인 중", "port", port.Port, "error", err)
 
-	successfulListeners := 0
-	failedListeners := 0
+		// 에러가 발생해도 실제로는 생성되었을 수 있으므로 타겟 그룹 이름으로 조회해봄
+		if tg, findErr := r.findOwnedTargetGroup(client, credentials, service, port.NodePort, healthCheck.Port, tgName); findErr == nil && tg != nil {
+			logger.Info("기존 타겟 그룹 발견됨", "targetGroupID", ncloud.StringValue(tg.TargetGroupNo), "name", tgName)
+			return tg, nil
+		}
+
+		// 여전히 타겟 그룹을 찾을 수 없으면 에러 반환
+		logger.Error(err, "타겟 그룹 생성 및 조회 모두 실패", "port", port.Port)
+		return nil, fmt.Errorf("타겟 그룹 생성 실패: %w", err)
+	}
+
+	// 정상 응답 처리
+	if tgResp == nil || len(tgResp.TargetGroupList) == 0 {
+		logger.Error(nil, "타겟 그룹 응답이 올바르지 않음")
+		return nil, fmt.Errorf("타겟 그룹 생성 응답이 올바르지 않음")
+	}
+	return tgResp.TargetGroupList[0], nil
+}
+
+// createListeners는 아직 없는 리스너를 생성합니다.
+// 리스너를 만들면 로드밸런서가 변경 중 상태가 되므로 생성 전마다 상태를 확인하고,
+// 준비되지 않았으면 기다리지 않고 errLoadBalancerBusy를 반환하여 다음 조정에서 이어서 생성합니다
+func (r *ServiceReconciler) createListeners(ctx context.Context, client NaverCloudClient, credentials *NaverCloudCredentials, lbID string, settings *listenerSettings, ports []corev1.ServicePort, targetGroupIDs []string, existingListeners map[string]bool) error {
+	logger := log.FromContext(ctx)
 
 	for i, port := range ports {
-		if i >= len(targetGroupIDs) {
+		if i >= len(targetGroupIDs) || targetGroupIDs[i] == "" {
 			logger.Info("타겟 그룹 부족으로 리스너 생성 건너뜀", "port", port.Port, "index", i)
 			continue
 		}
 
-		// 이미 해당 포트의 리스너가 있는지 확인
-		if existingListeners[port.Port] {
-			logger.Info("기존 리스너 재사용", "port", port.Port)
-			successfulListeners++
+		// 이미 해당 포트/프로토콜의 리스너가 있는지 확인
+		if existingListeners[settings.key(port)] {
 			continue
 		}
 
 		// LoadBalancer 상태 확인 (Running 상태에서만 리스너 생성 가능)
-		if !r.waitForLoadBalancerReadyForListener(ctx, client, lbID, logger) {
-			logger.Error(nil, "LoadBalancer가 준비되지 않아 리스너 생성 중단", "port", port.Port)
-			failedListeners++
-			continue
+		if err := r.checkLoadBalancerReady(client, credentials, lbID); err != nil {
+			return err
 		}
 
-		// 리스너 프로토콜 설정
-		protocolType := "TCP"
-		if port.Protocol == "UDP" {
-			protocolType = "UDP"
-		}
+		// 리스너 프로토콜 설정 (로드밸런서 타입과 TLS 포트에 따라 TCP/UDP/HTTP/TLS/HTTPS)
+		protocolType := settings.protocol(port)
 
 		logger.Info("리스너 생성 시도",
 			"port", port.Port,
 			"protocol", protocolType,
-			"targetGroupID", targetGroupIDs[i],
-			"attempt", i+1,
-			"totalPorts", len(ports))
+			"targetGroupID", targetGroupIDs[i])
 
 		// 리스너 생성 요청
 		listenerReq := vloadbalancer.CreateLoadBalancerListenerRequest{
@@ -1875,157 +1996,37 @@ func (r *ServiceReconciler) createListenersSequentially(ctx context.Context, cli
 			Port:                   ncloud.Int32(int32(port.Port)),
 			TargetGroupNo:          &targetGroupIDs[i],
 		}
+		settings.applyTLS(&listenerReq, port)
 
-		// 리스너 생성 재시도 로직 (최대 3회)
-		var listenerErr error
-		listenerCreated := false
-
-		for retryAttempt := 1; retryAttempt <= 3; retryAttempt++ {
-			_, listenerErr = client.V2Api.CreateLoadBalancerListener(&listenerReq)
-			if listenerErr == nil {
-				listenerCreated = true
-				break
-			}
-
-			logger.Info("리스너 생성 실패, 재시도 예정",
-				"port", port.Port,
-				"targetGroupID", targetGroupIDs[i],
-				"attempt", i+1,
-				"retryAttempt", retryAttempt,
-				"error", listenerErr.Error())
-
-			// 재시도 전 대기 (점진적 증가)
-			if retryAttempt < 3 {
-				waitTime := time.Duration(10+retryAttempt*5) * time.Second
-				logger.Info("리스너 생성 재시도 대기", "port", port.Port, "waitSeconds", int(waitTime.Seconds()))
-				time.Sleep(waitTime)
-			}
-		}
-
-		if !listenerCreated {
-			logger.Error(listenerErr, "리스너 생성 최종 실패",
-				"port", port.Port,
-				"targetGroupID", targetGroupIDs[i],
-				"attempt", i+1,
-				"totalRetries", 3)
-			failedListeners++
-
-			// 실패한 경우에도 다음 리스너를 위해 대기
-			time.Sleep(10 * time.Second)
-			continue
-		}
-
-		logger.Info("리스너 생성 성공",
-			"port", port.Port,
-			"targetGroupID", targetGroupIDs[i],
-			"attempt", i+1)
-		successfulListeners++
-
-		// 각 리스너 생성 후 LoadBalancer가 안정화될 때까지 대기
-		// 마지막 리스너가 아닐 경우에만 대기
-		if i < len(ports)-1 {
-			logger.Info("다음 리스너 생성을 위해 LoadBalancer 안정화 대기", "port", port.Port, "waitSeconds", 15)
-			time.Sleep(15 * time.Second) // 고정 대기 시간으로 변경
-
-			logger.Info("LoadBalancer 준비 상태 확인", "port", port.Port)
-			if !r.waitForLoadBalancerReadyForListener(ctx, client, lbID, logger) {
-				logger.Info("LoadBalancer 준비 대기 완료되지 않았지만 다음 리스너 생성 계속 시도", "currentPort", port.Port)
-			} else {
-				logger.Info("LoadBalancer 준비 완료, 다음 리스너 생성 계속", "currentPort", port.Port)
-			}
+		if _, err := client.CreateLoadBalancerListener(&listenerReq); err != nil {
+			return fmt.Errorf("리스너 생성 실패 (포트 %d): %w", port.Port, err)
 		}
-	}
+		existingListeners[settings.key(port)] = true
 
-	logger.Info("리스너 순차 생성 완료",
-		"successfulListeners", successfulListeners,
-		"failedListeners", failedListeners,
-		"totalPorts", len(ports))
-
-	// 일부 리스너라도 성공했으면 전체적으로는 성공으로 간주
-	if successfulListeners > 0 {
-		return nil
+		logger.Info("리스너 생성 성공", "port", port.Port, "targetGroupID", targetGroupIDs[i])
 	}
 
-	return fmt.Errorf("모든 리스너 생성 실패: 성공 %d, 실패 %d", successfulListeners, failedListeners)
+	return nil
 }
 
-// waitForLoadBalancerReadyForListener는 LoadBalancer가 리스너 생성 가능한 상태가 될 때까지 대기합니다
-func (r *ServiceReconciler) waitForLoadBalancerReadyForListener(ctx context.Context, client *vloadbalancer.APIClient, lbID string, logger logr.Logger) bool {
-	maxRetries := 15
-	retryInterval := 5 * time.Second
-
-	// SecretProvider를 통해 인증 정보 가져오기 (Region만 필요)
+// getNaverClient는 Naver Cloud API 호출에 사용할 클라이언트와 인증 정보를 반환합니다
+// NaverClient가 주입되어 있으면 그대로 사용하고, 없으면 인증 정보로 실제 클라이언트를 생성합니다
+func (r *ServiceReconciler) getNaverClient(ctx context.Context) (NaverCloudClient, *NaverCloudCredentials, error) {
 	credentials, err := r.getCredentials(ctx)
 	if err != nil {
-		logger.Error(err, "인증 정보 조회 실패")
-		return false
+		return nil, nil, fmt.Errorf("인증 정보 조회 실패: %w", err)
 	}
 
-	for retry := 1; retry <= maxRetries; retry++ {
-		// LoadBalancer 상태 조회
-		lbDetailReq := vloadbalancer.GetLoadBalancerInstanceDetailRequest{
-			RegionCode:             ncloud.String(credentials.Region),
-			LoadBalancerInstanceNo: &lbID,
-		}
-
-		lbDetailResp, err := client.V2Api.GetLoadBalancerInstanceDetail(&lbDetailReq)
-		if err != nil {
-			logger.Error(err, "LoadBalancer 상태 조회 실패", "lbID", lbID, "retry", retry)
-			time.Sleep(retryInterval)
-			continue
-		}
-
-		if len(lbDetailResp.LoadBalancerInstanceList) == 0 {
-			logger.Error(nil, "LoadBalancer 인스턴스를 찾을 수 없음", "lbID", lbID, "retry", retry)
-			time.Sleep(retryInterval)
-			continue
-		}
-
-		lb := lbDetailResp.LoadBalancerInstanceList[0]
-		statusCode := "UNKNOWN"
-		statusName := "UNKNOWN"
-
-		if lb.LoadBalancerInstanceStatus != nil && lb.LoadBalancerInstanceStatus.Code != nil {
-			statusCode = *lb.LoadBalancerInstanceStatus.Code
-		}
-		if lb.LoadBalancerInstanceStatus != nil && lb.LoadBalancerInstanceStatus.CodeName != nil {
-			statusName = *lb.LoadBalancerInstanceStatus.CodeName
-		}
-
-		logger.Info("LoadBalancer 상태 확인",
-			"lbID", lbID,
-			"statusCode", statusCode,
-			"statusName", statusName,
-			"retry", retry)
-
-		// 더 유연한 상태 조건: RUN, USED 상태이고 Changing이 아닌 경우 준비됨
-		if (statusCode == "RUN" || statusCode == "USED") && statusName != "Changing" {
-			logger.Info("LoadBalancer 준비 완료", "lbID", lbID, "statusCode", statusCode, "statusName", statusName)
-			return true
-		}
-
-		// 첫 번째 리스너인 경우 더 관대한 조건 적용
-		if retry == 1 && statusCode == "USED" {
-			logger.Info("첫 번째 리스너 생성을 위해 USED 상태에서 진행", "lbID", lbID, "statusName", statusName)
-			return true
-		}
+	if r.NaverClient != nil {
+		return r.NaverClient, credentials, nil
+	}
 
-		// 마지막 재시도가 아닌 경우 대기
-		if retry < maxRetries {
-			logger.Info("LoadBalancer 준비 대기",
-				"lbID", lbID,
-				"currentStatus", statusName,
-				"waitSeconds", int(retryInterval.Seconds()),
-				"retry", retry)
-			time.Sleep(retryInterval)
-		}
+	baseURL, err := navercloud.ResolveEndpoint(credentials.EndpointProfile, credentials.APIEndpoint)
+	if err != nil {
+		return nil, nil, fmt.Errorf("API 엔드포인트 결정 실패: %w", err)
 	}
 
-	logger.Info("LoadBalancer 준비 상태 대기 시간 초과, 강제 진행",
-		"lbID", lbID,
-		"maxRetries", maxRetries,
-		"reason", "일부 리스너라도 생성하기 위해 진행")
-	return true // 강제로 true 반환하여 리스너 생성 시도
+	return navercloud.NewRealClientWithAPIKey(credentials.APIKey, credentials.APISecret, baseURL), credentials, nil
 }
 
 // getCredentials는 SecretProvider를 통해 Naver Cloud 인증 정보를 가져옵니다
@@ -2037,15 +2038,31 @@ func (r *ServiceReconciler) getCredentials(ctx context.Context) (*NaverCloudCred
 	if r.NaverCloudConfig.APIKey != "" && r.NaverCloudConfig.APISecret != "" {
 		logger.Info("환경변수에서 인증 정보 사용")
 		return &NaverCloudCredentials{
-			APIKey:    r.NaverCloudConfig.APIKey,
-			APISecret: r.NaverCloudConfig.APISecret,
-			Region:    r.NaverCloudConfig.Region,
-			VpcNo:     r.NaverCloudConfig.VpcNo,
-			SubnetNo:  r.NaverCloudConfig.SubnetNo,
+			APIKey:          r.NaverCloudConfig.APIKey,
+			APISecret:       r.NaverCloudConfig.APISecret,
+			Region:          r.NaverCloudConfig.Region,
+			VpcNo:           r.NaverCloudConfig.VpcNo,
+			SubnetNo:        r.NaverCloudConfig.SubnetNo,
+			PrivateSubnetNo: r.NaverCloudConfig.PrivateSubnetNo,
+			EndpointProfile: r.NaverCloudConfig.EndpointProfile,
+			APIEndpoint:     r.NaverCloudConfig.APIEndpoint,
+
+			NodeAccessControlGroupNo: r.NaverCloudConfig.NodeAccessControlGroupNo,
 		}, nil
 	}
 
 	// SecretProvider를 통해 동적으로 인증 정보 가져오기
 	provider := NewAutoSecretProvider(r.Client, r.SecretConfig, r.ControllerNamespace)
-	return provider.GetCredentials(ctx)
+	credentials, err := provider.GetCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Secret에 엔드포인트 설정이 없으면 컨트롤러 설정(플래그/환경변수)을 사용
+	if credentials.EndpointProfile == "" && credentials.APIEndpoint == "" {
+		credentials.EndpointProfile = r.NaverCloudConfig.EndpointProfile
+		credentials.APIEndpoint = r.NaverCloudConfig.APIEndpoint
+	}
+
+	return credentials, nil
 }
@@ -0,0 +1,479 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	naverv1alpha1 "github.com/suslmk-lee/kube-controller01/api/v1alpha1"
+)
+
+// Network ACL 인바운드 규칙 우선순위 범위. 허용 규칙은 앞에서부터, 차단 규칙은 뒤에서부터 배정하여
+// 다른 Service가 추가한 차단 규칙보다 허용 규칙이 항상 먼저 적용되도록 합니다
+const (
+	minNetworkACLPriority = 0
+	maxNetworkACLPriority = 199
+)
+
+// Network ACL 규칙 동작 코드
+const (
+	networkACLActionAllow = "ALLOW"
+	networkACLActionDrop  = "DROP"
+)
+
+// allSourcesCIDR는 모든 소스를 나타내는 CIDR입니다
+const allSourcesCIDR = "0.0.0.0/0"
+
+// sourceRangesForService는 Service의 loadBalancerSourceRanges를 정규화한 IPv4 CIDR 목록으로 반환합니다.
+// 비어 있거나 0.0.0.0/0을 포함하면 접근을 제한하지 않으므로 nil을 반환합니다
+func sourceRangesForService(service *corev1.Service) ([]string, error) {
+	var ranges []string
+	seen := make(map[string]bool)
+	allowAll := false
+	for _, value := range service.Spec.LoadBalancerSourceRanges {
+		_, ipNet, err := net.ParseCIDR(strings.TrimSpace(value))
+		if err != nil || ipNet.IP.To4() == nil {
+			return nil, fmt.Errorf("loadBalancerSourceRanges 값이 올바르지 않음: %q (IPv4 CIDR)", value)
+		}
+		cidr := ipNet.String()
+		if cidr == allSourcesCIDR {
+			allowAll = true
+		}
+		if !seen[cidr] {
+			seen[cidr] = true
+			ranges = append(ranges, cidr)
+		}
+	}
+	if allowAll {
+		return nil, nil
+	}
+	return ranges, nil
+}
+
+// networkACLRule은 우선순위를 제외한 Network ACL 규칙 내용입니다 (원하는 규칙과 기록된 규칙 비교에 사용)
+type networkACLRule struct {
+	NetworkACLNo string
+	Protocol     string
+	IPBlock      string
+	PortRange    string
+	Action       string
+}
+
+// networkACLRuleOf는 기록된 규칙의 내용을 반환합니다
+func networkACLRuleOf(status naverv1alpha1.NetworkACLRuleStatus) networkACLRule {
+	return networkACLRule{
+		NetworkACLNo: status.NetworkACLNo,
+		Protocol:     status.Protocol,
+		IPBlock:      status.IPBlock,
+		PortRange:    status.PortRange,
+		Action:       status.Action,
+	}
+}
+
+// desiredSourceRangeRules는 Network ACL마다 리스너 포트별로 소스 범위 허용 규칙과 나머지 소스 차단 규칙을 만듭니다
+func desiredSourceRangeRules(networkACLNos, ranges []string, ports []corev1.ServicePort) []networkACLRule {
+	var rules []networkACLRule
+	for _, aclNo := range networkACLNos {
+		seen := make(map[string]bool)
+		for _, port := range ports {
+			protocol := string(servicePortProtocol(port))
+			portRange := fmt.Sprintf("%d", port.Port)
+			if seen[protocol+"/"+portRange] {
+				continue
+			}
+			seen[protocol+"/"+portRange] = true
+
+			for _, cidr := range ranges {
+				rules = append(rules, networkACLRule{NetworkACLNo: aclNo, Protocol: protocol, IPBlock: cidr, PortRange: portRange, Action: networkACLActionAllow})
+			}
+			rules = append(rules, networkACLRule{NetworkACLNo: aclNo, Protocol: protocol, IPBlock: allSourcesCIDR, PortRange: portRange, Action: networkACLActionDrop})
+		}
+	}
+	return rules
+}
+
+// syncSourceRanges는 loadBalancerSourceRanges를 로드밸런서 서브넷의 Network ACL 규칙에 반영합니다.
+// 새 규칙을 먼저 추가한 뒤 필요 없는 규칙을 제거하므로 변경 중에도 허용 범위가 넓어지지 않습니다.
+// Network ACL 규칙에는 목적지가 없어 같은 Network ACL을 쓰는 다른 로드밸런서에도 적용되므로 그런 로드밸런서가 있으면
+// 다른 로드밸런서의 트래픽을 막지 않도록 기록된 규칙을 제거하고 거부합니다
+func (r *ServiceReconciler) syncSourceRanges(ctx context.Context, client NaverCloudClient, credentials *NaverCloudCredentials, service *corev1.Service, nlb *naverv1alpha1.NaverLoadBalancer) error {
+	ranges, err := sourceRangesForService(service)
+	if err != nil {
+		return err
+	}
+	if len(ranges) == 0 && len(nlb.Status.SourceRangeRules) == 0 {
+		return nil
+	}
+	logger := log.FromContext(ctx).WithValues("lb-id", nlb.Status.LoadBalancerNo)
+
+	var desired []networkACLRule
+	if len(ranges) > 0 {
+		networkACLNos, err := r.loadBalancerNetworkACLs(client, credentials, nlb.Status.SubnetNos)
+		if err != nil {
+			return err
+		}
+		shared, err := r.loadBalancersSharingNetworkACLs(client, credentials, nlb.Status.LoadBalancerNo, networkACLNos)
+		if err != nil {
+			return err
+		}
+		if len(shared) > 0 {
+			err := fmt.Errorf("로드밸런서 서브넷의 Network ACL을 다른 로드밸런서(%s)와 공유하므로 loadBalancerSourceRanges를 적용할 수 없음 (전용 서브넷 필요)", strings.Join(shared, ", "))
+			if removed := len(nlb.Status.SourceRangeRules); removed > 0 {
+				if removeErr := r.removeNetworkACLRules(client, credentials, nlb.Status.SourceRangeRules); removeErr != nil {
+					return removeErr
+				}
+				if updateErr := r.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+					latest.Status.SourceRangeRules = nil
+				}); updateErr != nil {
+					return updateErr
+				}
+				logger.Info("공유 Network ACL의 소스 범위 규칙 제거", "count", removed, "sharedWith", shared)
+				err = fmt.Errorf("%w, 기존 규칙 %d개를 제거하여 소스 제한이 해제됨", err, removed)
+			}
+			r.recordWarning(service, "SharedSubnetSourceRanges", "%v", err)
+			return err
+		}
+		desired = desiredSourceRangeRules(networkACLNos, ranges, service.Spec.Ports)
+	}
+
+	// 1. 기록되지 않은 규칙을 Network ACL별로 추가하고 바로 상태에 기록
+	recorded := make(map[networkACLRule]bool, len(nlb.Status.SourceRangeRules))
+	for _, rule := range nlb.Status.SourceRangeRules {
+		recorded[networkACLRuleOf(rule)] = true
+	}
+	missing := make(map[string][]networkACLRule)
+	var missingACLs []string
+	for _, rule := range desired {
+		if recorded[rule] {
+			continue
+		}
+		if _, ok := missing[rule.NetworkACLNo]; !ok {
+			missingACLs = append(missingACLs, rule.NetworkACLNo)
+		}
+		missing[rule.NetworkACLNo] = append(missing[rule.NetworkACLNo], rule)
+	}
+
+	description := fmt.Sprintf("k8s %s/%s", service.Namespace, service.Name)
+	for _, aclNo := range missingACLs {
+		added, err := r.addNetworkACLRules(client, credentials, aclNo, missing[aclNo], description)
+		if err != nil {
+			return err
+		}
+		if err := r.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+			latest.Status.SourceRangeRules = append(latest.Status.SourceRangeRules, added...)
+		}); err != nil {
+			return err
+		}
+		logger.Info("소스 범위 Network ACL 규칙 추가 성공", "networkAclNo", aclNo, "count", len(added))
+	}
+
+	// 2. 더 이상 필요 없는 규칙 제거
+	wanted := make(map[networkACLRule]bool, len(desired))
+	for _, rule := range desired {
+		wanted[rule] = true
+	}
+	var stale []naverv1alpha1.NetworkACLRuleStatus
+	for _, rule := range nlb.Status.SourceRangeRules {
+		if !wanted[networkACLRuleOf(rule)] {
+			stale = append(stale, rule)
+		}
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+	if err := r.removeNetworkACLRules(client, credentials, stale); err != nil {
+		return err
+	}
+	logger.Info("소스 범위 Network ACL 규칙 제거 성공", "count", len(stale))
+
+	return r.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+		var kept []naverv1alpha1.NetworkACLRuleStatus
+		for _, rule := range latest.Status.SourceRangeRules {
+			if wanted[networkACLRuleOf(rule)] {
+				kept = append(kept, rule)
+			}
+		}
+		latest.Status.SourceRangeRules = kept
+	})
+}
+
+// loadBalancerNetworkACLs는 로드밸런서 서브넷에 연결된 Network ACL 번호를 반환합니다
+func (r *ServiceReconciler) loadBalancerNetworkACLs(client NaverCloudClient, credentials *NaverCloudCredentials, subnetNos []string) ([]string, error) {
+	subnets, err := r.lookupLoadBalancerSubnets(client, credentials, subnetNos)
+	if err != nil {
+		return nil, err
+	}
+
+	var networkACLNos []string
+	for _, subnet := range subnets {
+		aclNo := ncloud.StringValue(subnet.NetworkAclNo)
+		if aclNo == "" {
+			return nil, fmt.Errorf("로드밸런서 서브넷 %s의 Network ACL을 찾을 수 없음", ncloud.StringValue(subnet.SubnetNo))
+		}
+		if !containsString(networkACLNos, aclNo) {
+			networkACLNos = append(networkACLNos, aclNo)
+		}
+	}
+	return networkACLNos, nil
+}
+
+// checkSourceRangeSubnets는 새 로드밸런서 서브넷의 Network ACL에 다른 NaverLoadBalancer가 소스 범위 규칙을 기록했는지 확인합니다.
+// 그 규칙은 같은 Network ACL을 쓰는 로드밸런서의 트래픽도 차단하므로 그런 서브넷에는 로드밸런서를 만들지 않습니다
+func (r *ServiceReconciler) checkSourceRangeSubnets(ctx context.Context, client NaverCloudClient, credentials *NaverCloudCredentials, nlb *naverv1alpha1.NaverLoadBalancer, subnetNos []string) error {
+	var nlbList naverv1alpha1.NaverLoadBalancerList
+	if err := r.List(ctx, &nlbList); err != nil {
+		return fmt.Errorf("NaverLoadBalancer 목록 조회 실패: %w", err)
+	}
+
+	// Network ACL별 소스 범위 규칙을 기록한 Service
+	servicesByACL := make(map[string][]string)
+	for _, other := range nlbList.Items {
+		if other.Namespace == nlb.Namespace && other.Name == nlb.Name {
+			continue
+		}
+		owner := other.Namespace + "/" + other.Spec.ServiceName
+		for _, rule := range other.Status.SourceRangeRules {
+			if !containsString(servicesByACL[rule.NetworkACLNo], owner) {
+				servicesByACL[rule.NetworkACLNo] = append(servicesByACL[rule.NetworkACLNo], owner)
+			}
+		}
+	}
+	if len(servicesByACL) == 0 {
+		return nil
+	}
+
+	subnets, err := r.lookupLoadBalancerSubnets(client, credentials, subnetNos)
+	if err != nil {
+		return err
+	}
+	var owners []string
+	for _, subnet := range subnets {
+		for _, owner := range servicesByACL[ncloud.StringValue(subnet.NetworkAclNo)] {
+			if !containsString(owners, owner) {
+				owners = append(owners, owner)
+			}
+		}
+	}
+	if len(owners) == 0 {
+		return nil
+	}
+	sort.Strings(owners)
+	return fmt.Errorf("로드밸런서 서브넷의 Network ACL에 Service %s의 loadBalancerSourceRanges 규칙이 있어 로드밸런서를 만들 수 없음 (다른 서브넷 지정 필요)", strings.Join(owners, ", "))
+}
+
+// loadBalancersSharingNetworkACLs는 networkACLNos 중 하나가 연결된 서브넷을 쓰는 다른 로드밸런서 번호를 반환합니다
+func (r *ServiceReconciler) loadBalancersSharingNetworkACLs(client NaverCloudClient, credentials *NaverCloudCredentials, lbID string, networkACLNos []string) ([]string, error) {
+	lbResp, err := client.GetLoadBalancerInstanceList(&vloadbalancer.GetLoadBalancerInstanceListRequest{
+		RegionCode: ncloud.String(credentials.Region),
+		VpcNo:      ncloud.String(credentials.VpcNo),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("로드밸런서 목록 조회 실패: %w", err)
+	}
+	if lbResp == nil {
+		return nil, nil
+	}
+
+	// 다른 로드밸런서가 쓰는 서브넷별 로드밸런서 번호
+	loadBalancersBySubnet := make(map[string][]string)
+	var subnetNos []string
+	for _, lb := range lbResp.LoadBalancerInstanceList {
+		otherID := ncloud.StringValue(lb.LoadBalancerInstanceNo)
+		if otherID == "" || otherID == lbID {
+			continue
+		}
+		if code, _ := loadBalancerInstanceStatus(lb); code == "TERMINATING" {
+			continue
+		}
+		for _, subnetNo := range ncloud.StringListValue(lb.SubnetNoList) {
+			if _, ok := loadBalancersBySubnet[subnetNo]; !ok {
+				subnetNos = append(subnetNos, subnetNo)
+			}
+			loadBalancersBySubnet[subnetNo] = append(loadBalancersBySubnet[subnetNo], otherID)
+		}
+	}
+	if len(subnetNos) == 0 {
+		return nil, nil
+	}
+
+	subnetResp, err := client.GetSubnetList(&vpc.GetSubnetListRequest{
+		RegionCode:   ncloud.String(credentials.Region),
+		SubnetNoList: ncloud.StringList(subnetNos),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("서브넷 목록 조회 실패: %w", err)
+	}
+	if subnetResp == nil {
+		return nil, nil
+	}
+
+	var shared []string
+	for _, subnet := range subnetResp.SubnetList {
+		if subnet == nil || !containsString(networkACLNos, ncloud.StringValue(subnet.NetworkAclNo)) {
+			continue
+		}
+		for _, otherID := range loadBalancersBySubnet[ncloud.StringValue(subnet.SubnetNo)] {
+			if !containsString(shared, otherID) {
+				shared = append(shared, otherID)
+			}
+		}
+	}
+	sort.Strings(shared)
+	return shared, nil
+}
+
+// lookupLoadBalancerSubnets는 상태에 기록된 로드밸런서 서브넷을 같은 순서로 조회합니다
+func (r *ServiceReconciler) lookupLoadBalancerSubnets(client NaverCloudClient, credentials *NaverCloudCredentials, subnetNos []string) ([]*vpc.Subnet, error) {
+	if len(subnetNos) == 0 {
+		return nil, fmt.Errorf("로드밸런서 서브넷을 알 수 없음")
+	}
+
+	resp, err := client.GetSubnetList(&vpc.GetSubnetListRequest{
+		RegionCode:   ncloud.String(credentials.Region),
+		SubnetNoList: ncloud.StringList(subnetNos),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("서브넷 목록 조회 실패: %w", err)
+	}
+
+	found := make(map[string]*vpc.Subnet, len(subnetNos))
+	if resp != nil {
+		for _, subnet := range resp.SubnetList {
+			if subnet != nil {
+				found[ncloud.StringValue(subnet.SubnetNo)] = subnet
+			}
+		}
+	}
+
+	subnets := make([]*vpc.Subnet, 0, len(subnetNos))
+	for _, subnetNo := range subnetNos {
+		subnet, ok := found[subnetNo]
+		if !ok {
+			return nil, fmt.Errorf("로드밸런서 서브넷 %s를 찾을 수 없음", subnetNo)
+		}
+		subnets = append(subnets, subnet)
+	}
+	return subnets, nil
+}
+
+// addNetworkACLRules는 Network ACL에서 비어 있는 우선순위를 골라 규칙을 추가하고 추가한 규칙을 반환합니다
+func (r *ServiceReconciler) addNetworkACLRules(client NaverCloudClient, credentials *NaverCloudCredentials, networkACLNo string, rules []networkACLRule, description string) ([]naverv1alpha1.NetworkACLRuleStatus, error) {
+	listResp, err := client.GetNetworkAclRuleList(&vpc.GetNetworkAclRuleListRequest{
+		RegionCode:             ncloud.String(credentials.Region),
+		NetworkAclNo:           ncloud.String(networkACLNo),
+		NetworkAclRuleTypeCode: ncloud.String("INBND"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Network ACL 규칙 목록 조회 실패: %w", err)
+	}
+	used := make(map[int32]bool)
+	if listResp != nil {
+		for _, rule := range listResp.NetworkAclRuleList {
+			used[ncloud.Int32Value(rule.Priority)] = true
+		}
+	}
+
+	var free []int32
+	for priority := int32(minNetworkACLPriority); priority <= maxNetworkACLPriority; priority++ {
+		if !used[priority] {
+			free = append(free, priority)
+		}
+	}
+	if len(free) < len(rules) {
+		return nil, fmt.Errorf("Network ACL %s에 규칙을 추가할 우선순위가 부족함: 필요 %d, 남은 %d", networkACLNo, len(rules), len(free))
+	}
+
+	// 허용 규칙은 비어 있는 가장 작은 우선순위부터, 차단 규칙은 가장 큰 우선순위부터 배정
+	added := make([]naverv1alpha1.NetworkACLRuleStatus, 0, len(rules))
+	low, high := 0, len(free)-1
+	for _, rule := range rules {
+		var priority int32
+		if rule.Action == networkACLActionAllow {
+			priority, low = free[low], low+1
+		} else {
+			priority, high = free[high], high-1
+		}
+		added = append(added, naverv1alpha1.NetworkACLRuleStatus{
+			NetworkACLNo: networkACLNo,
+			Priority:     priority,
+			Protocol:     rule.Protocol,
+			IPBlock:      rule.IPBlock,
+			PortRange:    rule.PortRange,
+			Action:       rule.Action,
+		})
+	}
+	sort.Slice(added, func(i, j int) bool { return added[i].Priority < added[j].Priority })
+
+	params := make([]*vpc.AddNetworkAclRuleParameter, 0, len(added))
+	for _, rule := range added {
+		params = append(params, &vpc.AddNetworkAclRuleParameter{
+			IpBlock:                   ncloud.String(rule.IPBlock),
+			RuleActionCode:            ncloud.String(rule.Action),
+			PortRange:                 ncloud.String(rule.PortRange),
+			Priority:                  ncloud.Int32(rule.Priority),
+			ProtocolTypeCode:          ncloud.String(rule.Protocol),
+			NetworkAclRuleDescription: ncloud.String(description),
+		})
+	}
+	if _, err := client.AddNetworkAclInboundRule(&vpc.AddNetworkAclInboundRuleRequest{
+		RegionCode:         ncloud.String(credentials.Region),
+		NetworkAclNo:       ncloud.String(networkACLNo),
+		NetworkAclRuleList: params,
+	}); err != nil {
+		return nil, fmt.Errorf("Network ACL 규칙 추가 실패: %w", err)
+	}
+	return added, nil
+}
+
+// removeNetworkACLRules는 기록된 Network ACL 규칙을 Network ACL별로 제거합니다
+func (r *ServiceReconciler) removeNetworkACLRules(client NaverCloudClient, credentials *NaverCloudCredentials, rules []naverv1alpha1.NetworkACLRuleStatus) error {
+	params := make(map[string][]*vpc.RemoveNetworkAclRuleParameter)
+	var networkACLNos []string
+	for _, rule := range rules {
+		if _, ok := params[rule.NetworkACLNo]; !ok {
+			networkACLNos = append(networkACLNos, rule.NetworkACLNo)
+		}
+		params[rule.NetworkACLNo] = append(params[rule.NetworkACLNo], &vpc.RemoveNetworkAclRuleParameter{
+			IpBlock:          ncloud.String(rule.IPBlock),
+			RuleActionCode:   ncloud.String(rule.Action),
+			PortRange:        ncloud.String(rule.PortRange),
+			Priority:         ncloud.Int32(rule.Priority),
+			ProtocolTypeCode: ncloud.String(rule.Protocol),
+		})
+	}
+
+	for _, aclNo := range networkACLNos {
+		if _, err := client.RemoveNetworkAclInboundRule(&vpc.RemoveNetworkAclInboundRuleRequest{
+			RegionCode:         ncloud.String(credentials.Region),
+			NetworkAclNo:       ncloud.String(aclNo),
+			NetworkAclRuleList: params[aclNo],
+		}); err != nil {
+			return fmt.Errorf("Network ACL 규칙 제거 실패: %w", err)
+		}
+	}
+	return nil
+}
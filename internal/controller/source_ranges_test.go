@@ -0,0 +1,228 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	naverv1alpha1 "github.com/suslmk-lee/kube-controller01/api/v1alpha1"
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("Source Ranges Tests", func() {
+	var (
+		reconciler *ServiceReconciler
+		mockClient *navercloud.MockClient
+		ctx        context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockClient = navercloud.NewMockClient()
+		mockClient.AddMockSubnet("subnet-67890", "vpc-12345", "KR-1", "PRIVATE", "LOADB")
+		mockClient.Subnets[0].NetworkAclNo = ncloud.String("acl-lb")
+		// 다른 용도로 이미 사용 중인 우선순위
+		mockClient.NetworkAclRules["acl-lb"] = []vpc.NetworkAclRule{
+			{NetworkAclNo: ncloud.String("acl-lb"), Priority: ncloud.Int32(0), IpBlock: ncloud.String("10.0.0.0/16")},
+		}
+
+		reconciler = &ServiceReconciler{
+			Client: k8sClient,
+			NaverCloudConfig: NaverCloudConfig{
+				APIKey:    "test-api-key",
+				APISecret: "test-api-secret",
+				Region:    "KR",
+				VpcNo:     "vpc-12345",
+				SubnetNo:  "subnet-67890",
+			},
+			NaverClient: mockClient,
+		}
+	})
+
+	Context("When reading loadBalancerSourceRanges", func() {
+		It("should normalize the ranges and treat 0.0.0.0/0 as unrestricted", func() {
+			service := &corev1.Service{Spec: corev1.ServiceSpec{
+				LoadBalancerSourceRanges: []string{"203.0.113.7/24", " 198.51.100.0/24", "203.0.113.0/24"},
+			}}
+			Expect(sourceRangesForService(service)).To(Equal([]string{"203.0.113.0/24", "198.51.100.0/24"}))
+
+			service.Spec.LoadBalancerSourceRanges = append(service.Spec.LoadBalancerSourceRanges, "0.0.0.0/0")
+			Expect(sourceRangesForService(service)).To(BeEmpty())
+
+			for _, invalid := range []string{"203.0.113.0", "2001:db8::/32", "any"} {
+				service.Spec.LoadBalancerSourceRanges = []string{invalid}
+				_, err := sourceRangesForService(service)
+				Expect(err).To(HaveOccurred(), "range: %s", invalid)
+			}
+		})
+	})
+
+	Context("When loadBalancerSourceRanges change", func() {
+		It("should add, replace and remove the Network ACL rules of the load balancer subnet", func() {
+			service := newService(ctx, "source-ranges-service", nil, 80)
+			service.Spec.LoadBalancerSourceRanges = []string{"203.0.113.0/24"}
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+
+			nlb, err := reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Status.SourceRangeRules).To(ConsistOf(
+				naverv1alpha1.NetworkACLRuleStatus{NetworkACLNo: "acl-lb", Priority: 1, Protocol: "TCP", IPBlock: "203.0.113.0/24", PortRange: "80", Action: "ALLOW"},
+				naverv1alpha1.NetworkACLRuleStatus{NetworkACLNo: "acl-lb", Priority: 199, Protocol: "TCP", IPBlock: "0.0.0.0/0", PortRange: "80", Action: "DROP"},
+			))
+			Expect(mockClient.NetworkAclRules["acl-lb"]).To(HaveLen(3))
+
+			By("Adding the new range before removing the old one")
+			service.Spec.LoadBalancerSourceRanges = []string{"198.51.100.0/24"}
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.AddACLRuleCalled).To(Equal(2))
+			Expect(mockClient.RemoveACLRuleCalled).To(Equal(1))
+
+			nlb, err = reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Status.SourceRangeRules).To(ConsistOf(
+				HaveField("IPBlock", "198.51.100.0/24"),
+				HaveField("IPBlock", "0.0.0.0/0"),
+			))
+			Expect(mockClient.NetworkAclRules["acl-lb"]).To(HaveLen(3))
+
+			By("Removing every rule when the ranges are cleared")
+			service.Spec.LoadBalancerSourceRanges = nil
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+
+			nlb, err = reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Status.SourceRangeRules).To(BeEmpty())
+			Expect(mockClient.NetworkAclRules["acl-lb"]).To(HaveLen(1))
+		})
+
+		It("should remove the recorded rules when the Service is deleted", func() {
+			service := newService(ctx, "source-ranges-delete-service", nil, 80)
+			defer cleanupService(ctx, service)
+
+			nlb, err := reconciler.ensureNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			added, err := reconciler.addNetworkACLRules(mockClient, &NaverCloudCredentials{Region: "KR"}, "acl-lb",
+				desiredSourceRangeRules([]string{"acl-lb"}, []string{"203.0.113.0/24"}, service.Spec.Ports), "test")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reconciler.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+				latest.Status.SourceRangeRules = added
+			})).To(Succeed())
+
+			Expect(reconciler.deleteNaverCloudLB(ctx, service)).To(Succeed())
+			Expect(mockClient.RemoveACLRuleCalled).To(Equal(1))
+			Expect(mockClient.NetworkAclRules["acl-lb"]).To(HaveLen(1))
+		})
+
+		It("should refuse the ranges while another load balancer shares the Network ACL", func() {
+			recorder := record.NewFakeRecorder(10)
+			reconciler.Recorder = recorder
+			mockClient.LoadBalancers = append(mockClient.LoadBalancers, vloadbalancer.LoadBalancerInstance{
+				LoadBalancerInstanceNo: ncloud.String("lb-other"),
+				LoadBalancerName:       ncloud.String("other-lb"),
+				SubnetNoList:           ncloud.StringList([]string{"subnet-67890"}),
+			})
+
+			service := newService(ctx, "source-ranges-shared-service", nil, 80)
+			service.Spec.LoadBalancerSourceRanges = []string{"203.0.113.0/24"}
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).To(MatchError(ContainSubstring("lb-other")))
+			Expect(mockClient.AddACLRuleCalled).To(Equal(0))
+			Expect(mockClient.NetworkAclRules["acl-lb"]).To(HaveLen(1))
+			Expect(recorder.Events).To(Receive(ContainSubstring("SharedSubnetSourceRanges")))
+		})
+
+		It("should remove its rules once another load balancer shares the Network ACL", func() {
+			recorder := record.NewFakeRecorder(10)
+			reconciler.Recorder = recorder
+
+			service := newService(ctx, "source-ranges-later-shared-service", nil, 80)
+			service.Spec.LoadBalancerSourceRanges = []string{"203.0.113.0/24"}
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.NetworkAclRules["acl-lb"]).To(HaveLen(3))
+
+			By("Placing another load balancer in the same subnet")
+			mockClient.LoadBalancers = append(mockClient.LoadBalancers, vloadbalancer.LoadBalancerInstance{
+				LoadBalancerInstanceNo: ncloud.String("lb-other"),
+				LoadBalancerName:       ncloud.String("other-lb"),
+				SubnetNoList:           ncloud.StringList([]string{"subnet-67890"}),
+			})
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).To(MatchError(ContainSubstring("lb-other")))
+			Expect(mockClient.NetworkAclRules["acl-lb"]).To(HaveLen(1))
+			Expect(recorder.Events).To(Receive(ContainSubstring("SharedSubnetSourceRanges")))
+
+			nlb, err := reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Status.SourceRangeRules).To(BeEmpty())
+		})
+
+		It("should refuse to create a load balancer in a subnet restricted by another Service", func() {
+			recorder := record.NewFakeRecorder(10)
+			reconciler.Recorder = recorder
+
+			restricted := newService(ctx, "source-ranges-restricted-service", nil, 80)
+			defer cleanupService(ctx, restricted)
+			nlb, err := reconciler.ensureNaverLoadBalancer(ctx, restricted)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reconciler.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+				latest.Status.SourceRangeRules = []naverv1alpha1.NetworkACLRuleStatus{
+					{NetworkACLNo: "acl-lb", Priority: 199, Protocol: "TCP", IPBlock: "0.0.0.0/0", PortRange: "80", Action: "DROP"},
+				}
+			})).To(Succeed())
+
+			service := newService(ctx, "source-ranges-new-service", nil, 80)
+			defer cleanupService(ctx, service)
+
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).To(MatchError(ContainSubstring("default/source-ranges-restricted-service")))
+			Expect(mockClient.CreateTGCalled).To(Equal(0))
+			Expect(mockClient.CreateLBCalled).To(Equal(0))
+			Expect(recorder.Events).To(Receive(ContainSubstring("SharedSubnetSourceRanges")))
+		})
+
+		It("should record an Event for invalid ranges", func() {
+			recorder := record.NewFakeRecorder(10)
+			reconciler.Recorder = recorder
+
+			service := newService(ctx, "source-ranges-invalid-service", nil, 80)
+			service.Spec.LoadBalancerSourceRanges = []string{"not-a-cidr"}
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).To(HaveOccurred())
+			Expect(mockClient.CreateTGCalled).To(Equal(0))
+			Expect(recorder.Events).To(Receive(ContainSubstring("InvalidSourceRanges")))
+		})
+	})
+})
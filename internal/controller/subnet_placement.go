@@ -0,0 +1,164 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// 로드밸런서 배치 어노테이션 (쉼표로 구분, 둘 중 하나만 지정)
+const (
+	lbSubnetsAnnotation = "naver.k-paas.org/lb-subnets" // 로드밸런서 전용 서브넷 번호 목록
+	lbZonesAnnotation   = "naver.k-paas.org/lb-zones"   // 존 코드 목록 (예: KR-1,KR-2), 존별 로드밸런서 전용 서브넷으로 변환
+)
+
+// loadBalancerSubnetUsageType은 로드밸런서 전용 서브넷의 용도 코드입니다
+const loadBalancerSubnetUsageType = "LOADB"
+
+// splitAnnotationList는 쉼표로 구분된 어노테이션 값을 공백과 빈 항목을 제거한 목록으로 변환합니다
+func splitAnnotationList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// loadBalancerSubnets는 로드밸런서를 생성할 서브넷 목록을 결정합니다.
+// 어노테이션이 없으면 네트워크 타입에 맞게 설정된 서브넷 하나를 사용하고,
+// 있으면 VPC API로 각 서브넷이 설정된 VPC의 로드밸런서 전용 서브넷인지 확인합니다
+func (r *ServiceReconciler) loadBalancerSubnets(ctx context.Context, client NaverCloudClient, credentials *NaverCloudCredentials, service *corev1.Service, networkType string) ([]string, error) {
+	logger := log.FromContext(ctx)
+
+	subnetNos := splitAnnotationList(service.Annotations[lbSubnetsAnnotation])
+	zones := splitAnnotationList(service.Annotations[lbZonesAnnotation])
+	if len(subnetNos) > 0 && len(zones) > 0 {
+		return nil, fmt.Errorf("%s와 %s 어노테이션은 함께 지정할 수 없음", lbSubnetsAnnotation, lbZonesAnnotation)
+	}
+	if len(subnetNos) == 0 && len(zones) == 0 {
+		subnetNo, err := loadBalancerSubnetNo(credentials, networkType)
+		if err != nil {
+			return nil, err
+		}
+		return []string{subnetNo}, nil
+	}
+
+	// VPC의 로드밸런서 전용 서브넷 조회
+	resp, err := client.GetSubnetList(&vpc.GetSubnetListRequest{
+		RegionCode:    ncloud.String(credentials.Region),
+		VpcNo:         ncloud.String(credentials.VpcNo),
+		UsageTypeCode: ncloud.String(loadBalancerSubnetUsageType),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("서브넷 목록 조회 실패: %w", err)
+	}
+	available := make(map[string]*vpc.Subnet)
+	if resp != nil {
+		for _, subnet := range resp.SubnetList {
+			if subnet == nil || !isUsableLoadBalancerSubnet(subnet, credentials.VpcNo) {
+				continue
+			}
+			available[ncloud.StringValue(subnet.SubnetNo)] = subnet
+		}
+	}
+
+	if len(zones) > 0 {
+		subnetNos, err = subnetsForZones(zones, available, credentials, networkType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 존당 서브넷은 하나만 사용할 수 있음
+	usedZones := make(map[string]string)
+	for _, subnetNo := range subnetNos {
+		subnet, ok := available[subnetNo]
+		if !ok {
+			return nil, fmt.Errorf("서브넷 %s은(는) VPC %s의 로드밸런서 전용 서브넷이 아님", subnetNo, credentials.VpcNo)
+		}
+		zone := ncloud.StringValue(subnet.ZoneCode)
+		if other, ok := usedZones[zone]; ok {
+			return nil, fmt.Errorf("서브넷 %s와 %s가 같은 존(%s)에 있음 (존당 하나의 서브넷만 지정)", other, subnetNo, zone)
+		}
+		usedZones[zone] = subnetNo
+	}
+
+	logger.Info("로드밸런서 서브넷 결정", "subnets", subnetNos, "zones", usedZones)
+	return subnetNos, nil
+}
+
+// isUsableLoadBalancerSubnet은 서브넷이 지정한 VPC의 사용 가능한 로드밸런서 전용 서브넷인지 확인합니다
+func isUsableLoadBalancerSubnet(subnet *vpc.Subnet, vpcNo string) bool {
+	if ncloud.StringValue(subnet.VpcNo) != vpcNo {
+		return false
+	}
+	if subnet.UsageType == nil || ncloud.StringValue(subnet.UsageType.Code) != loadBalancerSubnetUsageType {
+		return false
+	}
+	return subnet.SubnetStatus == nil || ncloud.StringValue(subnet.SubnetStatus.Code) == "RUN"
+}
+
+// subnetsForZones는 존마다 로드밸런서 전용 서브넷을 하나씩 고릅니다.
+// 설정된 기본 서브넷이 해당 존에 있으면 우선하고, 다음으로 네트워크 타입과 같은 타입의 서브넷을 서브넷 번호 순으로 선택합니다
+func subnetsForZones(zones []string, available map[string]*vpc.Subnet, credentials *NaverCloudCredentials, networkType string) ([]string, error) {
+	preferred := credentials.SubnetNo
+	if networkType == lbNetworkTypePrivate {
+		preferred = credentials.PrivateSubnetNo
+	}
+
+	var subnetNos []string
+	for _, zone := range zones {
+		var candidates []*vpc.Subnet
+		for _, subnet := range available {
+			if strings.EqualFold(ncloud.StringValue(subnet.ZoneCode), zone) {
+				candidates = append(candidates, subnet)
+			}
+		}
+		if len(candidates) == 0 {
+			return nil, fmt.Errorf("존 %s에 로드밸런서 전용 서브넷이 없음", zone)
+		}
+
+		rank := func(subnet *vpc.Subnet) int {
+			switch {
+			case ncloud.StringValue(subnet.SubnetNo) == preferred:
+				return 0
+			case subnet.SubnetType != nil && ncloud.StringValue(subnet.SubnetType.Code) == networkType:
+				return 1
+			default:
+				return 2
+			}
+		}
+		sort.Slice(candidates, func(i, j int) bool {
+			if rank(candidates[i]) != rank(candidates[j]) {
+				return rank(candidates[i]) < rank(candidates[j])
+			}
+			return ncloud.StringValue(candidates[i].SubnetNo) < ncloud.StringValue(candidates[j].SubnetNo)
+		})
+		subnetNos = append(subnetNos, ncloud.StringValue(candidates[0].SubnetNo))
+	}
+	return subnetNos, nil
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Load Balancer Subnet Placement Tests", func() {
+	var (
+		reconciler  *ServiceReconciler
+		mockClient  *navercloud.MockClient
+		credentials *NaverCloudCredentials
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockClient = navercloud.NewMockClient()
+		mockClient.AddMockSubnet("subnet-67890", "vpc-12345", "KR-1", "PRIVATE", "LOADB")
+		mockClient.AddMockSubnet("subnet-lb-kr1", "vpc-12345", "KR-1", "PRIVATE", "LOADB")
+		mockClient.AddMockSubnet("subnet-lb-kr2", "vpc-12345", "KR-2", "PRIVATE", "LOADB")
+		mockClient.AddMockSubnet("subnet-gen-kr2", "vpc-12345", "KR-2", "PRIVATE", "GEN")
+		mockClient.AddMockSubnet("subnet-other-vpc", "vpc-99999", "KR-2", "PRIVATE", "LOADB")
+
+		reconciler = &ServiceReconciler{
+			Client: k8sClient,
+			NaverCloudConfig: NaverCloudConfig{
+				APIKey:    "test-api-key",
+				APISecret: "test-api-secret",
+				Region:    "KR",
+				VpcNo:     "vpc-12345",
+				SubnetNo:  "subnet-67890",
+			},
+			NaverClient: mockClient,
+		}
+		credentials = &NaverCloudCredentials{Region: "KR", VpcNo: "vpc-12345", SubnetNo: "subnet-67890"}
+	})
+
+	withAnnotations := func(annotations map[string]string) *corev1.Service {
+		return &corev1.Service{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}}
+	}
+
+	Context("When no placement annotation is set", func() {
+		It("should use the configured subnet without calling the VPC API", func() {
+			subnets, err := reconciler.loadBalancerSubnets(ctx, mockClient, credentials, withAnnotations(nil), lbNetworkTypePublic)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(subnets).To(Equal([]string{"subnet-67890"}))
+			Expect(mockClient.GetSubnetsCalled).To(Equal(0))
+		})
+	})
+
+	Context("When resolving the placement annotations", func() {
+		It("should accept load balancer subnets in different zones", func() {
+			subnets, err := reconciler.loadBalancerSubnets(ctx, mockClient, credentials, withAnnotations(map[string]string{
+				lbSubnetsAnnotation: "subnet-lb-kr1, subnet-lb-kr2",
+			}), lbNetworkTypePublic)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(subnets).To(Equal([]string{"subnet-lb-kr1", "subnet-lb-kr2"}))
+		})
+
+		It("should resolve zones and prefer the configured subnet", func() {
+			subnets, err := reconciler.loadBalancerSubnets(ctx, mockClient, credentials, withAnnotations(map[string]string{
+				lbZonesAnnotation: "KR-1,KR-2",
+			}), lbNetworkTypePublic)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(subnets).To(Equal([]string{"subnet-67890", "subnet-lb-kr2"}))
+		})
+
+		It("should reject subnets that cannot host the load balancer", func() {
+			for _, value := range []string{"subnet-gen-kr2", "subnet-other-vpc", "subnet-missing", "subnet-67890,subnet-lb-kr1"} {
+				_, err := reconciler.loadBalancerSubnets(ctx, mockClient, credentials, withAnnotations(map[string]string{
+					lbSubnetsAnnotation: value,
+				}), lbNetworkTypePublic)
+				Expect(err).To(HaveOccurred(), value)
+			}
+
+			_, err := reconciler.loadBalancerSubnets(ctx, mockClient, credentials, withAnnotations(map[string]string{
+				lbZonesAnnotation: "KR-3",
+			}), lbNetworkTypePublic)
+			Expect(err).To(MatchError(ContainSubstring("KR-3")))
+
+			_, err = reconciler.loadBalancerSubnets(ctx, mockClient, credentials, withAnnotations(map[string]string{
+				lbSubnetsAnnotation: "subnet-lb-kr1",
+				lbZonesAnnotation:   "KR-2",
+			}), lbNetworkTypePublic)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("When creating a multi-zone load balancer", func() {
+		It("should place the load balancer in every requested zone", func() {
+			service := newService(ctx, "subnet-multizone-service", map[string]string{lbZonesAnnotation: "KR-1,KR-2"}, 80)
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.LoadBalancers).To(HaveLen(1))
+			Expect(mockClient.LoadBalancers[0].SubnetNoList).To(HaveExactElements(
+				HaveValue(Equal("subnet-67890")),
+				HaveValue(Equal("subnet-lb-kr2")),
+			))
+
+			nlb, err := reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Status.SubnetNos).To(Equal([]string{"subnet-67890", "subnet-lb-kr2"}))
+		})
+
+		It("should not create any resource when a subnet is invalid", func() {
+			service := newService(ctx, "subnet-invalid-service", map[string]string{lbSubnetsAnnotation: "subnet-gen-kr2"}, 80)
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).To(MatchError(ContainSubstring("subnet-gen-kr2")))
+			Expect(mockClient.CreateTGCalled).To(Equal(0))
+			Expect(mockClient.CreateLBCalled).To(Equal(0))
+		})
+	})
+})
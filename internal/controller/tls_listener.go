@@ -0,0 +1,319 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	naverv1alpha1 "github.com/suslmk-lee/kube-controller01/api/v1alpha1"
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// TLS 종료 어노테이션
+const (
+	// sslPortsAnnotation은 TLS를 종료할 Service 포트 이름 또는 번호 목록입니다 (쉼표로 구분)
+	sslPortsAnnotation = "naver.k-paas.org/ssl-ports"
+	// sslCertificateNoAnnotation은 Certificate Manager에 등록된 인증서 번호입니다
+	sslCertificateNoAnnotation = "naver.k-paas.org/ssl-certificate-no"
+	// sslCertificateSecretAnnotation은 Certificate Manager로 가져올 같은 네임스페이스의 TLS Secret 이름입니다
+	sslCertificateSecretAnnotation = "naver.k-paas.org/ssl-certificate-secret"
+	// tlsMinVersionAnnotation은 TLS 최소 버전입니다 (TLSV10, TLSV11, TLSV12, TLSV13)
+	tlsMinVersionAnnotation = "naver.k-paas.org/tls-min-version"
+	// tlsCipherSuitesAnnotation은 허용할 암호화 스위트 목록입니다 (쉼표로 구분, 비우면 기본 정책)
+	tlsCipherSuitesAnnotation = "naver.k-paas.org/tls-cipher-suites"
+)
+
+// defaultTLSMinVersion은 TLS 최소 버전 기본값입니다
+const defaultTLSMinVersion = "TLSV12"
+
+// tlsMinVersions는 지원하는 TLS 최소 버전 코드입니다
+var tlsMinVersions = map[string]bool{"TLSV10": true, "TLSV11": true, "TLSV12": true, "TLSV13": true}
+
+// listenerSettings는 Service 어노테이션으로 결정한 리스너 설정입니다
+type listenerSettings struct {
+	LBType        string
+	TLSPorts      map[int32]bool // TLS를 종료할 Service 포트 번호
+	CertificateNo string
+	TLSMinVersion string
+	CipherSuites  []string
+	// importedCertificate는 TLS Secret에서 가져온 인증서입니다 (리스너 갱신 후 상태에 기록)
+	importedCertificate *naverv1alpha1.CertificateStatus
+}
+
+// isTLS는 포트가 TLS를 종료하는지 확인합니다
+func (s *listenerSettings) isTLS(port corev1.ServicePort) bool {
+	return servicePortProtocol(port) == corev1.ProtocolTCP && s.TLSPorts[port.Port]
+}
+
+// protocol은 포트의 리스너 프로토콜을 반환합니다 (TLS 포트는 APPLICATION이면 HTTPS, 그 외에는 TLS)
+func (s *listenerSettings) protocol(port corev1.ServicePort) string {
+	if s.isTLS(port) {
+		if s.LBType == lbTypeApplication {
+			return "HTTPS"
+		}
+		return "TLS"
+	}
+	return listenerProtocolForPort(s.LBType, port)
+}
+
+// key는 포트에 대응하는 리스너 키를 반환합니다
+func (s *listenerSettings) key(port corev1.ServicePort) string {
+	return listenerKey(port.Port, s.protocol(port))
+}
+
+// applyTLS는 TLS 포트의 리스너 생성 요청에 인증서와 TLS 정책을 설정합니다
+func (s *listenerSettings) applyTLS(req *vloadbalancer.CreateLoadBalancerListenerRequest, port corev1.ServicePort) {
+	if !s.isTLS(port) {
+		return
+	}
+	req.SslCertificateNo = ncloud.String(s.CertificateNo)
+	req.TlsMinVersionTypeCode = ncloud.String(s.TLSMinVersion)
+	if len(s.CipherSuites) > 0 {
+		req.CipherSuiteList = ncloud.StringList(s.CipherSuites)
+	}
+}
+
+// needsTLSUpdate는 기존 TLS 리스너의 인증서나 TLS 정책이 설정과 다른지 확인합니다
+func (s *listenerSettings) needsTLSUpdate(listener *vloadbalancer.LoadBalancerListener, port corev1.ServicePort) bool {
+	if !s.isTLS(port) {
+		return false
+	}
+	if ncloud.StringValue(listener.SslCertificateNo) != s.CertificateNo {
+		return true
+	}
+	if listener.TlsMinVersionType != nil && ncloud.StringValue(listener.TlsMinVersionType.Code) != s.TLSMinVersion {
+		return true
+	}
+	if len(s.CipherSuites) > 0 {
+		current := make(map[string]bool, len(listener.CipherSuiteList))
+		for _, suite := range listener.CipherSuiteList {
+			current[ncloud.StringValue(suite)] = true
+		}
+		if len(current) != len(s.CipherSuites) {
+			return true
+		}
+		for _, suite := range s.CipherSuites {
+			if !current[suite] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseListenerSettings는 Service 어노테이션에서 리스너 설정을 읽고 검증합니다.
+// TLS Secret으로 지정한 인증서 번호는 resolveCertificate에서 채웁니다
+func parseListenerSettings(service *corev1.Service, lbType string) (*listenerSettings, error) {
+	settings := &listenerSettings{LBType: lbType, TLSPorts: make(map[int32]bool)}
+
+	sslPorts := splitAnnotationList(service.Annotations[sslPortsAnnotation])
+	if len(sslPorts) == 0 {
+		return settings, nil
+	}
+	if lbType == lbTypeNetwork {
+		return nil, fmt.Errorf("NETWORK 로드밸런서는 TLS 종료를 지원하지 않음 (%s 어노테이션 제거 또는 NETWORK_PROXY/APPLICATION 사용)", sslPortsAnnotation)
+	}
+
+	for _, value := range sslPorts {
+		number, numErr := strconv.ParseInt(value, 10, 32)
+		found := false
+		for _, port := range service.Spec.Ports {
+			if port.Name != value && (numErr != nil || int64(port.Port) != number) {
+				continue
+			}
+			if servicePortProtocol(port) != corev1.ProtocolTCP {
+				return nil, fmt.Errorf("TLS는 TCP 포트에서만 사용할 수 있음: %s", value)
+			}
+			settings.TLSPorts[port.Port] = true
+			found = true
+		}
+		if !found {
+			return nil, fmt.Errorf("%s 어노테이션의 포트를 Service에서 찾을 수 없음: %s", sslPortsAnnotation, value)
+		}
+	}
+
+	certificateNo := strings.TrimSpace(service.Annotations[sslCertificateNoAnnotation])
+	secretName := strings.TrimSpace(service.Annotations[sslCertificateSecretAnnotation])
+	switch {
+	case certificateNo == "" && secretName == "":
+		return nil, fmt.Errorf("TLS 포트에는 %s 또는 %s 어노테이션이 필요함", sslCertificateNoAnnotation, sslCertificateSecretAnnotation)
+	case certificateNo != "" && secretName != "":
+		return nil, fmt.Errorf("%s와 %s 어노테이션은 함께 지정할 수 없음", sslCertificateNoAnnotation, sslCertificateSecretAnnotation)
+	}
+	settings.CertificateNo = certificateNo
+
+	settings.TLSMinVersion = defaultTLSMinVersion
+	if value := service.Annotations[tlsMinVersionAnnotation]; value != "" {
+		version := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(value), ".", ""))
+		if !tlsMinVersions[version] {
+			return nil, fmt.Errorf("지원하지 않는 TLS 최소 버전: %s (TLSV10, TLSV11, TLSV12, TLSV13 중 선택)", value)
+		}
+		settings.TLSMinVersion = version
+	}
+	settings.CipherSuites = splitAnnotationList(service.Annotations[tlsCipherSuitesAnnotation])
+
+	return settings, nil
+}
+
+// resolveCertificate는 TLS Secret을 사용하면 Certificate Manager로 가져와 인증서 번호를 채웁니다.
+// Secret 내용이 바뀌지 않았으면 상태에 기록된 인증서를 그대로 사용합니다
+func (r *ServiceReconciler) resolveCertificate(ctx context.Context, client NaverCloudClient, service *corev1.Service, nlb *naverv1alpha1.NaverLoadBalancer, settings *listenerSettings) error {
+	logger := log.FromContext(ctx)
+
+	secretName := strings.TrimSpace(service.Annotations[sslCertificateSecretAnnotation])
+	if len(settings.TLSPorts) == 0 || secretName == "" {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: service.Namespace, Name: secretName}, secret); err != nil {
+		return fmt.Errorf("TLS Secret %s 조회 실패: %w", secretName, err)
+	}
+	certPEM := secret.Data[corev1.TLSCertKey]
+	keyPEM := secret.Data[corev1.TLSPrivateKeyKey]
+	if len(certPEM) == 0 || len(keyPEM) == 0 {
+		return fmt.Errorf("TLS Secret %s에 %s 또는 %s가 없음", secretName, corev1.TLSCertKey, corev1.TLSPrivateKeyKey)
+	}
+
+	sum := sha256.Sum256(append(append([]byte{}, certPEM...), keyPEM...))
+	fingerprint := hex.EncodeToString(sum[:])
+	if recorded := nlb.Status.Certificate; recorded != nil && recorded.SecretName == secretName && recorded.Fingerprint == fingerprint {
+		settings.CertificateNo = recorded.CertificateNo
+		settings.importedCertificate = recorded
+		return nil
+	}
+
+	certificate, chain, err := splitCertificateChain(certPEM)
+	if err != nil {
+		return fmt.Errorf("TLS Secret %s 인증서 파싱 실패: %w", secretName, err)
+	}
+
+	// 이름 끝에 내용 해시를 넣어 갱신된 Secret은 새 인증서로 가져옴 (실패 후 재시도 시 같은 이름으로 찾아 재사용)
+	certificateName := r.generateValidName("k8s-cert", service.Namespace, service.Name, "")
+	if len(certificateName) > 21 {
+		certificateName = strings.TrimSuffix(certificateName[:21], "-")
+	}
+	certificateName += "-" + fingerprint[:8]
+
+	certificates, err := client.GetCertificateList()
+	if err != nil {
+		return fmt.Errorf("인증서 목록 조회 실패: %w", err)
+	}
+	var certificateNo string
+	for _, cert := range certificates {
+		if cert.CertificateName == certificateName {
+			certificateNo = cert.CertificateNo
+			logger.Info("이미 가져온 인증서 재사용", "certificateName", certificateName, "certificateNo", certificateNo)
+			break
+		}
+	}
+	if certificateNo == "" {
+		cert, err := client.ImportCertificate(&navercloud.ImportCertificateRequest{
+			CertificateName:      certificateName,
+			PrivateKey:           string(keyPEM),
+			PublicKeyCertificate: certificate,
+			CertificateChain:     chain,
+		})
+		if err != nil {
+			return fmt.Errorf("인증서 가져오기 실패: %w", err)
+		}
+		certificateNo = cert.CertificateNo
+		logger.Info("TLS Secret을 Certificate Manager로 가져옴", "secret", secretName, "certificateNo", certificateNo)
+	}
+
+	settings.CertificateNo = certificateNo
+	settings.importedCertificate = &naverv1alpha1.CertificateStatus{
+		SecretName:      secretName,
+		Fingerprint:     fingerprint,
+		CertificateNo:   certificateNo,
+		CertificateName: certificateName,
+	}
+	return nil
+}
+
+// splitCertificateChain은 PEM 인증서 묶음을 서버 인증서와 체인으로 나눕니다
+func splitCertificateChain(data []byte) (string, string, error) {
+	var blocks []string
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type == "CERTIFICATE" {
+			blocks = append(blocks, string(pem.EncodeToMemory(block)))
+		}
+	}
+	if len(blocks) == 0 {
+		return "", "", fmt.Errorf("PEM 인증서가 없음")
+	}
+	return blocks[0], strings.Join(blocks[1:], ""), nil
+}
+
+// recordCertificate는 리스너 갱신 후 가져온 인증서를 상태에 기록하고, 더 이상 사용하지 않는 이전 인증서를 삭제합니다.
+// 이전 인증서 삭제에 실패하면 다음 조정에서 다시 시도하도록 상태를 그대로 둡니다
+func (r *ServiceReconciler) recordCertificate(ctx context.Context, client NaverCloudClient, nlb *naverv1alpha1.NaverLoadBalancer, settings *listenerSettings) error {
+	recorded := nlb.Status.Certificate
+	imported := settings.importedCertificate
+	if recorded == nil && imported == nil || recorded != nil && imported != nil && *recorded == *imported {
+		return nil
+	}
+	logger := log.FromContext(ctx)
+
+	if recorded != nil && (imported == nil || recorded.CertificateNo != imported.CertificateNo) {
+		if err := client.DeleteCertificate(recorded.CertificateNo); err != nil {
+			logger.Info("이전 인증서 삭제 실패, 다음 조정에서 재시도", "certificateNo", recorded.CertificateNo, "error", err.Error())
+			return nil
+		}
+		logger.Info("이전 인증서 삭제 완료", "certificateNo", recorded.CertificateNo)
+	}
+
+	return r.updateNaverLoadBalancerStatus(ctx, nlb, func(latest *naverv1alpha1.NaverLoadBalancer) {
+		latest.Status.Certificate = imported
+	})
+}
+
+// mapSecretToServices는 TLS Secret 변경 시 해당 Secret을 참조하는 LoadBalancer Service를 조정 대상으로 반환합니다
+func (r *ServiceReconciler) mapSecretToServices(ctx context.Context, obj client.Object) []reconcile.Request {
+	var services corev1.ServiceList
+	if err := r.List(ctx, &services, client.InNamespace(obj.GetNamespace())); err != nil {
+		log.FromContext(ctx).Error(err, "Secret 변경 처리를 위한 Service 목록 조회 실패")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, service := range services.Items {
+		if service.Spec.Type != corev1.ServiceTypeLoadBalancer || service.Annotations[sslCertificateSecretAnnotation] != obj.GetName() {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: service.Namespace, Name: service.Name}})
+	}
+	return requests
+}
@@ -0,0 +1,264 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("TLS Listener Tests", func() {
+	var (
+		reconciler *ServiceReconciler
+		mockClient *navercloud.MockClient
+		ctx        context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockClient = navercloud.NewMockClient()
+
+		reconciler = &ServiceReconciler{
+			Client: k8sClient,
+			NaverCloudConfig: NaverCloudConfig{
+				APIKey:    "test-api-key",
+				APISecret: "test-api-secret",
+				Region:    "KR",
+				VpcNo:     "vpc-12345",
+				SubnetNo:  "subnet-67890",
+			},
+			NaverClient: mockClient,
+		}
+	})
+
+	Context("When reading the TLS annotations", func() {
+		service := func(annotations map[string]string) *corev1.Service {
+			return &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+				Spec: corev1.ServiceSpec{Ports: []corev1.ServicePort{
+					{Name: "http", Port: 80, Protocol: corev1.ProtocolTCP},
+					{Name: "https", Port: 443, Protocol: corev1.ProtocolTCP},
+					{Name: "dns", Port: 53, Protocol: corev1.ProtocolUDP},
+				}},
+			}
+		}
+
+		It("should select TLS ports and normalize the minimum version", func() {
+			settings, err := parseListenerSettings(service(map[string]string{
+				sslPortsAnnotation:         "https",
+				sslCertificateNoAnnotation: "1234",
+				tlsMinVersionAnnotation:    "TLSv1.3",
+			}), lbTypeApplication)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(settings.TLSPorts).To(Equal(map[int32]bool{443: true}))
+			Expect(settings.CertificateNo).To(Equal("1234"))
+			Expect(settings.TLSMinVersion).To(Equal("TLSV13"))
+
+			https := corev1.ServicePort{Port: 443, Protocol: corev1.ProtocolTCP}
+			http := corev1.ServicePort{Port: 80, Protocol: corev1.ProtocolTCP}
+			Expect(settings.protocol(https)).To(Equal("HTTPS"))
+			Expect(settings.protocol(http)).To(Equal("HTTP"))
+
+			settings.LBType = lbTypeNetworkProxy
+			Expect(settings.protocol(https)).To(Equal("TLS"))
+			Expect(settings.key(https)).To(Equal("443/TLS"))
+
+			settings, err = parseListenerSettings(service(nil), lbTypeNetworkProxy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(settings.TLSPorts).To(BeEmpty())
+			Expect(settings.protocol(https)).To(Equal("TCP"))
+		})
+
+		It("should reject invalid TLS settings", func() {
+			invalid := []struct {
+				lbType      string
+				annotations map[string]string
+			}{
+				{lbTypeNetwork, map[string]string{sslPortsAnnotation: "443", sslCertificateNoAnnotation: "1234"}},
+				{lbTypeNetworkProxy, map[string]string{sslPortsAnnotation: "8443", sslCertificateNoAnnotation: "1234"}},
+				{lbTypeNetworkProxy, map[string]string{sslPortsAnnotation: "dns", sslCertificateNoAnnotation: "1234"}},
+				{lbTypeNetworkProxy, map[string]string{sslPortsAnnotation: "443"}},
+				{lbTypeNetworkProxy, map[string]string{sslPortsAnnotation: "443", sslCertificateNoAnnotation: "1234", sslCertificateSecretAnnotation: "tls"}},
+				{lbTypeNetworkProxy, map[string]string{sslPortsAnnotation: "443", sslCertificateNoAnnotation: "1234", tlsMinVersionAnnotation: "SSLV3"}},
+			}
+			for _, tc := range invalid {
+				_, err := parseListenerSettings(service(tc.annotations), tc.lbType)
+				Expect(err).To(HaveOccurred(), "annotations: %v", tc.annotations)
+			}
+		})
+	})
+
+	Context("When creating a load balancer with a Certificate Manager certificate", func() {
+		It("should create a TLS listener and update it when the certificate changes", func() {
+			service := newService(ctx, "tls-certno-service", map[string]string{
+				sslPortsAnnotation:         "443",
+				sslCertificateNoAnnotation: "1234",
+			}, 443)
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(mockClient.Listeners).To(HaveLen(1))
+			listener := mockClient.Listeners[0]
+			Expect(ncloud.StringValue(listener.ProtocolType.Code)).To(Equal("TLS"))
+			Expect(ncloud.StringValue(listener.SslCertificateNo)).To(Equal("1234"))
+			Expect(ncloud.StringValue(listener.TlsMinVersionType.Code)).To(Equal(defaultTLSMinVersion))
+
+			nlb, err := reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Status.Listeners).To(ConsistOf(HaveField("Protocol", "TLS")))
+
+			By("Changing the certificate of the existing listener in place")
+			service.Annotations[sslCertificateNoAnnotation] = "5678"
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.ChangeListenerCalled).To(Equal(1))
+			Expect(mockClient.DeleteListenerCalled).To(Equal(0))
+			Expect(ncloud.StringValue(mockClient.Listeners[0].SslCertificateNo)).To(Equal("5678"))
+
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.ChangeListenerCalled).To(Equal(1))
+		})
+
+		It("should record an Event for an invalid TLS configuration", func() {
+			recorder := record.NewFakeRecorder(10)
+			reconciler.Recorder = recorder
+
+			service := newService(ctx, "tls-invalid-service", map[string]string{sslPortsAnnotation: "443"}, 443)
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).To(HaveOccurred())
+			Expect(mockClient.CreateTGCalled).To(Equal(0))
+			Expect(recorder.Events).To(Receive(ContainSubstring("InvalidTLSConfiguration")))
+		})
+	})
+
+	Context("When creating a load balancer with a TLS Secret", func() {
+		It("should import the Secret and rotate the certificate when it changes", func() {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "tls-secret", Namespace: "default"},
+				Type:       corev1.SecretTypeTLS,
+				Data:       newTLSSecretData("example.com"),
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			defer func() { Expect(k8sClient.Delete(ctx, secret)).To(Succeed()) }()
+
+			service := newService(ctx, "tls-secret-service", map[string]string{
+				lbTypeAnnotation:               "APPLICATION",
+				sslPortsAnnotation:             "port-443",
+				sslCertificateSecretAnnotation: "tls-secret",
+			}, 443)
+			defer cleanupService(ctx, service)
+
+			_, err := reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.ImportCertCalled).To(Equal(1))
+			Expect(mockClient.Certificates).To(HaveLen(1))
+			imported := mockClient.Certificates[0]
+			Expect(ncloud.StringValue(mockClient.Listeners[0].ProtocolType.Code)).To(Equal("HTTPS"))
+			Expect(ncloud.StringValue(mockClient.Listeners[0].SslCertificateNo)).To(Equal(imported.CertificateNo))
+
+			nlb, err := reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Status.Certificate).NotTo(BeNil())
+			Expect(nlb.Status.Certificate.CertificateNo).To(Equal(imported.CertificateNo))
+			Expect(nlb.Status.Certificate.SecretName).To(Equal("tls-secret"))
+
+			By("Reusing the imported certificate while the Secret is unchanged")
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.ImportCertCalled).To(Equal(1))
+			Expect(mockClient.ChangeListenerCalled).To(Equal(0))
+
+			By("Importing the renewed Secret and deleting the old certificate")
+			secret.Data = newTLSSecretData("example.com")
+			Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+
+			_, err = reconciler.reconcileNaverCloudLB(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mockClient.ImportCertCalled).To(Equal(2))
+			Expect(mockClient.ChangeListenerCalled).To(Equal(1))
+			Expect(mockClient.Certificates).To(HaveLen(1))
+			renewed := mockClient.Certificates[0]
+			Expect(renewed.CertificateNo).NotTo(Equal(imported.CertificateNo))
+			Expect(ncloud.StringValue(mockClient.Listeners[0].SslCertificateNo)).To(Equal(renewed.CertificateNo))
+
+			nlb, err = reconciler.getNaverLoadBalancer(ctx, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nlb.Status.Certificate.CertificateNo).To(Equal(renewed.CertificateNo))
+
+			By("Deleting the imported certificate with the load balancer")
+			Expect(reconciler.deleteNaverCloudLB(ctx, service)).To(Succeed())
+			Expect(mockClient.Certificates).To(BeEmpty())
+		})
+	})
+
+	Context("When a TLS Secret changes", func() {
+		It("should enqueue the Services that reference it", func() {
+			service := newService(ctx, "tls-watch-service", map[string]string{sslCertificateSecretAnnotation: "watched-secret"}, 443)
+			defer cleanupService(ctx, service)
+			other := newService(ctx, "tls-watch-other-service", nil, 443)
+			defer cleanupService(ctx, other)
+
+			secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "watched-secret", Namespace: "default"}}
+			requests := reconciler.mapSecretToServices(ctx, secret)
+			Expect(requests).To(HaveLen(1))
+			Expect(requests[0].Name).To(Equal(service.Name))
+		})
+	})
+})
+
+// newTLSSecretData는 테스트용 자체 서명 인증서와 개인 키로 kubernetes.io/tls Secret 데이터를 만듭니다
+func newTLSSecretData(commonName string) map[string][]byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).NotTo(HaveOccurred())
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	Expect(err).NotTo(HaveOccurred())
+
+	return map[string][]byte{
+		corev1.TLSCertKey:       pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		corev1.TLSPrivateKeyKey: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+}
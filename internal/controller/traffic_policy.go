@@ -0,0 +1,102 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// localHealthCheckPath는 kube-proxy가 HealthCheckNodePort에서 로컬 엔드포인트 유무를 응답하는 경로입니다
+const localHealthCheckPath = "/healthz"
+
+// isLocalTrafficPolicy는 Service가 externalTrafficPolicy: Local인지 확인합니다
+func isLocalTrafficPolicy(service *corev1.Service) bool {
+	return service.Spec.ExternalTrafficPolicy == corev1.ServiceExternalTrafficPolicyLocal
+}
+
+// healthCheckPortForPort는 타겟 그룹이 헬스 체크할 노드 포트를 반환합니다.
+// externalTrafficPolicy: Local이면 HealthCheckNodePort, health-check-port 어노테이션이 있으면 그 포트,
+// UDP 포트는 TCP 헬스 체크 포트, 그 외에는 Service 포트의 NodePort입니다
+func healthCheckPortForPort(service *corev1.Service, port corev1.ServicePort) int32 {
+	if isLocalTrafficPolicy(service) && service.Spec.HealthCheckNodePort != 0 {
+		return service.Spec.HealthCheckNodePort
+	}
+	if value := healthCheckAnnotation(service, port, healthCheckPortAnnotation); value != "" {
+		// 유효성은 validateHealthCheckAnnotations에서 미리 확인
+		healthCheckPort, _ := resolveHealthCheckPort(service, healthCheckPortAnnotation, value)
+		return healthCheckPort
+	}
+	if servicePortProtocol(port) == corev1.ProtocolUDP {
+		// 유효성은 validateHealthCheckPorts에서 미리 확인
+		healthCheckPort, _ := udpHealthCheckPort(service, port)
+		return healthCheckPort
+	}
+	return port.NodePort
+}
+
+// readyEndpointNodes는 Service의 준비된 엔드포인트가 실행 중인 노드 이름 집합을 반환합니다
+func (r *ServiceReconciler) readyEndpointNodes(ctx context.Context, service *corev1.Service) (map[string]bool, error) {
+	var sliceList discoveryv1.EndpointSliceList
+	if err := r.List(ctx, &sliceList,
+		client.InNamespace(service.Namespace),
+		client.MatchingLabels{discoveryv1.LabelServiceName: service.Name}); err != nil {
+		return nil, fmt.Errorf("EndpointSlice 목록 조회 실패: %w", err)
+	}
+
+	nodes := make(map[string]bool)
+	for _, slice := range sliceList.Items {
+		for _, endpoint := range slice.Endpoints {
+			if endpoint.NodeName == nil || *endpoint.NodeName == "" {
+				continue
+			}
+			// Ready가 비어 있으면 준비된 것으로 간주합니다 (EndpointSlice API 규약)
+			if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+				continue
+			}
+			nodes[*endpoint.NodeName] = true
+		}
+	}
+	return nodes, nil
+}
+
+// mapEndpointSliceToService는 EndpointSlice 변경 시 externalTrafficPolicy: Local인 LoadBalancer Service를 조정 대상으로 반환합니다
+func (r *ServiceReconciler) mapEndpointSliceToService(ctx context.Context, obj client.Object) []reconcile.Request {
+	serviceName := obj.GetLabels()[discoveryv1.LabelServiceName]
+	if serviceName == "" {
+		return nil
+	}
+
+	key := types.NamespacedName{Namespace: obj.GetNamespace(), Name: serviceName}
+	var service corev1.Service
+	if err := r.Get(ctx, key, &service); err != nil {
+		log.FromContext(ctx).V(1).Info("EndpointSlice의 Service 조회 실패", "service", key, "error", err.Error())
+		return nil
+	}
+
+	if service.Spec.Type != corev1.ServiceTypeLoadBalancer || !isLocalTrafficPolicy(&service) {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: key}}
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/suslmk-lee/kube-controller01/internal/navercloud"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ = Describe("External Traffic Policy Tests", func() {
+	var (
+		reconciler *ServiceReconciler
+		mockClient *navercloud.MockClient
+		ctx        context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockClient = navercloud.NewMockClient()
+
+		reconciler = &ServiceReconciler{
+			Client: k8sClient,
+			NaverCloudConfig: NaverCloudConfig{
+				APIKey:    "test-api-key",
+				APISecret: "test-api-secret",
+				Region:    "KR",
+				VpcNo:     "vpc-12345",
+				SubnetNo:  "subnet-67890",
+			},
+			NaverClient: mockClient,
+		}
+	})
+
+	newLocalService := func(name string) *corev1.Service {
+		service := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: corev1.ServiceSpec{
+				Type:                  corev1.ServiceTypeLoadBalancer,
+				ExternalTrafficPolicy: corev1.ServiceExternalTrafficPolicyLocal,
+				Ports: []corev1.ServicePort{
+					{Name: "http", Port: 80, TargetPort: intstr.FromInt32(8080), Protocol: corev1.ProtocolTCP},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, service)).To(Succeed())
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: service.Namespace, Name: service.Name}, service)).To(Succeed())
+		Expect(service.Spec.HealthCheckNodePort).NotTo(BeZero())
+		return service
+	}
+
+	createReadyNode := func(name, instanceNo, ip string) *corev1.Node {
+		node := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       corev1.NodeSpec{ProviderID: "ncloud:///KR-1/" + instanceNo},
+		}
+		Expect(k8sClient.Create(ctx, node)).To(Succeed())
+		node.Status = corev1.NodeStatus{
+			Addresses:  []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: ip}},
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+		}
+		Expect(k8sClient.Status().Update(ctx, node)).To(Succeed())
+		return node
+	}
+
+	Context("When choosing the health check port", func() {
+		It("should use the HealthCheckNodePort only for Local services", func() {
+			port := corev1.ServicePort{Port: 80, NodePort: 30080}
+			cluster := &corev1.Service{Spec: corev1.ServiceSpec{ExternalTrafficPolicy: corev1.ServiceExternalTrafficPolicyCluster}}
+			local := &corev1.Service{Spec: corev1.ServiceSpec{
+				ExternalTrafficPolicy: corev1.ServiceExternalTrafficPolicyLocal,
+				HealthCheckNodePort:   32000,
+			}}
+
+			Expect(healthCheckPortForPort(cluster, port)).To(Equal(int32(30080)))
+			Expect(healthCheckPortForPort(local, port)).To(Equal(int32(32000)))
+		})
+
+		It("should create an HTTP health check against the HealthCheckNodePort", func() {
+			service := newLocalService("local-tg-service")
+			defer cleanupService(ctx, service)
+
+			credentials, err := reconciler.getCredentials(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			tgID, err := reconciler.createTargetGroupForPort(ctx, mockClient, credentials, service, service.Spec.Ports[0], "tg-local")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tgID).To(Equal("tg-12345"))
+
+			tg := mockClient.TargetGroups[0]
+			Expect(ncloud.Int32Value(tg.TargetGroupPort)).To(Equal(service.Spec.Ports[0].NodePort))
+			Expect(ncloud.Int32Value(tg.HealthCheckPort)).To(Equal(service.Spec.HealthCheckNodePort))
+			Expect(ncloud.StringValue(tg.HealthCheckProtocolType.Code)).To(Equal("HTTP"))
+		})
+	})
+
+	Context("When a Local service has endpoints on some nodes", func() {
+		It("should only register nodes hosting ready endpoints", func() {
+			service := newLocalService("local-endpoints-service")
+			defer cleanupService(ctx, service)
+
+			withPod := createReadyNode("local-worker-1", "2001", "10.0.2.10")
+			defer func() { Expect(k8sClient.Delete(ctx, withPod)).To(Succeed()) }()
+			notReadyPod := createReadyNode("local-worker-2", "2002", "10.0.2.11")
+			defer func() { Expect(k8sClient.Delete(ctx, notReadyPod)).To(Succeed()) }()
+			withoutPod := createReadyNode("local-worker-3", "2003", "10.0.2.12")
+			defer func() { Expect(k8sClient.Delete(ctx, withoutPod)).To(Succeed()) }()
+
+			ready, notReady := true, false
+			slice := &discoveryv1.EndpointSlice{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      service.Name + "-abc",
+					Namespace: service.Namespace,
+					Labels:    map[string]string{discoveryv1.LabelServiceName: service.Name},
+				},
+				AddressType: discoveryv1.AddressTypeIPv4,
+				Endpoints: []discoveryv1.Endpoint{
+					{Addresses: []string{"10.244.1.5"}, NodeName: &withPod.Name, Conditions: discoveryv1.EndpointConditions{Ready: &ready}},
+					{Addresses: []string{"10.244.2.5"}, NodeName: &notReadyPod.Name, Conditions: discoveryv1.EndpointConditions{Ready: &notReady}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, slice)).To(Succeed())
+			defer func() { Expect(k8sClient.Delete(ctx, slice)).To(Succeed()) }()
+
+			instanceNos, complete, err := reconciler.targetInstanceNos(ctx, mockClient, service)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(complete).To(BeTrue())
+			Expect(instanceNos).To(ConsistOf("2001"))
+
+			By("Falling back to every ready node for Cluster services")
+			instanceNos, _, err = reconciler.targetInstanceNos(ctx, mockClient, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instanceNos).To(ContainElements("2001", "2002", "2003"))
+
+			By("Enqueueing the Service for EndpointSlice changes")
+			Expect(reconciler.mapEndpointSliceToService(ctx, slice)).To(ConsistOf(
+				HaveField("NamespacedName", types.NamespacedName{Namespace: service.Namespace, Name: service.Name}),
+			))
+		})
+
+		It("should ignore EndpointSlices of Cluster services", func() {
+			service := newService(ctx, "cluster-endpoints-service", nil, 80)
+			defer cleanupService(ctx, service)
+
+			slice := &discoveryv1.EndpointSlice{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: service.Namespace,
+					Labels:    map[string]string{discoveryv1.LabelServiceName: service.Name},
+				},
+			}
+			Expect(reconciler.mapEndpointSliceToService(ctx, slice)).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,202 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package navercloud
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Certificate Manager API 경로 (SDK가 없어 REST API를 직접 호출합니다)
+const (
+	certificateListPath   = "/api/v1/certificate/withCertInfo"
+	certificateImportPath = "/api/v1/certificate/external"
+	certificateDeletePath = "/api/v1/certificate/"
+)
+
+// errCertificateClientNotConfigured는 RealClient에 Certificate Manager 클라이언트가 없을 때 반환됩니다.
+var errCertificateClientNotConfigured = errors.New("Certificate Manager 클라이언트가 설정되지 않음")
+
+// Certificate는 Certificate Manager에 등록된 인증서입니다.
+type Certificate struct {
+	CertificateNo   string
+	CertificateName string
+	StatusCode      string
+}
+
+// ImportCertificateRequest는 외부 인증서(예: Kubernetes TLS Secret) 등록 요청입니다.
+type ImportCertificateRequest struct {
+	CertificateName      string `json:"certificateName"`
+	PrivateKey           string `json:"privateKey"`
+	PublicKeyCertificate string `json:"publicKeyCertificate"`
+	CertificateChain     string `json:"certificateChain,omitempty"`
+}
+
+// certificateResponse는 Certificate Manager API 응답 형식입니다.
+type certificateResponse struct {
+	ReturnCode      json.Number `json:"returnCode"`
+	ReturnMessage   string      `json:"returnMessage"`
+	CertificateList []struct {
+		CertificateNo   json.Number `json:"certificateNo"`
+		CertificateName string      `json:"certificateName"`
+		StatusCode      string      `json:"statusCode"`
+	} `json:"certificateList"`
+}
+
+// err는 HTTP 200으로 전달된 API 오류를 반환합니다.
+func (r *certificateResponse) err() error {
+	if code := r.ReturnCode.String(); code != "" && code != "0" {
+		return fmt.Errorf("Certificate Manager API 오류 (%s): %s", code, r.ReturnMessage)
+	}
+	return nil
+}
+
+// certificates는 응답의 인증서 목록을 변환합니다.
+func (r *certificateResponse) certificates() []Certificate {
+	certificates := make([]Certificate, 0, len(r.CertificateList))
+	for _, cert := range r.CertificateList {
+		certificates = append(certificates, Certificate{
+			CertificateNo:   cert.CertificateNo.String(),
+			CertificateName: cert.CertificateName,
+			StatusCode:      cert.StatusCode,
+		})
+	}
+	return certificates
+}
+
+// CertificateManagerClient는 API Gateway 서명(v2)으로 Certificate Manager REST API를 호출합니다.
+type CertificateManagerClient struct {
+	BaseURL    string
+	AccessKey  string
+	SecretKey  string
+	HTTPClient *http.Client
+}
+
+// NewCertificateManagerClient는 Certificate Manager API 클라이언트를 생성합니다.
+func NewCertificateManagerClient(accessKey, secretKey, baseURL string) *CertificateManagerClient {
+	return &CertificateManagerClient{
+		BaseURL:    baseURL,
+		AccessKey:  accessKey,
+		SecretKey:  secretKey,
+		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// GetCertificateList는 등록된 인증서 목록을 조회합니다.
+func (c *CertificateManagerClient) GetCertificateList() ([]Certificate, error) {
+	var resp certificateResponse
+	if err := c.do(http.MethodGet, certificateListPath, nil, &resp); err != nil {
+		return nil, err
+	}
+	if err := resp.err(); err != nil {
+		return nil, err
+	}
+	return resp.certificates(), nil
+}
+
+// ImportCertificate는 외부 인증서를 등록합니다.
+func (c *CertificateManagerClient) ImportCertificate(req *ImportCertificateRequest) (*Certificate, error) {
+	var resp certificateResponse
+	if err := c.do(http.MethodPost, certificateImportPath, req, &resp); err != nil {
+		return nil, err
+	}
+	if err := resp.err(); err != nil {
+		return nil, err
+	}
+	certificates := resp.certificates()
+	if len(certificates) == 0 {
+		return nil, fmt.Errorf("인증서 등록 응답에 인증서가 없음")
+	}
+	return &certificates[0], nil
+}
+
+// DeleteCertificate는 인증서를 삭제합니다.
+func (c *CertificateManagerClient) DeleteCertificate(certificateNo string) error {
+	var resp certificateResponse
+	if err := c.do(http.MethodDelete, certificateDeletePath+url.PathEscape(certificateNo), nil, &resp); err != nil {
+		return err
+	}
+	return resp.err()
+}
+
+// do는 서명된 요청을 보내고 JSON 응답을 out에 디코딩합니다.
+func (c *CertificateManagerClient) do(method, path string, body, out interface{}) error {
+	endpoint, err := url.Parse(c.BaseURL + path)
+	if err != nil {
+		return fmt.Errorf("Certificate Manager URL 파싱 실패: %w", err)
+	}
+
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("요청 직렬화 실패: %w", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequest(method, endpoint.String(), reader)
+	if err != nil {
+		return fmt.Errorf("요청 생성 실패: %w", err)
+	}
+	timestamp := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	req.Header.Set("x-ncp-apigw-timestamp", timestamp)
+	req.Header.Set("x-ncp-iam-access-key", c.AccessKey)
+	req.Header.Set("x-ncp-apigw-signature-v2", signRequest(method, endpoint.RequestURI(), timestamp, c.AccessKey, c.SecretKey))
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("Certificate Manager API 호출 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("응답 읽기 실패: %w", err)
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("Certificate Manager API 오류 (HTTP %d): %s", resp.StatusCode, string(data))
+	}
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("응답 파싱 실패: %w", err)
+	}
+	return nil
+}
+
+// signRequest는 네이버 클라우드 API Gateway 서명(v2)을 계산합니다.
+// 서명 대상 문자열은 "METHOD URI\ntimestamp\naccessKey" 입니다.
+func signRequest(method, requestURI, timestamp, accessKey, secretKey string) string {
+	mac := hmac.New(sha256.New, []byte(secretKey))
+	mac.Write([]byte(method + " " + requestURI + "\n" + timestamp + "\n" + accessKey))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
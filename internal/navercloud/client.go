@@ -17,7 +17,9 @@ limitations under the License.
 package navercloud
 
 import (
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 )
 
@@ -28,33 +30,90 @@ type Client interface {
 	CreateLoadBalancerInstance(req *vloadbalancer.CreateLoadBalancerInstanceRequest) (*vloadbalancer.CreateLoadBalancerInstanceResponse, error)
 	GetLoadBalancerInstanceList(req *vloadbalancer.GetLoadBalancerInstanceListRequest) (*vloadbalancer.GetLoadBalancerInstanceListResponse, error)
 	DeleteLoadBalancerInstances(req *vloadbalancer.DeleteLoadBalancerInstancesRequest) (*vloadbalancer.DeleteLoadBalancerInstancesResponse, error)
+	GetLoadBalancerInstanceDetail(req *vloadbalancer.GetLoadBalancerInstanceDetailRequest) (*vloadbalancer.GetLoadBalancerInstanceDetailResponse, error)
+	ChangeLoadBalancerInstanceConfiguration(req *vloadbalancer.ChangeLoadBalancerInstanceConfigurationRequest) (*vloadbalancer.ChangeLoadBalancerInstanceConfigurationResponse, error)
+	SetLoadBalancerDescription(req *vloadbalancer.SetLoadBalancerDescriptionRequest) (*vloadbalancer.SetLoadBalancerDescriptionResponse, error)
 
 	// Target Group 관련
 	CreateTargetGroup(req *vloadbalancer.CreateTargetGroupRequest) (*vloadbalancer.CreateTargetGroupResponse, error)
 	DeleteTargetGroups(req *vloadbalancer.DeleteTargetGroupsRequest) (*vloadbalancer.DeleteTargetGroupsResponse, error)
 	GetTargetGroupList(req *vloadbalancer.GetTargetGroupListRequest) (*vloadbalancer.GetTargetGroupListResponse, error)
+	GetTargetGroupDetail(req *vloadbalancer.GetTargetGroupDetailRequest) (*vloadbalancer.GetTargetGroupDetailResponse, error)
+	ChangeTargetGroupConfiguration(req *vloadbalancer.ChangeTargetGroupConfigurationRequest) (*vloadbalancer.ChangeTargetGroupConfigurationResponse, error)
+	ChangeTargetGroupHealthCheckConfiguration(req *vloadbalancer.ChangeTargetGroupHealthCheckConfigurationRequest) (*vloadbalancer.ChangeTargetGroupHealthCheckConfigurationResponse, error)
+	SetTargetGroupDescription(req *vloadbalancer.SetTargetGroupDescriptionRequest) (*vloadbalancer.SetTargetGroupDescriptionResponse, error)
 
 	// Listener 관련
 	CreateLoadBalancerListener(req *vloadbalancer.CreateLoadBalancerListenerRequest) (*vloadbalancer.CreateLoadBalancerListenerResponse, error)
+	GetLoadBalancerListenerList(req *vloadbalancer.GetLoadBalancerListenerListRequest) (*vloadbalancer.GetLoadBalancerListenerListResponse, error)
+	DeleteLoadBalancerListeners(req *vloadbalancer.DeleteLoadBalancerListenersRequest) (*vloadbalancer.DeleteLoadBalancerListenersResponse, error)
+	ChangeLoadBalancerListenerConfiguration(req *vloadbalancer.ChangeLoadBalancerListenerConfigurationRequest) (*vloadbalancer.ChangeLoadBalancerListenerConfigurationResponse, error)
 
 	// Target 관련
 	AddTarget(req *vloadbalancer.AddTargetRequest) (*vloadbalancer.AddTargetResponse, error)
+	RemoveTarget(req *vloadbalancer.RemoveTargetRequest) (*vloadbalancer.RemoveTargetResponse, error)
+	GetTargetList(req *vloadbalancer.GetTargetListRequest) (*vloadbalancer.GetTargetListResponse, error)
 
 	// Server 관련
 	GetServerInstanceList(req *vserver.GetServerInstanceListRequest) (*vserver.GetServerInstanceListResponse, error)
+	GetNetworkInterfaceList(req *vserver.GetNetworkInterfaceListRequest) (*vserver.GetNetworkInterfaceListResponse, error)
+	GetAccessControlGroupRuleList(req *vserver.GetAccessControlGroupRuleListRequest) (*vserver.GetAccessControlGroupRuleListResponse, error)
+	AddAccessControlGroupInboundRule(req *vserver.AddAccessControlGroupInboundRuleRequest) (*vserver.AddAccessControlGroupInboundRuleResponse, error)
+	RemoveAccessControlGroupInboundRule(req *vserver.RemoveAccessControlGroupInboundRuleRequest) (*vserver.RemoveAccessControlGroupInboundRuleResponse, error)
+	GetPublicIpInstanceList(req *vserver.GetPublicIpInstanceListRequest) (*vserver.GetPublicIpInstanceListResponse, error)
+
+	// VPC 관련
+	GetSubnetList(req *vpc.GetSubnetListRequest) (*vpc.GetSubnetListResponse, error)
+	GetNetworkAclRuleList(req *vpc.GetNetworkAclRuleListRequest) (*vpc.GetNetworkAclRuleListResponse, error)
+	AddNetworkAclInboundRule(req *vpc.AddNetworkAclInboundRuleRequest) (*vpc.AddNetworkAclInboundRuleResponse, error)
+	RemoveNetworkAclInboundRule(req *vpc.RemoveNetworkAclInboundRuleRequest) (*vpc.RemoveNetworkAclInboundRuleResponse, error)
+
+	// Certificate Manager 관련
+	GetCertificateList() ([]Certificate, error)
+	ImportCertificate(req *ImportCertificateRequest) (*Certificate, error)
+	DeleteCertificate(certificateNo string) error
 }
 
 // RealClient는 실제 네이버 클라우드 API를 호출하는 클라이언트입니다.
 type RealClient struct {
 	VLoadBalancerClient *vloadbalancer.APIClient
 	VServerClient       *vserver.APIClient
+	VPCClient           *vpc.APIClient
+	// CertificateClient는 Certificate Manager API 클라이언트입니다 (없으면 인증서 API 호출이 실패합니다)
+	CertificateClient *CertificateManagerClient
 }
 
 // NewRealClient는 실제 네이버 클라우드 API 클라이언트를 생성합니다.
-func NewRealClient(lbClient *vloadbalancer.APIClient, serverClient *vserver.APIClient) Client {
+func NewRealClient(lbClient *vloadbalancer.APIClient, serverClient *vserver.APIClient, vpcClient *vpc.APIClient) Client {
 	return &RealClient{
 		VLoadBalancerClient: lbClient,
 		VServerClient:       serverClient,
+		VPCClient:           vpcClient,
+	}
+}
+
+// NewRealClientWithAPIKey는 API 키와 API Gateway 기본 URL로 LoadBalancer/Server/VPC SDK 클라이언트를 구성하여 실제 클라이언트를 생성합니다.
+// baseURL은 ResolveEndpoint로 결정한 값이며, 모든 SDK 클라이언트가 같은 엔드포인트를 공유합니다.
+func NewRealClientWithAPIKey(accessKey, secretKey, baseURL string) Client {
+	apiKeys := &ncloud.APIKey{
+		AccessKey: accessKey,
+		SecretKey: secretKey,
+	}
+
+	lbConfig := vloadbalancer.NewConfiguration(apiKeys)
+	lbConfig.BasePath = baseURL + vloadbalancerPath
+
+	serverConfig := vserver.NewConfiguration(apiKeys)
+	serverConfig.BasePath = baseURL + vserverPath
+
+	vpcConfig := vpc.NewConfiguration(apiKeys)
+	vpcConfig.BasePath = baseURL + vpcPath
+
+	return &RealClient{
+		VLoadBalancerClient: vloadbalancer.NewAPIClient(lbConfig),
+		VServerClient:       vserver.NewAPIClient(serverConfig),
+		VPCClient:           vpc.NewAPIClient(vpcConfig),
+		CertificateClient:   NewCertificateManagerClient(accessKey, secretKey, CertificateManagerEndpoint(baseURL)),
 	}
 }
 
@@ -73,6 +132,21 @@ func (c *RealClient) DeleteLoadBalancerInstances(req *vloadbalancer.DeleteLoadBa
 	return c.VLoadBalancerClient.V2Api.DeleteLoadBalancerInstances(req)
 }
 
+// GetLoadBalancerInstanceDetail은 로드밸런서 인스턴스 상세 정보를 조회합니다.
+func (c *RealClient) GetLoadBalancerInstanceDetail(req *vloadbalancer.GetLoadBalancerInstanceDetailRequest) (*vloadbalancer.GetLoadBalancerInstanceDetailResponse, error) {
+	return c.VLoadBalancerClient.V2Api.GetLoadBalancerInstanceDetail(req)
+}
+
+// ChangeLoadBalancerInstanceConfiguration은 로드밸런서 유휴 타임아웃과 처리량 타입을 변경합니다.
+func (c *RealClient) ChangeLoadBalancerInstanceConfiguration(req *vloadbalancer.ChangeLoadBalancerInstanceConfigurationRequest) (*vloadbalancer.ChangeLoadBalancerInstanceConfigurationResponse, error) {
+	return c.VLoadBalancerClient.V2Api.ChangeLoadBalancerInstanceConfiguration(req)
+}
+
+// SetLoadBalancerDescription은 로드밸런서 설명을 변경합니다.
+func (c *RealClient) SetLoadBalancerDescription(req *vloadbalancer.SetLoadBalancerDescriptionRequest) (*vloadbalancer.SetLoadBalancerDescriptionResponse, error) {
+	return c.VLoadBalancerClient.V2Api.SetLoadBalancerDescription(req)
+}
+
 // CreateTargetGroup은 타겟 그룹을 생성합니다.
 func (c *RealClient) CreateTargetGroup(req *vloadbalancer.CreateTargetGroupRequest) (*vloadbalancer.CreateTargetGroupResponse, error) {
 	return c.VLoadBalancerClient.V2Api.CreateTargetGroup(req)
@@ -88,17 +162,131 @@ func (c *RealClient) GetTargetGroupList(req *vloadbalancer.GetTargetGroupListReq
 	return c.VLoadBalancerClient.V2Api.GetTargetGroupList(req)
 }
 
+// GetTargetGroupDetail은 타겟 그룹 상세 정보를 조회합니다.
+func (c *RealClient) GetTargetGroupDetail(req *vloadbalancer.GetTargetGroupDetailRequest) (*vloadbalancer.GetTargetGroupDetailResponse, error) {
+	return c.VLoadBalancerClient.V2Api.GetTargetGroupDetail(req)
+}
+
+// ChangeTargetGroupConfiguration은 타겟 그룹의 알고리즘과 세션 유지 설정을 변경합니다.
+func (c *RealClient) ChangeTargetGroupConfiguration(req *vloadbalancer.ChangeTargetGroupConfigurationRequest) (*vloadbalancer.ChangeTargetGroupConfigurationResponse, error) {
+	return c.VLoadBalancerClient.V2Api.ChangeTargetGroupConfiguration(req)
+}
+
+// ChangeTargetGroupHealthCheckConfiguration은 타겟 그룹 헬스 체크 설정을 변경합니다.
+func (c *RealClient) ChangeTargetGroupHealthCheckConfiguration(req *vloadbalancer.ChangeTargetGroupHealthCheckConfigurationRequest) (*vloadbalancer.ChangeTargetGroupHealthCheckConfigurationResponse, error) {
+	return c.VLoadBalancerClient.V2Api.ChangeTargetGroupHealthCheckConfiguration(req)
+}
+
+// SetTargetGroupDescription은 타겟 그룹 설명을 변경합니다.
+func (c *RealClient) SetTargetGroupDescription(req *vloadbalancer.SetTargetGroupDescriptionRequest) (*vloadbalancer.SetTargetGroupDescriptionResponse, error) {
+	return c.VLoadBalancerClient.V2Api.SetTargetGroupDescription(req)
+}
+
 // CreateLoadBalancerListener는 로드밸런서 리스너를 생성합니다.
 func (c *RealClient) CreateLoadBalancerListener(req *vloadbalancer.CreateLoadBalancerListenerRequest) (*vloadbalancer.CreateLoadBalancerListenerResponse, error) {
 	return c.VLoadBalancerClient.V2Api.CreateLoadBalancerListener(req)
 }
 
+// GetLoadBalancerListenerList는 로드밸런서 리스너 목록을 조회합니다.
+func (c *RealClient) GetLoadBalancerListenerList(req *vloadbalancer.GetLoadBalancerListenerListRequest) (*vloadbalancer.GetLoadBalancerListenerListResponse, error) {
+	return c.VLoadBalancerClient.V2Api.GetLoadBalancerListenerList(req)
+}
+
+// DeleteLoadBalancerListeners는 로드밸런서 리스너를 삭제합니다.
+func (c *RealClient) DeleteLoadBalancerListeners(req *vloadbalancer.DeleteLoadBalancerListenersRequest) (*vloadbalancer.DeleteLoadBalancerListenersResponse, error) {
+	return c.VLoadBalancerClient.V2Api.DeleteLoadBalancerListeners(req)
+}
+
+// ChangeLoadBalancerListenerConfiguration은 리스너 설정(인증서, TLS 최소 버전 등)을 변경합니다.
+func (c *RealClient) ChangeLoadBalancerListenerConfiguration(req *vloadbalancer.ChangeLoadBalancerListenerConfigurationRequest) (*vloadbalancer.ChangeLoadBalancerListenerConfigurationResponse, error) {
+	return c.VLoadBalancerClient.V2Api.ChangeLoadBalancerListenerConfiguration(req)
+}
+
 // AddTarget은 타겟 그룹에 타겟을 추가합니다.
 func (c *RealClient) AddTarget(req *vloadbalancer.AddTargetRequest) (*vloadbalancer.AddTargetResponse, error) {
 	return c.VLoadBalancerClient.V2Api.AddTarget(req)
 }
 
+// RemoveTarget은 타겟 그룹에서 타겟을 제거합니다.
+func (c *RealClient) RemoveTarget(req *vloadbalancer.RemoveTargetRequest) (*vloadbalancer.RemoveTargetResponse, error) {
+	return c.VLoadBalancerClient.V2Api.RemoveTarget(req)
+}
+
+// GetTargetList는 타겟 그룹에 등록된 타겟 목록을 조회합니다.
+func (c *RealClient) GetTargetList(req *vloadbalancer.GetTargetListRequest) (*vloadbalancer.GetTargetListResponse, error) {
+	return c.VLoadBalancerClient.V2Api.GetTargetList(req)
+}
+
 // GetServerInstanceList는 서버 인스턴스 목록을 조회합니다.
 func (c *RealClient) GetServerInstanceList(req *vserver.GetServerInstanceListRequest) (*vserver.GetServerInstanceListResponse, error) {
 	return c.VServerClient.V2Api.GetServerInstanceList(req)
 }
+
+// GetNetworkInterfaceList는 네트워크 인터페이스 목록을 조회합니다.
+func (c *RealClient) GetNetworkInterfaceList(req *vserver.GetNetworkInterfaceListRequest) (*vserver.GetNetworkInterfaceListResponse, error) {
+	return c.VServerClient.V2Api.GetNetworkInterfaceList(req)
+}
+
+// GetAccessControlGroupRuleList는 ACG 규칙 목록을 조회합니다.
+func (c *RealClient) GetAccessControlGroupRuleList(req *vserver.GetAccessControlGroupRuleListRequest) (*vserver.GetAccessControlGroupRuleListResponse, error) {
+	return c.VServerClient.V2Api.GetAccessControlGroupRuleList(req)
+}
+
+// AddAccessControlGroupInboundRule은 ACG에 인바운드 규칙을 추가합니다.
+func (c *RealClient) AddAccessControlGroupInboundRule(req *vserver.AddAccessControlGroupInboundRuleRequest) (*vserver.AddAccessControlGroupInboundRuleResponse, error) {
+	return c.VServerClient.V2Api.AddAccessControlGroupInboundRule(req)
+}
+
+// RemoveAccessControlGroupInboundRule은 ACG에서 인바운드 규칙을 제거합니다.
+func (c *RealClient) RemoveAccessControlGroupInboundRule(req *vserver.RemoveAccessControlGroupInboundRuleRequest) (*vserver.RemoveAccessControlGroupInboundRuleResponse, error) {
+	return c.VServerClient.V2Api.RemoveAccessControlGroupInboundRule(req)
+}
+
+// GetPublicIpInstanceList는 공인 IP 인스턴스 목록을 조회합니다.
+func (c *RealClient) GetPublicIpInstanceList(req *vserver.GetPublicIpInstanceListRequest) (*vserver.GetPublicIpInstanceListResponse, error) {
+	return c.VServerClient.V2Api.GetPublicIpInstanceList(req)
+}
+
+// GetSubnetList는 서브넷 목록을 조회합니다.
+func (c *RealClient) GetSubnetList(req *vpc.GetSubnetListRequest) (*vpc.GetSubnetListResponse, error) {
+	return c.VPCClient.V2Api.GetSubnetList(req)
+}
+
+// GetNetworkAclRuleList는 Network ACL 규칙 목록을 조회합니다.
+func (c *RealClient) GetNetworkAclRuleList(req *vpc.GetNetworkAclRuleListRequest) (*vpc.GetNetworkAclRuleListResponse, error) {
+	return c.VPCClient.V2Api.GetNetworkAclRuleList(req)
+}
+
+// AddNetworkAclInboundRule은 Network ACL에 인바운드 규칙을 추가합니다.
+func (c *RealClient) AddNetworkAclInboundRule(req *vpc.AddNetworkAclInboundRuleRequest) (*vpc.AddNetworkAclInboundRuleResponse, error) {
+	return c.VPCClient.V2Api.AddNetworkAclInboundRule(req)
+}
+
+// RemoveNetworkAclInboundRule은 Network ACL에서 인바운드 규칙을 제거합니다.
+func (c *RealClient) RemoveNetworkAclInboundRule(req *vpc.RemoveNetworkAclInboundRuleRequest) (*vpc.RemoveNetworkAclInboundRuleResponse, error) {
+	return c.VPCClient.V2Api.RemoveNetworkAclInboundRule(req)
+}
+
+// GetCertificateList는 Certificate Manager에 등록된 인증서 목록을 조회합니다.
+func (c *RealClient) GetCertificateList() ([]Certificate, error) {
+	if c.CertificateClient == nil {
+		return nil, errCertificateClientNotConfigured
+	}
+	return c.CertificateClient.GetCertificateList()
+}
+
+// ImportCertificate는 외부 인증서를 Certificate Manager에 등록합니다.
+func (c *RealClient) ImportCertificate(req *ImportCertificateRequest) (*Certificate, error) {
+	if c.CertificateClient == nil {
+		return nil, errCertificateClientNotConfigured
+	}
+	return c.CertificateClient.ImportCertificate(req)
+}
+
+// DeleteCertificate는 Certificate Manager에서 인증서를 삭제합니다.
+func (c *RealClient) DeleteCertificate(certificateNo string) error {
+	if c.CertificateClient == nil {
+		return errCertificateClientNotConfigured
+	}
+	return c.CertificateClient.DeleteCertificate(certificateNo)
+}
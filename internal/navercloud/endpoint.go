@@ -0,0 +1,102 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package navercloud
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// 엔드포인트 프로파일 상수
+const (
+	// EndpointProfilePublic은 민간(commercial) 리전 엔드포인트입니다
+	EndpointProfilePublic = "public"
+	// EndpointProfileGov는 공공기관용 리전 엔드포인트입니다 (기본값)
+	EndpointProfileGov = "gov"
+	// EndpointProfileFin은 금융 리전 엔드포인트입니다
+	EndpointProfileFin = "fin"
+	// EndpointProfileCustom은 사용자가 지정한 URL을 사용합니다
+	EndpointProfileCustom = "custom"
+
+	// DefaultEndpointProfile은 프로파일이 지정되지 않았을 때 사용하는 기본값입니다
+	DefaultEndpointProfile = EndpointProfileGov
+)
+
+// endpointProfiles는 프로파일별 API Gateway 기본 URL입니다
+var endpointProfiles = map[string]string{
+	EndpointProfilePublic: "https://ncloud.apigw.ntruss.com",
+	EndpointProfileGov:    "https://ncloud.apigw.gov-ntruss.com",
+	EndpointProfileFin:    "https://fin-ncloud.apigw.fin-ntruss.com",
+}
+
+// 서비스별 API 경로
+const (
+	vloadbalancerPath = "/vloadbalancer/v2"
+	vserverPath       = "/vserver/v2"
+	vpcPath           = "/vpc/v2"
+
+	// certificateManagerPath는 사용자 지정 URL에서 Certificate Manager API의 경로 접두사입니다
+	certificateManagerPath = "/certificatemanager"
+)
+
+// certificateManagerEndpoints는 프로파일 기본 URL별 Certificate Manager API 기본 URL입니다
+var certificateManagerEndpoints = map[string]string{
+	"https://ncloud.apigw.ntruss.com":         "https://certificatemanager.apigw.ntruss.com",
+	"https://ncloud.apigw.gov-ntruss.com":     "https://certificatemanager.apigw.gov-ntruss.com",
+	"https://fin-ncloud.apigw.fin-ntruss.com": "https://fin-certificatemanager.apigw.fin-ntruss.com",
+}
+
+// CertificateManagerEndpoint는 API Gateway 기본 URL에 대응하는 Certificate Manager API 기본 URL을 반환합니다.
+// Certificate Manager는 별도 호스트를 사용하며, 사용자 지정 URL이면 경로 접두사를 붙입니다.
+func CertificateManagerEndpoint(baseURL string) string {
+	if endpoint, ok := certificateManagerEndpoints[baseURL]; ok {
+		return endpoint
+	}
+	return baseURL + certificateManagerPath
+}
+
+// ResolveEndpoint는 프로파일과 사용자 지정 URL로 API Gateway 기본 URL을 결정합니다.
+// customURL이 지정되면 프로파일보다 우선하며, 프로파일이 비어 있으면 gov 프로파일을 사용합니다.
+func ResolveEndpoint(profile, customURL string) (string, error) {
+	if customURL != "" {
+		parsed, err := url.Parse(customURL)
+		if err != nil {
+			return "", fmt.Errorf("API 엔드포인트 URL 파싱 실패: %w", err)
+		}
+		if parsed.Scheme != "http" && parsed.Scheme != "https" || parsed.Host == "" {
+			return "", fmt.Errorf("잘못된 API 엔드포인트 URL: %s", customURL)
+		}
+		return strings.TrimRight(customURL, "/"), nil
+	}
+
+	if profile == "" {
+		profile = DefaultEndpointProfile
+	}
+
+	profile = strings.ToLower(profile)
+	if profile == EndpointProfileCustom {
+		return "", fmt.Errorf("custom 엔드포인트 프로파일에는 API 엔드포인트 URL이 필요합니다")
+	}
+
+	baseURL, ok := endpointProfiles[profile]
+	if !ok {
+		return "", fmt.Errorf("지원하지 않는 엔드포인트 프로파일: %s (public, gov, fin, custom 중 선택)", profile)
+	}
+
+	return baseURL, nil
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Certificate Manager REST API 경로 (사용자 지정 URL에서는 /certificatemanager 접두사가 붙음)
+const (
+	certificateManagerPrefix = "/certificatemanager/api/v1/certificate"
+	certificateListPath      = "/withCertInfo"
+	certificateImportPath    = "/external"
+)
+
+// certificate는 Certificate Manager에 등록된 인증서입니다
+type certificate struct {
+	no   string
+	name string
+}
+
+// certificateItem은 Certificate Manager 응답의 인증서 항목입니다
+type certificateItem struct {
+	CertificateNo   json.Number `json:"certificateNo"`
+	CertificateName string      `json:"certificateName"`
+	StatusCode      string      `json:"statusCode"`
+}
+
+// certificateResponse는 Certificate Manager 응답 형식입니다 (vloadbalancer와 달리 액션 이름으로 감싸지 않음)
+type certificateResponse struct {
+	ReturnCode      string            `json:"returnCode"`
+	ReturnMessage   string            `json:"returnMessage"`
+	CertificateList []certificateItem `json:"certificateList"`
+}
+
+// AddCertificate는 등록된 인증서를 추가합니다
+func (s *Server) AddCertificate(certificateNo, name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.certificates[certificateNo] = &certificate{no: certificateNo, name: name}
+}
+
+// isCertificateManagerPath는 Certificate Manager REST API 경로인지 확인합니다
+func isCertificateManagerPath(path string) bool {
+	return strings.Contains(path, certificateManagerPrefix)
+}
+
+// serveCertificateManager는 인증서 조회(GET withCertInfo), 외부 인증서 등록(POST external),
+// 삭제(DELETE {certificateNo}) 요청을 처리합니다
+func (s *Server) serveCertificateManager(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.Path[strings.Index(r.URL.Path, certificateManagerPrefix)+len(certificateManagerPrefix):]
+
+	var action string
+	switch {
+	case r.Method == http.MethodGet && path == certificateListPath:
+		action = "getCertificateList"
+	case r.Method == http.MethodPost && path == certificateImportPath:
+		action = "importCertificate"
+	case r.Method == http.MethodDelete && strings.HasPrefix(path, "/") && len(path) > 1:
+		action = "deleteCertificate"
+	default:
+		writeUnsupported(w, r)
+		return
+	}
+
+	var body []byte
+	if r.Body != nil {
+		var err error
+		if body, err = io.ReadAll(r.Body); err != nil {
+			writeCertificateResponse(w, http.StatusBadRequest, codeInvalidParameter, err.Error(), nil)
+			return
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests[action]++
+	s.advance()
+
+	switch action {
+	case "getCertificateList":
+		var list []*certificate
+		for _, no := range sortedKeys(s.certificates) {
+			list = append(list, s.certificates[no])
+		}
+		writeCertificateResponse(w, http.StatusOK, "0", "success", list)
+	case "importCertificate":
+		var req struct {
+			CertificateName      string `json:"certificateName"`
+			PrivateKey           string `json:"privateKey"`
+			PublicKeyCertificate string `json:"publicKeyCertificate"`
+		}
+		if err := json.Unmarshal(body, &req); err != nil {
+			writeCertificateResponse(w, http.StatusBadRequest, codeInvalidParameter, "JSON 본문 파싱 실패: "+err.Error(), nil)
+			return
+		}
+		if req.CertificateName == "" || req.PrivateKey == "" || req.PublicKeyCertificate == "" {
+			writeCertificateResponse(w, http.StatusBadRequest, codeInvalidParameter, "certificateName, privateKey, publicKeyCertificate are required", nil)
+			return
+		}
+		for _, cert := range s.certificates {
+			if cert.name == req.CertificateName {
+				writeCertificateResponse(w, http.StatusBadRequest, CodeDuplicateName, "Duplicate certificate name.", nil)
+				return
+			}
+		}
+		cert := &certificate{no: s.nextNo(), name: req.CertificateName}
+		s.certificates[cert.no] = cert
+		writeCertificateResponse(w, http.StatusOK, "0", "success", []*certificate{cert})
+	case "deleteCertificate":
+		no := strings.TrimPrefix(path, "/")
+		cert, ok := s.certificates[no]
+		if !ok {
+			writeCertificateResponse(w, http.StatusBadRequest, codeNotFound, "Certificate not found: "+no, nil)
+			return
+		}
+		delete(s.certificates, no)
+		writeCertificateResponse(w, http.StatusOK, "0", "success", []*certificate{cert})
+	}
+}
+
+// writeCertificateResponse는 Certificate Manager 응답을 작성합니다
+func writeCertificateResponse(w http.ResponseWriter, status int, code, message string, list []*certificate) {
+	resp := certificateResponse{ReturnCode: code, ReturnMessage: message, CertificateList: []certificateItem{}}
+	for _, cert := range list {
+		resp.CertificateList = append(resp.CertificateList, certificateItem{
+			CertificateNo:   json.Number(cert.no),
+			CertificateName: cert.name,
+			StatusCode:      "ISSUED",
+		})
+	}
+	body, err := json.Marshal(resp)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
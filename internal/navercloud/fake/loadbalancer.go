@@ -0,0 +1,1023 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+)
+
+// 로드밸런서 상태 코드
+const (
+	LBStatusInit        = "INIT"
+	LBStatusCreating    = "CREATING"
+	LBStatusUsed        = "USED"
+	LBStatusTerminating = "TERMINATING"
+)
+
+// lbPhase는 로드밸런서의 내부 상태입니다
+type lbPhase int
+
+const (
+	lbPhaseInit lbPhase = iota
+	lbPhaseCreating
+	lbPhaseRunning
+	lbPhaseChanging
+	lbPhaseTerminating
+)
+
+// loadBalancer는 로드밸런서 인스턴스 상태입니다
+type loadBalancer struct {
+	no              string
+	name            string
+	description     string
+	typeCode        string
+	networkTypeCode string
+	throughputType  string
+	idleTimeout     int32
+	regionCode      string
+	vpcNo           string
+	subnetNos       []string
+	domain          string
+	ips             []string
+	createDate      string
+	phase           lbPhase
+	since           int64
+}
+
+// targetGroup은 타겟 그룹 상태입니다
+type targetGroup struct {
+	no                  string
+	name                string
+	description         string
+	regionCode          string
+	vpcNo               string
+	targetTypeCode      string
+	protocolTypeCode    string
+	port                int32
+	algorithmTypeCode   string
+	useStickySession    bool
+	useProxyProtocol    bool
+	healthCheckProtocol string
+	healthCheckPort     int32
+	healthCheckURLPath  string
+	healthCheckMethod   string
+	healthCheckCycle    int32
+	healthCheckUp       int32
+	healthCheckDown     int32
+	loadBalancerNo      string
+	createDate          string
+	targets             []*target
+}
+
+// target은 타겟 그룹에 등록된 서버입니다
+type target struct {
+	instanceNo string
+	since      int64
+	healthy    bool
+}
+
+// listener는 로드밸런서 리스너 상태입니다
+type listener struct {
+	no               string
+	loadBalancerNo   string
+	protocolType     string
+	port             int32
+	targetGroupNo    string
+	sslCertificateNo string
+}
+
+// loadBalancerHandlers는 vloadbalancer v2 액션 테이블입니다
+var loadBalancerHandlers = map[string]handlerFunc{
+	"createLoadBalancerInstance":                (*Server).createLoadBalancerInstance,
+	"getLoadBalancerInstanceList":               (*Server).getLoadBalancerInstanceList,
+	"getLoadBalancerInstanceDetail":             (*Server).getLoadBalancerInstanceDetail,
+	"deleteLoadBalancerInstances":               (*Server).deleteLoadBalancerInstances,
+	"changeLoadBalancerInstanceConfiguration":   (*Server).changeLoadBalancerInstanceConfiguration,
+	"setLoadBalancerDescription":                (*Server).setLoadBalancerDescription,
+	"createTargetGroup":                         (*Server).createTargetGroup,
+	"getTargetGroupList":                        (*Server).getTargetGroupList,
+	"getTargetGroupDetail":                      (*Server).getTargetGroupDetail,
+	"deleteTargetGroups":                        (*Server).deleteTargetGroups,
+	"changeTargetGroupConfiguration":            (*Server).changeTargetGroupConfiguration,
+	"changeTargetGroupHealthCheckConfiguration": (*Server).changeTargetGroupHealthCheckConfiguration,
+	"setTargetGroupDescription":                 (*Server).setTargetGroupDescription,
+	"createLoadBalancerListener":                (*Server).createLoadBalancerListener,
+	"getLoadBalancerListenerList":               (*Server).getLoadBalancerListenerList,
+	"deleteLoadBalancerListeners":               (*Server).deleteLoadBalancerListeners,
+	"changeLoadBalancerListenerConfiguration":   (*Server).changeLoadBalancerListenerConfiguration,
+	"addTarget":     (*Server).addTarget,
+	"removeTarget":  (*Server).removeTarget,
+	"getTargetList": (*Server).getTargetList,
+}
+
+// advanceLoadBalancers는 로드밸런서 상태를 INIT→CREATING→USED, Changing→Running, 삭제 순으로 진행합니다
+func (s *Server) advanceLoadBalancers() {
+	for _, no := range sortedKeys(s.loadBalancers) {
+		lb := s.loadBalancers[no]
+		elapsed := s.tick - lb.since
+
+		switch lb.phase {
+		case lbPhaseInit:
+			if elapsed >= 1 {
+				lb.phase, lb.since = lbPhaseCreating, s.tick
+			}
+		case lbPhaseCreating, lbPhaseChanging:
+			if elapsed >= s.steps() {
+				lb.phase, lb.since = lbPhaseRunning, s.tick
+			}
+		case lbPhaseTerminating:
+			if elapsed >= s.steps() {
+				s.removeLoadBalancer(no)
+			}
+		}
+	}
+}
+
+// removeLoadBalancer는 삭제가 완료된 로드밸런서와 리스너를 정리하고 타겟 그룹 연결을 해제합니다
+func (s *Server) removeLoadBalancer(no string) {
+	for listenerNo, l := range s.listeners {
+		if l.loadBalancerNo == no {
+			delete(s.listeners, listenerNo)
+		}
+	}
+	for _, tg := range s.targetGroups {
+		if tg.loadBalancerNo == no {
+			tg.loadBalancerNo = ""
+		}
+	}
+	delete(s.loadBalancers, no)
+}
+
+// status는 로드밸런서 상태를 (코드, 상태 이름, 작업 코드)로 반환합니다
+func (lb *loadBalancer) status() (string, string, string) {
+	switch lb.phase {
+	case lbPhaseInit:
+		return LBStatusInit, "Init", "CREAT"
+	case lbPhaseCreating:
+		return LBStatusCreating, "Creating", "CREAT"
+	case lbPhaseChanging:
+		return LBStatusUsed, "Changing", "CHANG"
+	case lbPhaseTerminating:
+		return LBStatusTerminating, "Terminating", "TERMT"
+	default:
+		return LBStatusUsed, "Running", "NULL"
+	}
+}
+
+// loadBalancerToSDK는 로드밸런서 상태를 SDK 응답 타입으로 변환합니다
+func (s *Server) loadBalancerToSDK(lb *loadBalancer) *vloadbalancer.LoadBalancerInstance {
+	code, name, operation := lb.status()
+
+	var listenerNos []*string
+	for _, no := range sortedKeys(s.listeners) {
+		if s.listeners[no].loadBalancerNo == lb.no {
+			listenerNos = append(listenerNos, ncloud.String(no))
+		}
+	}
+
+	return &vloadbalancer.LoadBalancerInstance{
+		LoadBalancerInstanceNo:         ncloud.String(lb.no),
+		LoadBalancerInstanceStatus:     &vloadbalancer.CommonCode{Code: ncloud.String(code), CodeName: ncloud.String(name)},
+		LoadBalancerInstanceOperation:  &vloadbalancer.CommonCode{Code: ncloud.String(operation), CodeName: ncloud.String(operation)},
+		LoadBalancerInstanceStatusName: ncloud.String(name),
+		LoadBalancerDescription:        ncloud.String(lb.description),
+		CreateDate:                     ncloud.String(lb.createDate),
+		LoadBalancerName:               ncloud.String(lb.name),
+		LoadBalancerDomain:             ncloud.String(lb.domain),
+		LoadBalancerIpList:             stringList(lb.ips),
+		LoadBalancerType:               &vloadbalancer.CommonCode{Code: ncloud.String(lb.typeCode), CodeName: ncloud.String(lb.typeCode)},
+		LoadBalancerNetworkType:        &vloadbalancer.CommonCode{Code: ncloud.String(lb.networkTypeCode), CodeName: ncloud.String(lb.networkTypeCode)},
+		ThroughputType:                 &vloadbalancer.CommonCode{Code: ncloud.String(lb.throughputType), CodeName: ncloud.String(lb.throughputType)},
+		IdleTimeout:                    ncloud.Int32(lb.idleTimeout),
+		VpcNo:                          ncloud.String(lb.vpcNo),
+		RegionCode:                     ncloud.String(lb.regionCode),
+		SubnetNoList:                   stringList(lb.subnetNos),
+		LoadBalancerListenerNoList:     listenerNos,
+	}
+}
+
+// targetGroupToSDK는 타겟 그룹 상태를 SDK 응답 타입으로 변환합니다
+func targetGroupToSDK(tg *targetGroup) *vloadbalancer.TargetGroup {
+	targetNos := make([]string, 0, len(tg.targets))
+	for _, t := range tg.targets {
+		targetNos = append(targetNos, t.instanceNo)
+	}
+
+	return &vloadbalancer.TargetGroup{
+		TargetGroupNo:             ncloud.String(tg.no),
+		TargetGroupName:           ncloud.String(tg.name),
+		TargetType:                &vloadbalancer.CommonCode{Code: ncloud.String(tg.targetTypeCode), CodeName: ncloud.String(tg.targetTypeCode)},
+		VpcNo:                     ncloud.String(tg.vpcNo),
+		TargetGroupProtocolType:   &vloadbalancer.CommonCode{Code: ncloud.String(tg.protocolTypeCode), CodeName: ncloud.String(tg.protocolTypeCode)},
+		TargetGroupPort:           ncloud.Int32(tg.port),
+		TargetGroupDescription:    ncloud.String(tg.description),
+		UseStickySession:          ncloud.Bool(tg.useStickySession),
+		UseProxyProtocol:          ncloud.Bool(tg.useProxyProtocol),
+		AlgorithmType:             &vloadbalancer.CommonCode{Code: ncloud.String(tg.algorithmTypeCode), CodeName: ncloud.String(tg.algorithmTypeCode)},
+		CreateDate:                ncloud.String(tg.createDate),
+		RegionCode:                ncloud.String(tg.regionCode),
+		LoadBalancerInstanceNo:    ncloud.String(tg.loadBalancerNo),
+		HealthCheckProtocolType:   &vloadbalancer.CommonCode{Code: ncloud.String(tg.healthCheckProtocol), CodeName: ncloud.String(tg.healthCheckProtocol)},
+		HealthCheckPort:           ncloud.Int32(tg.healthCheckPort),
+		HealthCheckUrlPath:        ncloud.String(tg.healthCheckURLPath),
+		HealthCheckHttpMethodType: &vloadbalancer.CommonCode{Code: ncloud.String(tg.healthCheckMethod), CodeName: ncloud.String(tg.healthCheckMethod)},
+		HealthCheckCycle:          ncloud.Int32(tg.healthCheckCycle),
+		HealthCheckUpThreshold:    ncloud.Int32(tg.healthCheckUp),
+		HealthCheckDownThreshold:  ncloud.Int32(tg.healthCheckDown),
+		TargetNoList:              stringList(targetNos),
+	}
+}
+
+// listenerToSDK는 리스너 상태를 SDK 응답 타입으로 변환합니다
+func listenerToSDK(l *listener) *vloadbalancer.LoadBalancerListener {
+	return &vloadbalancer.LoadBalancerListener{
+		LoadBalancerInstanceNo: ncloud.String(l.loadBalancerNo),
+		LoadBalancerListenerNo: ncloud.String(l.no),
+		ProtocolType:           &vloadbalancer.CommonCode{Code: ncloud.String(l.protocolType), CodeName: ncloud.String(l.protocolType)},
+		Port:                   ncloud.Int32(l.port),
+		SslCertificateNo:       ncloud.String(l.sslCertificateNo),
+	}
+}
+
+// regionOrDefault는 regionCode 파라미터가 없으면 KR을 반환합니다
+func regionOrDefault(p params) string {
+	if region := p.str("regionCode"); region != "" {
+		return region
+	}
+	return "KR"
+}
+
+// createLoadBalancerInstance는 INIT 상태의 로드밸런서를 생성합니다. 같은 이름이 있으면 1200013을 반환합니다
+func (s *Server) createLoadBalancerInstance(p params) (interface{}, *apiError) {
+	if apiErr := p.require("loadBalancerTypeCode", "vpcNo"); apiErr != nil {
+		return nil, apiErr
+	}
+
+	subnetNos := p.list("subnetNoList")
+	if len(subnetNos) == 0 {
+		return nil, invalidParameter("subnetNoList is required")
+	}
+
+	name := p.str("loadBalancerName")
+	for _, lb := range s.loadBalancers {
+		if name != "" && lb.name == name {
+			return nil, &apiError{status: http.StatusBadRequest, code: CodeDuplicateName, message: "Duplicate load balancer name."}
+		}
+	}
+
+	idleTimeout, ok, err := p.int32Value("idleTimeout")
+	if err != nil {
+		return nil, invalidParameter("%s", err.Error())
+	}
+	if !ok {
+		idleTimeout = 60
+	}
+
+	no := s.nextNo()
+	if name == "" {
+		name = "lb-" + no
+	}
+
+	networkType := p.str("loadBalancerNetworkTypeCode")
+	if networkType == "" {
+		networkType = "PUBLIC"
+	}
+	throughput := p.str("throughputTypeCode")
+	if throughput == "" {
+		throughput = "SMALL"
+	}
+
+	lb := &loadBalancer{
+		no:              no,
+		name:            name,
+		description:     p.str("loadBalancerDescription"),
+		typeCode:        p.str("loadBalancerTypeCode"),
+		networkTypeCode: networkType,
+		throughputType:  throughput,
+		idleTimeout:     idleTimeout,
+		regionCode:      regionOrDefault(p),
+		vpcNo:           p.str("vpcNo"),
+		subnetNos:       subnetNos,
+		domain:          fmt.Sprintf("%s-%s.kr.lb.naverncp.com", name, no),
+		ips:             []string{fmt.Sprintf("10.250.%d.%d", (s.seq/250)%250, s.seq%250+1)},
+		createDate:      s.timestamp(),
+		phase:           lbPhaseInit,
+		since:           s.tick,
+	}
+	s.loadBalancers[no] = lb
+
+	return &vloadbalancer.CreateLoadBalancerInstanceResponse{
+		RequestId:                ncloud.String(no),
+		ReturnCode:               ncloud.String("0"),
+		ReturnMessage:            ncloud.String("success"),
+		TotalRows:                ncloud.Int32(1),
+		LoadBalancerInstanceList: []*vloadbalancer.LoadBalancerInstance{s.loadBalancerToSDK(lb)},
+	}, nil
+}
+
+// getLoadBalancerInstanceList는 번호/VPC로 필터링한 로드밸런서 목록을 반환합니다
+func (s *Server) getLoadBalancerInstanceList(p params) (interface{}, *apiError) {
+	filter := p.list("loadBalancerInstanceNoList")
+	vpcNo := p.str("vpcNo")
+
+	var list []*vloadbalancer.LoadBalancerInstance
+	for _, no := range sortedKeys(s.loadBalancers) {
+		lb := s.loadBalancers[no]
+		if len(filter) > 0 && !contains(filter, no) {
+			continue
+		}
+		if vpcNo != "" && lb.vpcNo != vpcNo {
+			continue
+		}
+		list = append(list, s.loadBalancerToSDK(lb))
+	}
+
+	return &vloadbalancer.GetLoadBalancerInstanceListResponse{
+		ReturnCode:               ncloud.String("0"),
+		ReturnMessage:            ncloud.String("success"),
+		TotalRows:                ncloud.Int32(int32(len(list))),
+		LoadBalancerInstanceList: list,
+	}, nil
+}
+
+// getLoadBalancerInstanceDetail은 로드밸런서 한 개의 현재 상태를 반환합니다
+func (s *Server) getLoadBalancerInstanceDetail(p params) (interface{}, *apiError) {
+	if apiErr := p.require("loadBalancerInstanceNo"); apiErr != nil {
+		return nil, apiErr
+	}
+
+	lb, ok := s.loadBalancers[p.str("loadBalancerInstanceNo")]
+	if !ok {
+		return nil, notFound("Load balancer instance not found: %s", p.str("loadBalancerInstanceNo"))
+	}
+
+	return &vloadbalancer.GetLoadBalancerInstanceDetailResponse{
+		ReturnCode:               ncloud.String("0"),
+		ReturnMessage:            ncloud.String("success"),
+		TotalRows:                ncloud.Int32(1),
+		LoadBalancerInstanceList: []*vloadbalancer.LoadBalancerInstance{s.loadBalancerToSDK(lb)},
+	}, nil
+}
+
+// deleteLoadBalancerInstances는 로드밸런서를 TERMINATING 상태로 전환합니다. 실제 제거는 advance에서 처리됩니다
+func (s *Server) deleteLoadBalancerInstances(p params) (interface{}, *apiError) {
+	nos := p.list("loadBalancerInstanceNoList")
+	if len(nos) == 0 {
+		return nil, invalidParameter("loadBalancerInstanceNoList is required")
+	}
+
+	for _, no := range nos {
+		lb, ok := s.loadBalancers[no]
+		if !ok {
+			return nil, notFound("Load balancer instance not found: %s", no)
+		}
+		if lb.phase == lbPhaseInit || lb.phase == lbPhaseCreating || lb.phase == lbPhaseChanging {
+			return nil, &apiError{status: http.StatusBadRequest, code: codeInvalidState, message: "Load balancer is not in a deletable state."}
+		}
+	}
+
+	var list []*vloadbalancer.LoadBalancerInstance
+	for _, no := range nos {
+		lb := s.loadBalancers[no]
+		if lb.phase != lbPhaseTerminating {
+			lb.phase, lb.since = lbPhaseTerminating, s.tick
+		}
+		list = append(list, s.loadBalancerToSDK(lb))
+	}
+
+	return &vloadbalancer.DeleteLoadBalancerInstancesResponse{
+		ReturnCode:               ncloud.String("0"),
+		ReturnMessage:            ncloud.String("success"),
+		TotalRows:                ncloud.Int32(int32(len(list))),
+		LoadBalancerInstanceList: list,
+	}, nil
+}
+
+// changeLoadBalancerInstanceConfiguration은 유휴 타임아웃과 처리량 타입을 변경하고 로드밸런서를 Changing 상태로 전환합니다
+func (s *Server) changeLoadBalancerInstanceConfiguration(p params) (interface{}, *apiError) {
+	if apiErr := p.require("loadBalancerInstanceNo"); apiErr != nil {
+		return nil, apiErr
+	}
+
+	lb, ok := s.loadBalancers[p.str("loadBalancerInstanceNo")]
+	if !ok {
+		return nil, notFound("Load balancer instance not found: %s", p.str("loadBalancerInstanceNo"))
+	}
+	if lb.phase != lbPhaseRunning {
+		return nil, &apiError{status: http.StatusBadRequest, code: codeInvalidState, message: "Load balancer is not in a changeable state."}
+	}
+
+	idleTimeout, ok, err := p.int32Value("idleTimeout")
+	if err != nil {
+		return nil, invalidParameter("%s", err.Error())
+	}
+	if ok {
+		lb.idleTimeout = idleTimeout
+	}
+	if v := p.str("throughputTypeCode"); v != "" {
+		lb.throughputType = v
+	}
+	lb.phase, lb.since = lbPhaseChanging, s.tick
+
+	return &vloadbalancer.ChangeLoadBalancerInstanceConfigurationResponse{
+		ReturnCode:               ncloud.String("0"),
+		ReturnMessage:            ncloud.String("success"),
+		TotalRows:                ncloud.Int32(1),
+		LoadBalancerInstanceList: []*vloadbalancer.LoadBalancerInstance{s.loadBalancerToSDK(lb)},
+	}, nil
+}
+
+// setLoadBalancerDescription은 로드밸런서 설명을 변경합니다
+func (s *Server) setLoadBalancerDescription(p params) (interface{}, *apiError) {
+	if apiErr := p.require("loadBalancerInstanceNo"); apiErr != nil {
+		return nil, apiErr
+	}
+
+	lb, ok := s.loadBalancers[p.str("loadBalancerInstanceNo")]
+	if !ok {
+		return nil, notFound("Load balancer instance not found: %s", p.str("loadBalancerInstanceNo"))
+	}
+	lb.description = p.str("loadBalancerDescription")
+
+	return &vloadbalancer.SetLoadBalancerDescriptionResponse{
+		ReturnCode:               ncloud.String("0"),
+		ReturnMessage:            ncloud.String("success"),
+		TotalRows:                ncloud.Int32(1),
+		LoadBalancerInstanceList: []*vloadbalancer.LoadBalancerInstance{s.loadBalancerToSDK(lb)},
+	}, nil
+}
+
+// createTargetGroup은 타겟 그룹을 생성합니다. 같은 이름이 있으면 1200013을 반환합니다.
+// 실제 API처럼 알고리즘, 세션 유지, Proxy Protocol은 받지 않고 기본값으로 생성하며 changeTargetGroupConfiguration으로만 바꿀 수 있습니다
+func (s *Server) createTargetGroup(p params) (interface{}, *apiError) {
+	if apiErr := p.require("vpcNo", "targetGroupProtocolTypeCode"); apiErr != nil {
+		return nil, apiErr
+	}
+
+	name := p.str("targetGroupName")
+	for _, tg := range s.targetGroups {
+		if name != "" && tg.name == name {
+			return nil, &apiError{status: http.StatusBadRequest, code: CodeDuplicateName, message: "Duplicate target group name."}
+		}
+	}
+
+	port, _, err := p.int32Value("targetGroupPort")
+	if err != nil {
+		return nil, invalidParameter("%s", err.Error())
+	}
+	healthCheckPort, ok, err := p.int32Value("healthCheckPort")
+	if err != nil {
+		return nil, invalidParameter("%s", err.Error())
+	}
+	if !ok {
+		healthCheckPort = port
+	}
+
+	no := s.nextNo()
+	if name == "" {
+		name = "tg-" + no
+	}
+
+	tg := &targetGroup{
+		no:                  no,
+		name:                name,
+		description:         p.str("targetGroupDescription"),
+		regionCode:          regionOrDefault(p),
+		vpcNo:               p.str("vpcNo"),
+		targetTypeCode:      stringOrDefault(p.str("targetTypeCode"), "VSVR"),
+		protocolTypeCode:    p.str("targetGroupProtocolTypeCode"),
+		port:                port,
+		algorithmTypeCode:   "RR",
+		healthCheckProtocol: stringOrDefault(p.str("healthCheckProtocolTypeCode"), "TCP"),
+		healthCheckPort:     healthCheckPort,
+		healthCheckURLPath:  p.str("healthCheckUrlPath"),
+		healthCheckMethod:   p.str("healthCheckHttpMethodTypeCode"),
+		healthCheckCycle:    30,
+		healthCheckUp:       2,
+		healthCheckDown:     2,
+		createDate:          s.timestamp(),
+	}
+	if v, ok, _ := p.int32Value("healthCheckCycle"); ok {
+		tg.healthCheckCycle = v
+	}
+	if v, ok, _ := p.int32Value("healthCheckUpThreshold"); ok {
+		tg.healthCheckUp = v
+	}
+	if v, ok, _ := p.int32Value("healthCheckDownThreshold"); ok {
+		tg.healthCheckDown = v
+	}
+	for _, instanceNo := range p.list("targetNoList") {
+		if _, ok := s.servers[instanceNo]; !ok {
+			return nil, notFound("Server instance not found: %s", instanceNo)
+		}
+		tg.targets = append(tg.targets, &target{instanceNo: instanceNo, since: s.tick})
+	}
+	s.targetGroups[no] = tg
+
+	return &vloadbalancer.CreateTargetGroupResponse{
+		RequestId:       ncloud.String(no),
+		ReturnCode:      ncloud.String("0"),
+		ReturnMessage:   ncloud.String("success"),
+		TotalRows:       ncloud.Int32(1),
+		TargetGroupList: []*vloadbalancer.TargetGroup{targetGroupToSDK(tg)},
+	}, nil
+}
+
+// getTargetGroupList는 번호/VPC/로드밸런서/이름으로 필터링한 타겟 그룹 목록을 반환합니다
+func (s *Server) getTargetGroupList(p params) (interface{}, *apiError) {
+	filter := p.list("targetGroupNoList")
+	vpcNo := p.str("vpcNo")
+	lbNo := p.str("loadBalancerInstanceNo")
+	name := p.str("targetGroupName")
+
+	var list []*vloadbalancer.TargetGroup
+	for _, no := range sortedKeys(s.targetGroups) {
+		tg := s.targetGroups[no]
+		if len(filter) > 0 && !contains(filter, no) {
+			continue
+		}
+		if vpcNo != "" && tg.vpcNo != vpcNo {
+			continue
+		}
+		if lbNo != "" && tg.loadBalancerNo != lbNo {
+			continue
+		}
+		if name != "" && tg.name != name {
+			continue
+		}
+		list = append(list, targetGroupToSDK(tg))
+	}
+
+	return &vloadbalancer.GetTargetGroupListResponse{
+		ReturnCode:      ncloud.String("0"),
+		ReturnMessage:   ncloud.String("success"),
+		TotalRows:       ncloud.Int32(int32(len(list))),
+		TargetGroupList: list,
+	}, nil
+}
+
+// getTargetGroupDetail은 타겟 그룹 한 개를 반환합니다
+func (s *Server) getTargetGroupDetail(p params) (interface{}, *apiError) {
+	if apiErr := p.require("targetGroupNo"); apiErr != nil {
+		return nil, apiErr
+	}
+
+	tg, ok := s.targetGroups[p.str("targetGroupNo")]
+	if !ok {
+		return nil, notFound("Target group not found: %s", p.str("targetGroupNo"))
+	}
+
+	return &vloadbalancer.GetTargetGroupDetailResponse{
+		ReturnCode:      ncloud.String("0"),
+		ReturnMessage:   ncloud.String("success"),
+		TotalRows:       ncloud.Int32(1),
+		TargetGroupList: []*vloadbalancer.TargetGroup{targetGroupToSDK(tg)},
+	}, nil
+}
+
+// changeTargetGroupConfiguration은 타겟 그룹 알고리즘과 세션 유지, Proxy Protocol 설정을 변경합니다
+func (s *Server) changeTargetGroupConfiguration(p params) (interface{}, *apiError) {
+	if apiErr := p.require("targetGroupNo"); apiErr != nil {
+		return nil, apiErr
+	}
+
+	tg, ok := s.targetGroups[p.str("targetGroupNo")]
+	if !ok {
+		return nil, notFound("Target group not found: %s", p.str("targetGroupNo"))
+	}
+
+	if v := p.str("algorithmTypeCode"); v != "" {
+		tg.algorithmTypeCode = v
+	}
+	for key, field := range map[string]*bool{
+		"useStickySession": &tg.useStickySession,
+		"useProxyProtocol": &tg.useProxyProtocol,
+	} {
+		v, ok := p.boolValue(key)
+		if ok {
+			*field = v
+		}
+	}
+
+	return &vloadbalancer.ChangeTargetGroupConfigurationResponse{
+		ReturnCode:      ncloud.String("0"),
+		ReturnMessage:   ncloud.String("success"),
+		TotalRows:       ncloud.Int32(1),
+		TargetGroupList: []*vloadbalancer.TargetGroup{targetGroupToSDK(tg)},
+	}, nil
+}
+
+// setTargetGroupDescription은 타겟 그룹 설명을 변경합니다
+func (s *Server) setTargetGroupDescription(p params) (interface{}, *apiError) {
+	if apiErr := p.require("targetGroupNo"); apiErr != nil {
+		return nil, apiErr
+	}
+
+	tg, ok := s.targetGroups[p.str("targetGroupNo")]
+	if !ok {
+		return nil, notFound("Target group not found: %s", p.str("targetGroupNo"))
+	}
+	tg.description = p.str("targetGroupDescription")
+
+	return &vloadbalancer.SetTargetGroupDescriptionResponse{
+		ReturnCode:      ncloud.String("0"),
+		ReturnMessage:   ncloud.String("success"),
+		TotalRows:       ncloud.Int32(1),
+		TargetGroupList: []*vloadbalancer.TargetGroup{targetGroupToSDK(tg)},
+	}, nil
+}
+
+// changeTargetGroupHealthCheckConfiguration은 타겟 그룹 헬스 체크 설정을 변경합니다 (프로토콜은 변경할 수 없음)
+func (s *Server) changeTargetGroupHealthCheckConfiguration(p params) (interface{}, *apiError) {
+	if apiErr := p.require("targetGroupNo"); apiErr != nil {
+		return nil, apiErr
+	}
+
+	tg, ok := s.targetGroups[p.str("targetGroupNo")]
+	if !ok {
+		return nil, notFound("Target group not found: %s", p.str("targetGroupNo"))
+	}
+
+	for key, field := range map[string]*int32{
+		"healthCheckPort":          &tg.healthCheckPort,
+		"healthCheckCycle":         &tg.healthCheckCycle,
+		"healthCheckUpThreshold":   &tg.healthCheckUp,
+		"healthCheckDownThreshold": &tg.healthCheckDown,
+	} {
+		v, ok, err := p.int32Value(key)
+		if err != nil {
+			return nil, invalidParameter("%s", err.Error())
+		}
+		if ok {
+			*field = v
+		}
+	}
+	if v := p.str("healthCheckUrlPath"); v != "" {
+		tg.healthCheckURLPath = v
+	}
+	if v := p.str("healthCheckHttpMethodTypeCode"); v != "" {
+		tg.healthCheckMethod = v
+	}
+
+	return &vloadbalancer.ChangeTargetGroupHealthCheckConfigurationResponse{
+		ReturnCode:      ncloud.String("0"),
+		ReturnMessage:   ncloud.String("success"),
+		TotalRows:       ncloud.Int32(1),
+		TargetGroupList: []*vloadbalancer.TargetGroup{targetGroupToSDK(tg)},
+	}, nil
+}
+
+// deleteTargetGroups는 타겟 그룹을 삭제합니다. 로드밸런서에 연결되어 있으면 1200059를 반환합니다
+func (s *Server) deleteTargetGroups(p params) (interface{}, *apiError) {
+	nos := p.list("targetGroupNoList")
+	if len(nos) == 0 {
+		return nil, invalidParameter("targetGroupNoList is required")
+	}
+
+	for _, no := range nos {
+		tg, ok := s.targetGroups[no]
+		if !ok {
+			return nil, notFound("Target group not found: %s", no)
+		}
+		if tg.loadBalancerNo != "" {
+			return nil, &apiError{status: http.StatusBadRequest, code: CodeTargetGroupInUse, message: "Target group in use."}
+		}
+	}
+
+	var list []*vloadbalancer.TargetGroup
+	for _, no := range nos {
+		list = append(list, targetGroupToSDK(s.targetGroups[no]))
+		delete(s.targetGroups, no)
+	}
+
+	return &vloadbalancer.DeleteTargetGroupsResponse{
+		ReturnCode:      ncloud.String("0"),
+		ReturnMessage:   ncloud.String("success"),
+		TotalRows:       ncloud.Int32(int32(len(list))),
+		TargetGroupList: list,
+	}, nil
+}
+
+// createLoadBalancerListener는 Running 상태의 로드밸런서에 리스너를 추가하고 로드밸런서를 Changing으로 전환합니다
+func (s *Server) createLoadBalancerListener(p params) (interface{}, *apiError) {
+	if apiErr := p.require("loadBalancerInstanceNo", "protocolTypeCode", "port", "targetGroupNo"); apiErr != nil {
+		return nil, apiErr
+	}
+
+	lb, ok := s.loadBalancers[p.str("loadBalancerInstanceNo")]
+	if !ok {
+		return nil, notFound("Load balancer instance not found: %s", p.str("loadBalancerInstanceNo"))
+	}
+	if lb.phase != lbPhaseRunning {
+		return nil, &apiError{status: http.StatusBadRequest, code: codeInvalidState, message: "Load balancer is not in a changeable state."}
+	}
+
+	tg, ok := s.targetGroups[p.str("targetGroupNo")]
+	if !ok {
+		return nil, notFound("Target group not found: %s", p.str("targetGroupNo"))
+	}
+	if tg.loadBalancerNo != "" && tg.loadBalancerNo != lb.no {
+		return nil, invalidParameter("Target group %s is already used by load balancer %s", tg.no, tg.loadBalancerNo)
+	}
+
+	port, _, err := p.int32Value("port")
+	if err != nil {
+		return nil, invalidParameter("%s", err.Error())
+	}
+	for _, l := range s.listeners {
+		if l.loadBalancerNo == lb.no && l.port == port {
+			return nil, invalidParameter("Listener port %d is already in use", port)
+		}
+	}
+
+	l := &listener{
+		no:               s.nextNo(),
+		loadBalancerNo:   lb.no,
+		protocolType:     p.str("protocolTypeCode"),
+		port:             port,
+		targetGroupNo:    tg.no,
+		sslCertificateNo: p.str("sslCertificateNo"),
+	}
+	s.listeners[l.no] = l
+	tg.loadBalancerNo = lb.no
+	lb.phase, lb.since = lbPhaseChanging, s.tick
+
+	return &vloadbalancer.CreateLoadBalancerListenerResponse{
+		RequestId:                ncloud.String(l.no),
+		ReturnCode:               ncloud.String("0"),
+		ReturnMessage:            ncloud.String("success"),
+		TotalRows:                ncloud.Int32(1),
+		LoadBalancerListenerList: []*vloadbalancer.LoadBalancerListener{listenerToSDK(l)},
+	}, nil
+}
+
+// getLoadBalancerListenerList는 로드밸런서의 리스너 목록을 반환합니다
+func (s *Server) getLoadBalancerListenerList(p params) (interface{}, *apiError) {
+	if apiErr := p.require("loadBalancerInstanceNo"); apiErr != nil {
+		return nil, apiErr
+	}
+
+	lbNo := p.str("loadBalancerInstanceNo")
+	if _, ok := s.loadBalancers[lbNo]; !ok {
+		return nil, notFound("Load balancer instance not found: %s", lbNo)
+	}
+
+	var list []*vloadbalancer.LoadBalancerListener
+	for _, no := range sortedKeys(s.listeners) {
+		if s.listeners[no].loadBalancerNo == lbNo {
+			list = append(list, listenerToSDK(s.listeners[no]))
+		}
+	}
+
+	return &vloadbalancer.GetLoadBalancerListenerListResponse{
+		ReturnCode:               ncloud.String("0"),
+		ReturnMessage:            ncloud.String("success"),
+		TotalRows:                ncloud.Int32(int32(len(list))),
+		LoadBalancerListenerList: list,
+	}, nil
+}
+
+// deleteLoadBalancerListeners는 리스너를 삭제하고 더 이상 사용되지 않는 타겟 그룹의 연결을 해제합니다
+func (s *Server) deleteLoadBalancerListeners(p params) (interface{}, *apiError) {
+	nos := p.list("loadBalancerListenerNoList")
+	if len(nos) == 0 {
+		return nil, invalidParameter("loadBalancerListenerNoList is required")
+	}
+
+	for _, no := range nos {
+		l, ok := s.listeners[no]
+		if !ok {
+			return nil, notFound("Load balancer listener not found: %s", no)
+		}
+		if lb := s.loadBalancers[l.loadBalancerNo]; lb.phase != lbPhaseRunning {
+			return nil, &apiError{status: http.StatusBadRequest, code: codeInvalidState, message: "Load balancer is not in a changeable state."}
+		}
+	}
+
+	var list []*vloadbalancer.LoadBalancerListener
+	for _, no := range nos {
+		l := s.listeners[no]
+		list = append(list, listenerToSDK(l))
+		delete(s.listeners, no)
+
+		lb := s.loadBalancers[l.loadBalancerNo]
+		lb.phase, lb.since = lbPhaseChanging, s.tick
+		s.releaseTargetGroup(l.targetGroupNo, l.loadBalancerNo)
+	}
+
+	return &vloadbalancer.DeleteLoadBalancerListenersResponse{
+		ReturnCode:               ncloud.String("0"),
+		ReturnMessage:            ncloud.String("success"),
+		TotalRows:                ncloud.Int32(int32(len(list))),
+		LoadBalancerListenerList: list,
+	}, nil
+}
+
+// changeLoadBalancerListenerConfiguration은 리스너의 프로토콜, 포트, 인증서를 변경하고 로드밸런서를 Changing으로 전환합니다
+func (s *Server) changeLoadBalancerListenerConfiguration(p params) (interface{}, *apiError) {
+	if apiErr := p.require("loadBalancerListenerNo", "protocolTypeCode", "port"); apiErr != nil {
+		return nil, apiErr
+	}
+
+	l, ok := s.listeners[p.str("loadBalancerListenerNo")]
+	if !ok {
+		return nil, notFound("Load balancer listener not found: %s", p.str("loadBalancerListenerNo"))
+	}
+	lb := s.loadBalancers[l.loadBalancerNo]
+	if lb.phase != lbPhaseRunning {
+		return nil, &apiError{status: http.StatusBadRequest, code: codeInvalidState, message: "Load balancer is not in a changeable state."}
+	}
+
+	port, _, err := p.int32Value("port")
+	if err != nil {
+		return nil, invalidParameter("%s", err.Error())
+	}
+	for _, other := range s.listeners {
+		if other.no != l.no && other.loadBalancerNo == lb.no && other.port == port {
+			return nil, invalidParameter("Listener port %d is already in use", port)
+		}
+	}
+
+	l.protocolType = p.str("protocolTypeCode")
+	l.port = port
+	l.sslCertificateNo = p.str("sslCertificateNo")
+	lb.phase, lb.since = lbPhaseChanging, s.tick
+
+	return &vloadbalancer.ChangeLoadBalancerListenerConfigurationResponse{
+		ReturnCode:               ncloud.String("0"),
+		ReturnMessage:            ncloud.String("success"),
+		TotalRows:                ncloud.Int32(1),
+		LoadBalancerListenerList: []*vloadbalancer.LoadBalancerListener{listenerToSDK(l)},
+	}, nil
+}
+
+// releaseTargetGroup은 어떤 리스너도 타겟 그룹을 사용하지 않으면 로드밸런서 연결을 해제합니다
+func (s *Server) releaseTargetGroup(tgNo, lbNo string) {
+	for _, l := range s.listeners {
+		if l.targetGroupNo == tgNo && l.loadBalancerNo == lbNo {
+			return
+		}
+	}
+	if tg, ok := s.targetGroups[tgNo]; ok && tg.loadBalancerNo == lbNo {
+		tg.loadBalancerNo = ""
+	}
+}
+
+// addTarget은 등록된 서버 인스턴스를 타겟 그룹에 추가합니다
+func (s *Server) addTarget(p params) (interface{}, *apiError) {
+	if apiErr := p.require("targetGroupNo"); apiErr != nil {
+		return nil, apiErr
+	}
+
+	tg, ok := s.targetGroups[p.str("targetGroupNo")]
+	if !ok {
+		return nil, notFound("Target group not found: %s", p.str("targetGroupNo"))
+	}
+
+	instanceNos := p.list("targetNoList")
+	if len(instanceNos) == 0 {
+		return nil, invalidParameter("targetNoList is required")
+	}
+	for _, instanceNo := range instanceNos {
+		if _, ok := s.servers[instanceNo]; !ok {
+			return nil, notFound("Server instance not found: %s", instanceNo)
+		}
+	}
+
+	for _, instanceNo := range instanceNos {
+		if tg.findTarget(instanceNo) == nil {
+			tg.targets = append(tg.targets, &target{instanceNo: instanceNo, since: s.tick})
+		}
+	}
+
+	return &vloadbalancer.AddTargetResponse{
+		ReturnCode:    ncloud.String("0"),
+		ReturnMessage: ncloud.String("success"),
+		TotalRows:     ncloud.Int32(int32(len(tg.targets))),
+		TargetList:    s.targetsToSDK(tg),
+	}, nil
+}
+
+// removeTarget은 타겟 그룹에서 서버 인스턴스를 제거합니다
+func (s *Server) removeTarget(p params) (interface{}, *apiError) {
+	if apiErr := p.require("targetGroupNo"); apiErr != nil {
+		return nil, apiErr
+	}
+
+	tg, ok := s.targetGroups[p.str("targetGroupNo")]
+	if !ok {
+		return nil, notFound("Target group not found: %s", p.str("targetGroupNo"))
+	}
+
+	instanceNos := p.list("targetNoList")
+	if len(instanceNos) == 0 {
+		return nil, invalidParameter("targetNoList is required")
+	}
+
+	remaining := tg.targets[:0]
+	for _, t := range tg.targets {
+		if !contains(instanceNos, t.instanceNo) {
+			remaining = append(remaining, t)
+		}
+	}
+	tg.targets = remaining
+
+	return &vloadbalancer.RemoveTargetResponse{
+		ReturnCode:    ncloud.String("0"),
+		ReturnMessage: ncloud.String("success"),
+		TotalRows:     ncloud.Int32(int32(len(tg.targets))),
+		TargetList:    s.targetsToSDK(tg),
+	}, nil
+}
+
+// getTargetList는 타겟 그룹의 타겟과 헬스 체크 상태를 반환합니다
+func (s *Server) getTargetList(p params) (interface{}, *apiError) {
+	if apiErr := p.require("targetGroupNo"); apiErr != nil {
+		return nil, apiErr
+	}
+
+	tg, ok := s.targetGroups[p.str("targetGroupNo")]
+	if !ok {
+		return nil, notFound("Target group not found: %s", p.str("targetGroupNo"))
+	}
+
+	return &vloadbalancer.GetTargetListResponse{
+		ReturnCode:    ncloud.String("0"),
+		ReturnMessage: ncloud.String("success"),
+		TotalRows:     ncloud.Int32(int32(len(tg.targets))),
+		TargetList:    s.targetsToSDK(tg),
+	}, nil
+}
+
+// advanceTargets는 등록 후 일정 요청이 지난 타겟의 헬스 체크 상태를 UP으로 전환합니다
+func (s *Server) advanceTargets() {
+	for _, tg := range s.targetGroups {
+		for _, t := range tg.targets {
+			if !t.healthy && s.tick-t.since >= s.steps() {
+				t.healthy = true
+			}
+		}
+	}
+}
+
+// findTarget은 타겟 그룹에서 서버 인스턴스를 찾습니다
+func (tg *targetGroup) findTarget(instanceNo string) *target {
+	for _, t := range tg.targets {
+		if t.instanceNo == instanceNo {
+			return t
+		}
+	}
+	return nil
+}
+
+// targetsToSDK는 타겟 목록을 SDK 응답 타입으로 변환합니다
+func (s *Server) targetsToSDK(tg *targetGroup) []*vloadbalancer.Target {
+	list := make([]*vloadbalancer.Target, 0, len(tg.targets))
+	for _, t := range tg.targets {
+		health := "INIT"
+		if t.healthy {
+			health = "UP"
+		}
+
+		list = append(list, &vloadbalancer.Target{
+			TargetNo:          ncloud.String(t.instanceNo),
+			HealthCheckStatus: &vloadbalancer.CommonCode{Code: ncloud.String(health), CodeName: ncloud.String(health)},
+		})
+	}
+	return list
+}
+
+// stringOrDefault는 값이 비어 있으면 기본값을 반환합니다
+func stringOrDefault(value, def string) string {
+	if value == "" {
+		return def
+	}
+	return value
+}
+
+// stringList는 문자열 슬라이스를 SDK의 포인터 슬라이스로 변환합니다
+func stringList(values []string) []*string {
+	list := make([]*string, 0, len(values))
+	for _, v := range values {
+		list = append(list, ncloud.String(v))
+	}
+	return list
+}
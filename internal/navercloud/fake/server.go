@@ -0,0 +1,475 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake는 네이버 클라우드 vloadbalancer/vserver/vpc v2 API와 Certificate Manager API를 흉내 내는
+// 상태 기반 인메모리 HTTP 서버를 제공합니다.
+//
+// 실제 SDK가 보내는 요청(HMAC 서명 헤더, responseFormatType=json, xxxList.N 형식의
+// 목록 파라미터)을 그대로 받아 SDK가 파싱할 수 있는 응답을 돌려주므로,
+// navercloud.NewRealClientWithAPIKey에 이 서버의 URL을 넘기면 클라우드 계정 없이
+// 실제 SDK 경로 전체를 실행할 수 있습니다.
+//
+// 컨트롤러가 호출하는 액션만 구현하며, 구현하지 않은 서비스나 액션은 조용히 성공하지 않고
+// 501 Not Implemented로 실패합니다.
+package fake
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 컨트롤러가 특별 처리하는 네이버 클라우드 오류 코드
+const (
+	// CodeDuplicateName은 같은 이름의 로드밸런서/타겟 그룹이 이미 존재할 때 반환됩니다
+	CodeDuplicateName = "1200013"
+	// CodeTargetGroupInUse는 로드밸런서에 연결된 타겟 그룹을 삭제하려 할 때 반환됩니다
+	CodeTargetGroupInUse = "1200059"
+)
+
+// fake 서버 전용 오류 코드 (실제 API의 코드와 일치하지 않을 수 있음)
+const (
+	codeInvalidParameter = "1200001"
+	codeNotFound         = "1200004"
+	codeInvalidState     = "1200041"
+	codeAuthFailed       = "200"
+	codeNotImplemented   = "501"
+)
+
+// 요청 서명 헤더
+const (
+	headerTimestamp = "x-ncp-apigw-timestamp"
+	headerAccessKey = "x-ncp-iam-access-key"
+	headerSignature = "x-ncp-apigw-signature-v2"
+	// headerSignatureV1은 SDK가 보내는 서명 헤더입니다. 서명 방식은 v2와 같지만 쿼리 문자열 없이 경로만 서명합니다
+	headerSignatureV1 = "x-ncp-apigw-signature-v1"
+)
+
+// DefaultProvisioningSteps는 상태 전이(생성, 변경, 삭제)가 완료되기까지 필요한 API 요청 수의 기본값입니다
+const DefaultProvisioningSteps = 3
+
+// maxTimestampSkew는 서명 타임스탬프로 허용하는 최대 시간 차이입니다
+const maxTimestampSkew = 5 * time.Minute
+
+// Server는 네이버 클라우드 API를 흉내 내는 상태 기반 HTTP 핸들러입니다.
+// 모든 상태 전이는 API 요청 수(tick)를 기준으로 진행되므로 테스트 결과가 결정적입니다.
+type Server struct {
+	// AccessKey/SecretKey는 HMAC 서명 검증에 사용하는 인증 정보입니다
+	AccessKey string
+	SecretKey string
+	// ProvisioningSteps는 로드밸런서 생성/변경/삭제가 완료되기까지 필요한 API 요청 수입니다
+	ProvisioningSteps int
+	// Now는 서명 타임스탬프 검증에 사용하는 현재 시각입니다
+	Now func() time.Time
+
+	mu              sync.Mutex
+	tick            int64
+	seq             int64
+	loadBalancers   map[string]*loadBalancer
+	targetGroups    map[string]*targetGroup
+	listeners       map[string]*listener
+	servers         map[string]*serverInstance
+	subnets         map[string]*subnet
+	networkACLRules map[string][]*networkACLRule
+	acgRules        map[string][]*accessControlGroupRule
+	publicIPs       map[string]*publicIP
+	certificates    map[string]*certificate
+	requests        map[string]int
+}
+
+// apiError는 네이버 클라우드 responseError 형식의 오류입니다
+type apiError struct {
+	status  int
+	code    string
+	message string
+}
+
+// handlerFunc는 하나의 API 액션을 처리합니다. 호출 시 s.mu가 잠겨 있습니다
+type handlerFunc func(s *Server, p params) (interface{}, *apiError)
+
+// NewServer는 주어진 인증 정보로 서명을 검증하는 fake 서버를 생성합니다
+func NewServer(accessKey, secretKey string) *Server {
+	return &Server{
+		AccessKey:         accessKey,
+		SecretKey:         secretKey,
+		ProvisioningSteps: DefaultProvisioningSteps,
+		Now:               time.Now,
+		seq:               100000,
+		loadBalancers:     make(map[string]*loadBalancer),
+		targetGroups:      make(map[string]*targetGroup),
+		listeners:         make(map[string]*listener),
+		servers:           make(map[string]*serverInstance),
+		subnets:           make(map[string]*subnet),
+		networkACLRules:   make(map[string][]*networkACLRule),
+		acgRules:          make(map[string][]*accessControlGroupRule),
+		publicIPs:         make(map[string]*publicIP),
+		certificates:      make(map[string]*certificate),
+		requests:          make(map[string]int),
+	}
+}
+
+// Sign은 네이버 클라우드 API Gateway 서명(v2)을 계산합니다.
+// 서명 대상 문자열은 "METHOD URI\ntimestamp\naccessKey" 입니다.
+func Sign(method, requestURI, timestamp, accessKey, secretKey string) string {
+	mac := hmac.New(sha256.New, []byte(secretKey))
+	mac.Write([]byte(method + " " + requestURI + "\n" + timestamp + "\n" + accessKey))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// RequestCount는 지정한 액션(예: "createLoadBalancerInstance")이 호출된 횟수를 반환합니다
+func (s *Server) RequestCount(action string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[action]
+}
+
+// ServeHTTP는 /vloadbalancer/v2/{action}, /vserver/v2/{action}, /vpc/v2/{action}과
+// /certificatemanager/api/v1/certificate/... 요청을 처리합니다
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if apiErr := s.authenticate(r); apiErr != nil {
+		writeError(w, apiErr)
+		return
+	}
+
+	if isCertificateManagerPath(r.URL.Path) {
+		s.serveCertificateManager(w, r)
+		return
+	}
+
+	handlers, action, ok := route(r.URL.Path)
+	if !ok {
+		writeUnsupported(w, r)
+		return
+	}
+
+	handler, ok := handlers[action]
+	if !ok {
+		writeUnsupported(w, r)
+		return
+	}
+
+	p, err := parseParams(r)
+	if err != nil {
+		writeError(w, &apiError{status: http.StatusBadRequest, code: codeInvalidParameter, message: err.Error()})
+		return
+	}
+
+	s.mu.Lock()
+	s.requests[action]++
+	s.advance()
+	payload, apiErr := handler(s, p)
+	s.mu.Unlock()
+
+	if apiErr != nil {
+		writeError(w, apiErr)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, action+"Response", payload)
+}
+
+// authenticate는 요청의 HMAC 서명과 타임스탬프를 검증합니다
+func (s *Server) authenticate(r *http.Request) *apiError {
+	timestamp := r.Header.Get(headerTimestamp)
+	accessKey := r.Header.Get(headerAccessKey)
+	signature := r.Header.Get(headerSignature)
+	requestURI := r.RequestURI
+	if requestURI == "" {
+		requestURI = r.URL.RequestURI()
+	}
+	if signature == "" {
+		signature = r.Header.Get(headerSignatureV1)
+		requestURI = r.URL.EscapedPath()
+	}
+
+	if timestamp == "" || accessKey == "" || signature == "" {
+		return &apiError{status: http.StatusUnauthorized, code: codeAuthFailed, message: "Authentication Failed: missing signature headers"}
+	}
+
+	millis, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return &apiError{status: http.StatusUnauthorized, code: codeAuthFailed, message: "Authentication Failed: invalid timestamp"}
+	}
+	skew := s.Now().Sub(time.UnixMilli(millis))
+	if skew > maxTimestampSkew || skew < -maxTimestampSkew {
+		return &apiError{status: http.StatusUnauthorized, code: codeAuthFailed, message: "Authentication Failed: timestamp expired"}
+	}
+
+	if accessKey != s.AccessKey {
+		return &apiError{status: http.StatusUnauthorized, code: codeAuthFailed, message: "Authentication Failed: unknown access key"}
+	}
+
+	expected := Sign(r.Method, requestURI, timestamp, accessKey, s.SecretKey)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return &apiError{status: http.StatusUnauthorized, code: codeAuthFailed, message: "Authentication Failed: signature mismatch"}
+	}
+
+	return nil
+}
+
+// route는 요청 경로에서 서비스별 핸들러 테이블과 액션 이름을 찾습니다
+func route(path string) (map[string]handlerFunc, string, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 3 {
+		return nil, "", false
+	}
+
+	// 사용자 지정 URL에 경로 접두사가 있을 수 있으므로 뒤에서부터 해석
+	n := len(segments)
+	service, version, action := segments[n-3], segments[n-2], segments[n-1]
+	if version != "v2" {
+		return nil, "", false
+	}
+
+	switch service {
+	case "vloadbalancer":
+		return loadBalancerHandlers, action, true
+	case "vserver":
+		return serverHandlers, action, true
+	case "vpc":
+		return vpcHandlers, action, true
+	default:
+		return nil, "", false
+	}
+}
+
+// advance는 요청마다 tick을 증가시키고 진행 중인 상태 전이를 처리합니다
+func (s *Server) advance() {
+	s.tick++
+	s.advanceLoadBalancers()
+	s.advanceTargets()
+}
+
+// nextNo는 새 리소스 번호를 발급합니다
+func (s *Server) nextNo() string {
+	s.seq++
+	return strconv.FormatInt(s.seq, 10)
+}
+
+// steps는 상태 전이에 필요한 tick 수를 반환합니다
+func (s *Server) steps() int64 {
+	if s.ProvisioningSteps < 1 {
+		return 1
+	}
+	return int64(s.ProvisioningSteps)
+}
+
+// timestamp는 응답에 사용할 생성 시각 문자열을 반환합니다
+func (s *Server) timestamp() string {
+	return s.Now().Format("2006-01-02T15:04:05-0700")
+}
+
+// params는 쿼리 문자열, form 본문, JSON 본문에서 수집한 요청 파라미터입니다
+type params url.Values
+
+// parseParams는 요청의 모든 파라미터를 xxxList.N 형식으로 평탄화하여 수집합니다
+func parseParams(r *http.Request) (params, error) {
+	values := url.Values{}
+	for key, vals := range r.URL.Query() {
+		values[key] = append(values[key], vals...)
+	}
+
+	if r.Body == nil {
+		return params(values), nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("요청 본문 읽기 실패: %w", err)
+	}
+	if len(body) == 0 {
+		return params(values), nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch {
+	case mediaType == "application/json" || (mediaType == "" && json.Valid(body)):
+		decoder := json.NewDecoder(strings.NewReader(string(body)))
+		decoder.UseNumber()
+		var decoded interface{}
+		if err := decoder.Decode(&decoded); err != nil {
+			return nil, fmt.Errorf("JSON 본문 파싱 실패: %w", err)
+		}
+		flatten("", decoded, values)
+	default:
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return nil, fmt.Errorf("form 본문 파싱 실패: %w", err)
+		}
+		for key, vals := range form {
+			values[key] = append(values[key], vals...)
+		}
+	}
+
+	return params(values), nil
+}
+
+// flatten은 JSON 값을 네이버 클라우드 쿼리 파라미터 형식(key.N.field)으로 변환합니다
+func flatten(prefix string, value interface{}, out url.Values) {
+	join := func(key string) string {
+		if prefix == "" {
+			return key
+		}
+		return prefix + "." + key
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			flatten(join(key), child, out)
+		}
+	case []interface{}:
+		for i, child := range v {
+			flatten(join(strconv.Itoa(i+1)), child, out)
+		}
+	case nil:
+	default:
+		out.Add(prefix, fmt.Sprint(v))
+	}
+}
+
+// str은 단일 파라미터 값을 반환합니다
+func (p params) str(key string) string {
+	return url.Values(p).Get(key)
+}
+
+// int32Value는 정수 파라미터 값을 반환합니다
+func (p params) int32Value(key string) (int32, bool, error) {
+	raw := p.str(key)
+	if raw == "" {
+		return 0, false, nil
+	}
+	v, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil {
+		return 0, false, fmt.Errorf("%s 값이 정수가 아님: %s", key, raw)
+	}
+	return int32(v), true, nil
+}
+
+// boolValue는 불리언 파라미터 값을 반환합니다
+func (p params) boolValue(key string) (bool, bool) {
+	raw := p.str(key)
+	if raw == "" {
+		return false, false
+	}
+	return strings.EqualFold(raw, "true"), true
+}
+
+// list는 key.1, key.2, ... 형식의 목록 파라미터를 순서대로 반환합니다
+func (p params) list(key string) []string {
+	var result []string
+	for i := 1; ; i++ {
+		v := p.str(key + "." + strconv.Itoa(i))
+		if v == "" {
+			break
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+// require는 필수 파라미터를 검사합니다
+func (p params) require(keys ...string) *apiError {
+	for _, key := range keys {
+		if p.str(key) == "" {
+			return invalidParameter("%s is required", key)
+		}
+	}
+	return nil
+}
+
+// invalidParameter는 잘못된 파라미터 오류를 생성합니다
+func invalidParameter(format string, args ...interface{}) *apiError {
+	return &apiError{status: http.StatusBadRequest, code: codeInvalidParameter, message: fmt.Sprintf(format, args...)}
+}
+
+// notFound는 리소스 없음 오류를 생성합니다
+func notFound(format string, args ...interface{}) *apiError {
+	return &apiError{status: http.StatusBadRequest, code: codeNotFound, message: fmt.Sprintf(format, args...)}
+}
+
+// sortedKeys는 숫자 리소스 번호를 생성 순서대로 정렬하여 반환합니다
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, _ := strconv.ParseInt(keys[i], 10, 64)
+		b, _ := strconv.ParseInt(keys[j], 10, 64)
+		return a < b
+	})
+	return keys
+}
+
+// contains는 문자열 슬라이스 포함 여부를 확인합니다
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// writeJSON은 {"<action>Response": {...}} 형식으로 응답합니다.
+// SDK는 첫 '{'를 제외한 본문에서 다음 '{'의 위치를 구해 원본 본문의 같은 위치부터 마지막 바이트 직전까지를 파싱하므로,
+// 실제 API처럼 ':' 뒤에 공백을 하나 두어 잘라낸 값이 공백으로 시작하게 하고 끝에 개행을 붙이지 않습니다.
+func writeJSON(w http.ResponseWriter, status int, key string, payload interface{}) {
+	encodedKey, err := json.Marshal(key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	encodedPayload, err := json.Marshal(payload)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	body := fmt.Sprintf("{%s: %s}", encodedKey, encodedPayload)
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(body))
+}
+
+// writeUnsupported는 fake 서버가 구현하지 않은 API 요청을 501로 거부합니다.
+// 구현되지 않은 호출이 빈 응답으로 성공한 것처럼 보이지 않도록 경로를 메시지에 포함합니다
+func writeUnsupported(w http.ResponseWriter, r *http.Request) {
+	writeError(w, &apiError{
+		status:  http.StatusNotImplemented,
+		code:    codeNotImplemented,
+		message: fmt.Sprintf("fake 서버가 구현하지 않은 API: %s %s", r.Method, r.URL.Path),
+	})
+}
+
+// writeError는 responseError 형식의 오류 응답을 작성합니다
+func writeError(w http.ResponseWriter, apiErr *apiError) {
+	writeJSON(w, apiErr.status, "responseError", map[string]string{
+		"returnCode":    apiErr.code,
+		"returnMessage": apiErr.message,
+	})
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+)
+
+// subnet은 VPC 서브넷과 서브넷에 연결된 Network ACL 번호입니다
+type subnet struct {
+	no           string
+	vpcNo        string
+	zoneCode     string
+	cidr         string
+	subnetType   string
+	usageType    string
+	networkACLNo string
+	createDate   string
+}
+
+// networkACLRule은 Network ACL 인바운드 규칙입니다
+type networkACLRule struct {
+	priority    int32
+	protocol    string
+	ipBlock     string
+	portRange   string
+	action      string
+	description string
+	createDate  string
+}
+
+// vpcHandlers는 vpc v2 액션 테이블입니다
+var vpcHandlers = map[string]handlerFunc{
+	"getSubnetList":               (*Server).getSubnetList,
+	"getNetworkAclRuleList":       (*Server).getNetworkAclRuleList,
+	"addNetworkAclInboundRule":    (*Server).addNetworkAclInboundRule,
+	"removeNetworkAclInboundRule": (*Server).removeNetworkAclInboundRule,
+}
+
+// AddSubnet은 서브넷을 추가합니다. usageType은 GEN 또는 LOADB이며,
+// 같은 networkACLNo를 지정한 서브넷은 하나의 Network ACL을 공유합니다.
+func (s *Server) AddSubnet(subnetNo, vpcNo, zoneCode, cidr, usageType, networkACLNo string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.subnets[subnetNo] = &subnet{
+		no:           subnetNo,
+		vpcNo:        vpcNo,
+		zoneCode:     zoneCode,
+		cidr:         cidr,
+		subnetType:   "PRIVATE",
+		usageType:    usageType,
+		networkACLNo: networkACLNo,
+		createDate:   s.timestamp(),
+	}
+}
+
+// getSubnetList는 번호/VPC/존/용도로 필터링한 서브넷 목록을 반환합니다
+func (s *Server) getSubnetList(p params) (interface{}, *apiError) {
+	filter := p.list("subnetNoList")
+	vpcNo := p.str("vpcNo")
+	zoneCode := p.str("zoneCode")
+	usageType := p.str("usageTypeCode")
+	networkACLNo := p.str("networkAclNo")
+
+	var list []*vpc.Subnet
+	for _, no := range sortedKeys(s.subnets) {
+		sn := s.subnets[no]
+		if len(filter) > 0 && !contains(filter, no) {
+			continue
+		}
+		if vpcNo != "" && sn.vpcNo != vpcNo {
+			continue
+		}
+		if zoneCode != "" && sn.zoneCode != zoneCode {
+			continue
+		}
+		if usageType != "" && sn.usageType != usageType {
+			continue
+		}
+		if networkACLNo != "" && sn.networkACLNo != networkACLNo {
+			continue
+		}
+
+		list = append(list, &vpc.Subnet{
+			SubnetNo:     ncloud.String(sn.no),
+			VpcNo:        ncloud.String(sn.vpcNo),
+			ZoneCode:     ncloud.String(sn.zoneCode),
+			SubnetName:   ncloud.String("subnet-" + sn.no),
+			Subnet:       ncloud.String(sn.cidr),
+			SubnetStatus: &vpc.CommonCode{Code: ncloud.String("RUN"), CodeName: ncloud.String("run")},
+			CreateDate:   ncloud.String(sn.createDate),
+			SubnetType:   &vpc.CommonCode{Code: ncloud.String(sn.subnetType), CodeName: ncloud.String(sn.subnetType)},
+			UsageType:    &vpc.CommonCode{Code: ncloud.String(sn.usageType), CodeName: ncloud.String(sn.usageType)},
+			NetworkAclNo: ncloud.String(sn.networkACLNo),
+		})
+	}
+
+	return &vpc.GetSubnetListResponse{
+		ReturnCode:    ncloud.String("0"),
+		ReturnMessage: ncloud.String("success"),
+		TotalRows:     ncloud.Int32(int32(len(list))),
+		SubnetList:    list,
+	}, nil
+}
+
+// networkACLRuleToSDK는 Network ACL 규칙을 SDK 응답 타입으로 변환합니다
+func networkACLRuleToSDK(aclNo string, rule *networkACLRule) *vpc.NetworkAclRule {
+	return &vpc.NetworkAclRule{
+		NetworkAclNo:              ncloud.String(aclNo),
+		Priority:                  ncloud.Int32(rule.priority),
+		ProtocolType:              &vpc.CommonCode{Code: ncloud.String(rule.protocol), CodeName: ncloud.String(rule.protocol)},
+		PortRange:                 ncloud.String(rule.portRange),
+		RuleAction:                &vpc.CommonCode{Code: ncloud.String(rule.action), CodeName: ncloud.String(rule.action)},
+		CreateDate:                ncloud.String(rule.createDate),
+		IpBlock:                   ncloud.String(rule.ipBlock),
+		NetworkAclRuleType:        &vpc.CommonCode{Code: ncloud.String("INBND"), CodeName: ncloud.String("Inbound")},
+		NetworkAclRuleDescription: ncloud.String(rule.description),
+	}
+}
+
+// networkACLExists는 서브넷에 연결된 Network ACL인지 확인합니다
+func (s *Server) networkACLExists(aclNo string) bool {
+	for _, sn := range s.subnets {
+		if sn.networkACLNo == aclNo {
+			return true
+		}
+	}
+	return false
+}
+
+// getNetworkAclRuleList는 Network ACL의 인바운드 규칙 목록을 우선순위 순으로 반환합니다
+func (s *Server) getNetworkAclRuleList(p params) (interface{}, *apiError) {
+	if apiErr := p.require("networkAclNo"); apiErr != nil {
+		return nil, apiErr
+	}
+	aclNo := p.str("networkAclNo")
+	if !s.networkACLExists(aclNo) {
+		return nil, notFound("Network ACL not found: %s", aclNo)
+	}
+	if ruleType := p.str("networkAclRuleTypeCode"); ruleType != "" && ruleType != "INBND" {
+		return &vpc.GetNetworkAclRuleListResponse{
+			ReturnCode:    ncloud.String("0"),
+			ReturnMessage: ncloud.String("success"),
+			TotalRows:     ncloud.Int32(0),
+		}, nil
+	}
+
+	var list []*vpc.NetworkAclRule
+	for _, rule := range s.networkACLRules[aclNo] {
+		list = append(list, networkACLRuleToSDK(aclNo, rule))
+	}
+
+	return &vpc.GetNetworkAclRuleListResponse{
+		ReturnCode:         ncloud.String("0"),
+		ReturnMessage:      ncloud.String("success"),
+		TotalRows:          ncloud.Int32(int32(len(list))),
+		NetworkAclRuleList: list,
+	}, nil
+}
+
+// networkACLRuleParams는 networkAclRuleList.N.xxx 형식의 규칙 파라미터를 읽습니다
+func networkACLRuleParams(p params) ([]*networkACLRule, *apiError) {
+	var rules []*networkACLRule
+	for i := 1; ; i++ {
+		prefix := "networkAclRuleList." + strconv.Itoa(i) + "."
+		if p.str(prefix+"priority") == "" && p.str(prefix+"ipBlock") == "" {
+			break
+		}
+		priority, ok, err := p.int32Value(prefix + "priority")
+		if err != nil {
+			return nil, invalidParameter("%s", err.Error())
+		}
+		if !ok {
+			return nil, invalidParameter("%spriority is required", prefix)
+		}
+		if apiErr := p.require(prefix+"protocolTypeCode", prefix+"ipBlock", prefix+"ruleActionCode"); apiErr != nil {
+			return nil, apiErr
+		}
+		rules = append(rules, &networkACLRule{
+			priority:    priority,
+			protocol:    p.str(prefix + "protocolTypeCode"),
+			ipBlock:     p.str(prefix + "ipBlock"),
+			portRange:   p.str(prefix + "portRange"),
+			action:      p.str(prefix + "ruleActionCode"),
+			description: p.str(prefix + "networkAclRuleDescription"),
+		})
+	}
+	if len(rules) == 0 {
+		return nil, invalidParameter("networkAclRuleList is required")
+	}
+	return rules, nil
+}
+
+// addNetworkAclInboundRule은 인바운드 규칙을 추가합니다. 이미 사용 중인 우선순위면 오류를 반환합니다
+func (s *Server) addNetworkAclInboundRule(p params) (interface{}, *apiError) {
+	if apiErr := p.require("networkAclNo"); apiErr != nil {
+		return nil, apiErr
+	}
+	aclNo := p.str("networkAclNo")
+	if !s.networkACLExists(aclNo) {
+		return nil, notFound("Network ACL not found: %s", aclNo)
+	}
+	rules, apiErr := networkACLRuleParams(p)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	used := make(map[int32]bool)
+	for _, rule := range s.networkACLRules[aclNo] {
+		used[rule.priority] = true
+	}
+	for _, rule := range rules {
+		if used[rule.priority] {
+			return nil, invalidParameter("Network ACL rule priority %d is already in use", rule.priority)
+		}
+		used[rule.priority] = true
+	}
+
+	var list []*vpc.NetworkAclRule
+	for _, rule := range rules {
+		rule.createDate = s.timestamp()
+		s.networkACLRules[aclNo] = append(s.networkACLRules[aclNo], rule)
+		list = append(list, networkACLRuleToSDK(aclNo, rule))
+	}
+	sort.Slice(s.networkACLRules[aclNo], func(i, j int) bool {
+		return s.networkACLRules[aclNo][i].priority < s.networkACLRules[aclNo][j].priority
+	})
+
+	return &vpc.AddNetworkAclInboundRuleResponse{
+		ReturnCode:         ncloud.String("0"),
+		ReturnMessage:      ncloud.String("success"),
+		TotalRows:          ncloud.Int32(int32(len(list))),
+		NetworkAclRuleList: list,
+	}, nil
+}
+
+// removeNetworkAclInboundRule은 우선순위와 IP 블록이 일치하는 인바운드 규칙을 삭제합니다. 없는 규칙은 건너뜁니다
+func (s *Server) removeNetworkAclInboundRule(p params) (interface{}, *apiError) {
+	if apiErr := p.require("networkAclNo"); apiErr != nil {
+		return nil, apiErr
+	}
+	aclNo := p.str("networkAclNo")
+	if !s.networkACLExists(aclNo) {
+		return nil, notFound("Network ACL not found: %s", aclNo)
+	}
+	rules, apiErr := networkACLRuleParams(p)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	var list []*vpc.NetworkAclRule
+	for _, rule := range rules {
+		current := s.networkACLRules[aclNo]
+		index := -1
+		for i, existing := range current {
+			if existing.priority == rule.priority && existing.ipBlock == rule.ipBlock {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			continue
+		}
+		list = append(list, networkACLRuleToSDK(aclNo, current[index]))
+		s.networkACLRules[aclNo] = append(current[:index], current[index+1:]...)
+	}
+
+	return &vpc.RemoveNetworkAclInboundRuleResponse{
+		ReturnCode:         ncloud.String("0"),
+		ReturnMessage:      ncloud.String("success"),
+		TotalRows:          ncloud.Int32(int32(len(list))),
+		NetworkAclRuleList: list,
+	}, nil
+}
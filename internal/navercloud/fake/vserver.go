@@ -0,0 +1,353 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"strconv"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+)
+
+// serverInstance는 타겟으로 등록할 수 있는 서버 인스턴스와 기본 네트워크 인터페이스입니다
+type serverInstance struct {
+	no         string
+	name       string
+	ip         string
+	vpcNo      string
+	subnetNo   string
+	zoneCode   string
+	regionCode string
+	nicNo      string
+}
+
+// serverHandlers는 vserver v2 액션 테이블입니다
+var serverHandlers = map[string]handlerFunc{
+	"getServerInstanceList":               (*Server).getServerInstanceList,
+	"getNetworkInterfaceList":             (*Server).getNetworkInterfaceList,
+	"getAccessControlGroupRuleList":       (*Server).getAccessControlGroupRuleList,
+	"addAccessControlGroupInboundRule":    (*Server).addAccessControlGroupInboundRule,
+	"removeAccessControlGroupInboundRule": (*Server).removeAccessControlGroupInboundRule,
+	"getPublicIpInstanceList":             (*Server).getPublicIpInstanceList,
+}
+
+// AddServerInstance는 타겟 그룹에 등록할 수 있는 서버 인스턴스를 추가합니다.
+// 서버마다 ip를 가진 기본 네트워크 인터페이스가 함께 생성됩니다.
+func (s *Server) AddServerInstance(instanceNo, name, ip, vpcNo string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.servers[instanceNo] = &serverInstance{
+		no:         instanceNo,
+		name:       name,
+		ip:         ip,
+		vpcNo:      vpcNo,
+		zoneCode:   "KR-1",
+		regionCode: "KR",
+		nicNo:      s.nextNo(),
+	}
+}
+
+// getServerInstanceList는 번호/VPC/이름으로 필터링한 서버 인스턴스 목록을 반환합니다
+func (s *Server) getServerInstanceList(p params) (interface{}, *apiError) {
+	filter := p.list("serverInstanceNoList")
+	vpcNo := p.str("vpcNo")
+	name := p.str("serverName")
+
+	var list []*vserver.ServerInstance
+	for _, no := range sortedKeys(s.servers) {
+		srv := s.servers[no]
+		if len(filter) > 0 && !contains(filter, no) {
+			continue
+		}
+		if vpcNo != "" && srv.vpcNo != vpcNo {
+			continue
+		}
+		if name != "" && srv.name != name {
+			continue
+		}
+
+		list = append(list, &vserver.ServerInstance{
+			ServerInstanceNo:         ncloud.String(srv.no),
+			ServerName:               ncloud.String(srv.name),
+			ServerInstanceStatus:     &vserver.CommonCode{Code: ncloud.String("RUN"), CodeName: ncloud.String("Server run state")},
+			ServerInstanceStatusName: ncloud.String("running"),
+			ZoneCode:                 ncloud.String(srv.zoneCode),
+			RegionCode:               ncloud.String(srv.regionCode),
+			VpcNo:                    ncloud.String(srv.vpcNo),
+			SubnetNo:                 ncloud.String(srv.subnetNo),
+			NetworkInterfaceNoList:   []*string{ncloud.String(srv.nicNo)},
+		})
+	}
+
+	return &vserver.GetServerInstanceListResponse{
+		ReturnCode:         ncloud.String("0"),
+		ReturnMessage:      ncloud.String("success"),
+		TotalRows:          ncloud.Int32(int32(len(list))),
+		ServerInstanceList: list,
+	}, nil
+}
+
+// getNetworkInterfaceList는 인스턴스 번호/IP/인터페이스 번호로 필터링한 네트워크 인터페이스 목록을 반환합니다
+func (s *Server) getNetworkInterfaceList(p params) (interface{}, *apiError) {
+	filter := p.list("networkInterfaceNoList")
+	instanceNo := p.str("instanceNo")
+	ip := p.str("ip")
+
+	var list []*vserver.NetworkInterface
+	for _, no := range sortedKeys(s.servers) {
+		srv := s.servers[no]
+		if len(filter) > 0 && !contains(filter, srv.nicNo) {
+			continue
+		}
+		if instanceNo != "" && srv.no != instanceNo {
+			continue
+		}
+		if ip != "" && srv.ip != ip {
+			continue
+		}
+
+		list = append(list, &vserver.NetworkInterface{
+			NetworkInterfaceNo:     ncloud.String(srv.nicNo),
+			NetworkInterfaceName:   ncloud.String("nic-" + srv.nicNo),
+			SubnetNo:               ncloud.String(srv.subnetNo),
+			IsDefault:              ncloud.Bool(true),
+			DeviceName:             ncloud.String("eth0"),
+			NetworkInterfaceStatus: &vserver.CommonCode{Code: ncloud.String("USED"), CodeName: ncloud.String("used")},
+			InstanceType:           &vserver.CommonCode{Code: ncloud.String("SVR"), CodeName: ncloud.String("Server")},
+			InstanceNo:             ncloud.String(srv.no),
+			Ip:                     ncloud.String(srv.ip),
+		})
+	}
+
+	return &vserver.GetNetworkInterfaceListResponse{
+		ReturnCode:           ncloud.String("0"),
+		ReturnMessage:        ncloud.String("success"),
+		TotalRows:            ncloud.Int32(int32(len(list))),
+		NetworkInterfaceList: list,
+	}, nil
+}
+
+// accessControlGroupRule은 ACG 인바운드 규칙입니다
+type accessControlGroupRule struct {
+	protocol    string
+	ipBlock     string
+	portRange   string
+	description string
+}
+
+// publicIP는 공인 IP 인스턴스입니다. serverNo가 비어 있으면 서버에 할당되지 않은 상태입니다
+type publicIP struct {
+	no         string
+	ip         string
+	serverNo   string
+	createDate string
+}
+
+// AddAccessControlGroup은 규칙이 없는 ACG를 추가합니다
+func (s *Server) AddAccessControlGroup(acgNo string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.acgRules[acgNo]; !ok {
+		s.acgRules[acgNo] = nil
+	}
+}
+
+// AddPublicIP는 공인 IP 인스턴스를 추가합니다. serverNo를 지정하면 해당 서버에 할당된 상태로 추가됩니다
+func (s *Server) AddPublicIP(publicIPNo, ip, serverNo string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.publicIPs[publicIPNo] = &publicIP{no: publicIPNo, ip: ip, serverNo: serverNo, createDate: s.timestamp()}
+}
+
+// accessControlGroupRuleToSDK는 ACG 규칙을 SDK 응답 타입으로 변환합니다
+func accessControlGroupRuleToSDK(acgNo string, rule *accessControlGroupRule) *vserver.AccessControlGroupRule {
+	return &vserver.AccessControlGroupRule{
+		AccessControlGroupNo:              ncloud.String(acgNo),
+		ProtocolType:                      &vserver.ProtocolType{Code: ncloud.String(rule.protocol), CodeName: ncloud.String(rule.protocol)},
+		IpBlock:                           ncloud.String(rule.ipBlock),
+		PortRange:                         ncloud.String(rule.portRange),
+		AccessControlGroupRuleType:        &vserver.CommonCode{Code: ncloud.String("INBND"), CodeName: ncloud.String("Inbound")},
+		AccessControlGroupRuleDescription: ncloud.String(rule.description),
+	}
+}
+
+// accessControlGroupRuleParams는 accessControlGroupRuleList.N.xxx 형식의 규칙 파라미터를 읽습니다
+func accessControlGroupRuleParams(p params) ([]*accessControlGroupRule, *apiError) {
+	var rules []*accessControlGroupRule
+	for i := 1; ; i++ {
+		prefix := "accessControlGroupRuleList." + strconv.Itoa(i) + "."
+		if p.str(prefix+"protocolTypeCode") == "" {
+			break
+		}
+		rules = append(rules, &accessControlGroupRule{
+			protocol:    p.str(prefix + "protocolTypeCode"),
+			ipBlock:     p.str(prefix + "ipBlock"),
+			portRange:   p.str(prefix + "portRange"),
+			description: p.str(prefix + "accessControlGroupRuleDescription"),
+		})
+	}
+	if len(rules) == 0 {
+		return nil, invalidParameter("accessControlGroupRuleList is required")
+	}
+	return rules, nil
+}
+
+// sameRule은 프로토콜, IP 블록, 포트 범위가 같은 규칙인지 확인합니다
+func (r *accessControlGroupRule) sameRule(other *accessControlGroupRule) bool {
+	return r.protocol == other.protocol && r.ipBlock == other.ipBlock && r.portRange == other.portRange
+}
+
+// getAccessControlGroupRuleList는 ACG의 인바운드 규칙 목록을 반환합니다
+func (s *Server) getAccessControlGroupRuleList(p params) (interface{}, *apiError) {
+	if apiErr := p.require("accessControlGroupNo"); apiErr != nil {
+		return nil, apiErr
+	}
+	acgNo := p.str("accessControlGroupNo")
+	rules, ok := s.acgRules[acgNo]
+	if !ok {
+		return nil, notFound("Access control group not found: %s", acgNo)
+	}
+
+	var list []*vserver.AccessControlGroupRule
+	if ruleType := p.str("accessControlGroupRuleTypeCode"); ruleType == "" || ruleType == "INBND" {
+		for _, rule := range rules {
+			list = append(list, accessControlGroupRuleToSDK(acgNo, rule))
+		}
+	}
+
+	return &vserver.GetAccessControlGroupRuleListResponse{
+		ReturnCode:                 ncloud.String("0"),
+		ReturnMessage:              ncloud.String("success"),
+		TotalRows:                  ncloud.Int32(int32(len(list))),
+		AccessControlGroupRuleList: list,
+	}, nil
+}
+
+// addAccessControlGroupInboundRule은 인바운드 규칙을 추가합니다. 같은 규칙이 이미 있으면 오류를 반환합니다
+func (s *Server) addAccessControlGroupInboundRule(p params) (interface{}, *apiError) {
+	if apiErr := p.require("accessControlGroupNo", "vpcNo"); apiErr != nil {
+		return nil, apiErr
+	}
+	acgNo := p.str("accessControlGroupNo")
+	if _, ok := s.acgRules[acgNo]; !ok {
+		return nil, notFound("Access control group not found: %s", acgNo)
+	}
+	rules, apiErr := accessControlGroupRuleParams(p)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	for _, rule := range rules {
+		for _, existing := range s.acgRules[acgNo] {
+			if existing.sameRule(rule) {
+				return nil, invalidParameter("Duplicate access control group rule: %s %s", rule.ipBlock, rule.portRange)
+			}
+		}
+	}
+
+	var list []*vserver.AccessControlGroupRule
+	for _, rule := range rules {
+		s.acgRules[acgNo] = append(s.acgRules[acgNo], rule)
+		list = append(list, accessControlGroupRuleToSDK(acgNo, rule))
+	}
+
+	return &vserver.AddAccessControlGroupInboundRuleResponse{
+		ReturnCode:                 ncloud.String("0"),
+		ReturnMessage:              ncloud.String("success"),
+		TotalRows:                  ncloud.Int32(int32(len(list))),
+		AccessControlGroupRuleList: list,
+	}, nil
+}
+
+// removeAccessControlGroupInboundRule은 일치하는 인바운드 규칙을 삭제합니다. 없는 규칙은 건너뜁니다
+func (s *Server) removeAccessControlGroupInboundRule(p params) (interface{}, *apiError) {
+	if apiErr := p.require("accessControlGroupNo", "vpcNo"); apiErr != nil {
+		return nil, apiErr
+	}
+	acgNo := p.str("accessControlGroupNo")
+	if _, ok := s.acgRules[acgNo]; !ok {
+		return nil, notFound("Access control group not found: %s", acgNo)
+	}
+	rules, apiErr := accessControlGroupRuleParams(p)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	var list []*vserver.AccessControlGroupRule
+	for _, rule := range rules {
+		current := s.acgRules[acgNo]
+		for i, existing := range current {
+			if existing.sameRule(rule) {
+				list = append(list, accessControlGroupRuleToSDK(acgNo, existing))
+				s.acgRules[acgNo] = append(current[:i], current[i+1:]...)
+				break
+			}
+		}
+	}
+
+	return &vserver.RemoveAccessControlGroupInboundRuleResponse{
+		ReturnCode:                 ncloud.String("0"),
+		ReturnMessage:              ncloud.String("success"),
+		TotalRows:                  ncloud.Int32(int32(len(list))),
+		AccessControlGroupRuleList: list,
+	}, nil
+}
+
+// getPublicIpInstanceList는 번호/IP로 필터링한 공인 IP 목록을 반환합니다
+func (s *Server) getPublicIpInstanceList(p params) (interface{}, *apiError) {
+	filter := p.list("publicIpInstanceNoList")
+	ip := p.str("publicIp")
+
+	var list []*vserver.PublicIpInstance
+	for _, no := range sortedKeys(s.publicIPs) {
+		pip := s.publicIPs[no]
+		if len(filter) > 0 && !contains(filter, no) {
+			continue
+		}
+		if ip != "" && pip.ip != ip {
+			continue
+		}
+
+		item := &vserver.PublicIpInstance{
+			PublicIpInstanceNo:         ncloud.String(pip.no),
+			PublicIp:                   ncloud.String(pip.ip),
+			CreateDate:                 ncloud.String(pip.createDate),
+			PublicIpInstanceStatusName: ncloud.String("created"),
+			PublicIpInstanceStatus:     &vserver.CommonCode{Code: ncloud.String("CREAT"), CodeName: ncloud.String("created")},
+			PublicIpInstanceOperation:  &vserver.CommonCode{Code: ncloud.String("NULL"), CodeName: ncloud.String("NULL")},
+		}
+		if srv, ok := s.servers[pip.serverNo]; ok {
+			item.ServerInstanceNo = ncloud.String(srv.no)
+			item.ServerName = ncloud.String(srv.name)
+			item.PrivateIp = ncloud.String(srv.ip)
+			item.PublicIpInstanceStatusName = ncloud.String("running")
+			item.PublicIpInstanceStatus = &vserver.CommonCode{Code: ncloud.String("RUN"), CodeName: ncloud.String("running")}
+		} else if pip.serverNo != "" {
+			item.ServerInstanceNo = ncloud.String(pip.serverNo)
+		}
+		list = append(list, item)
+	}
+
+	return &vserver.GetPublicIpInstanceListResponse{
+		ReturnCode:           ncloud.String("0"),
+		ReturnMessage:        ncloud.String("success"),
+		TotalRows:            ncloud.Int32(int32(len(list))),
+		PublicIpInstanceList: list,
+	}, nil
+}
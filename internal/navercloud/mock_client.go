@@ -21,6 +21,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 )
 
@@ -36,26 +37,64 @@ type MockClient struct {
 	ShouldFailGetServers     bool
 
 	// 반환할 데이터들
-	LoadBalancers []vloadbalancer.LoadBalancerInstance
-	TargetGroups  []vloadbalancer.TargetGroup
-	Servers       []vserver.ServerInstance
+	LoadBalancers     []vloadbalancer.LoadBalancerInstance
+	TargetGroups      []vloadbalancer.TargetGroup
+	Listeners         []vloadbalancer.LoadBalancerListener
+	Targets           map[string][]string // 타겟 그룹 번호별 등록된 타겟 번호
+	ListenerTargets   map[string]string   // 리스너 번호별 연결된 타겟 그룹 번호
+	Servers           []vserver.ServerInstance
+	NetworkInterfaces []vserver.NetworkInterface
+	ACGRules          map[string][]vserver.AccessControlGroupRule // ACG 번호별 인바운드 규칙
+	PublicIPs         []vserver.PublicIpInstance
+	Subnets           []vpc.Subnet
+	NetworkAclRules   map[string][]vpc.NetworkAclRule // Network ACL 번호별 인바운드 규칙
+	Certificates      []Certificate
 
 	// 호출 추적
-	CreateLBCalled       int
-	DeleteLBCalled       int
-	CreateTGCalled       int
-	DeleteTGCalled       int
-	CreateListenerCalled int
-	AddTargetCalled      int
-	GetServersCalled     int
+	CreateLBCalled          int
+	DeleteLBCalled          int
+	GetLBDetailCalled       int
+	CreateTGCalled          int
+	DeleteTGCalled          int
+	CreateListenerCalled    int
+	DeleteListenerCalled    int
+	AddTargetCalled         int
+	RemoveTargetCalled      int
+	GetServersCalled        int
+	GetSubnetsCalled        int
+	ChangeListenerCalled    int
+	ChangeHealthCheckCalled int
+	ChangeLBConfigCalled    int
+	ChangeTGConfigCalled    int
+	AddACGRuleCalled        int
+	RemoveACGRuleCalled     int
+	AddACLRuleCalled        int
+	RemoveACLRuleCalled     int
+	ImportCertCalled        int
+	DeleteCertCalled        int
+
+	// 리소스 번호 발급용 시퀀스
+	lbSeq       int
+	tgSeq       int
+	listenerSeq int
+	certSeq     int
 }
 
 // NewMockClient는 새로운 모킹 클라이언트를 생성합니다.
 func NewMockClient() *MockClient {
 	return &MockClient{
-		LoadBalancers: []vloadbalancer.LoadBalancerInstance{},
-		TargetGroups:  []vloadbalancer.TargetGroup{},
-		Servers:       []vserver.ServerInstance{},
+		LoadBalancers:     []vloadbalancer.LoadBalancerInstance{},
+		TargetGroups:      []vloadbalancer.TargetGroup{},
+		Listeners:         []vloadbalancer.LoadBalancerListener{},
+		Targets:           map[string][]string{},
+		ListenerTargets:   map[string]string{},
+		Servers:           []vserver.ServerInstance{},
+		NetworkInterfaces: []vserver.NetworkInterface{},
+		ACGRules:          map[string][]vserver.AccessControlGroupRule{},
+		PublicIPs:         []vserver.PublicIpInstance{},
+		Subnets:           []vpc.Subnet{},
+		NetworkAclRules:   map[string][]vpc.NetworkAclRule{},
+		Certificates:      []Certificate{},
 	}
 }
 
@@ -67,10 +106,24 @@ func (m *MockClient) CreateLoadBalancerInstance(req *vloadbalancer.CreateLoadBal
 	}
 
 	// 새로운 로드밸런서 생성
+	lbNo := fmt.Sprintf("lb-%d", 12345+m.lbSeq)
+	m.lbSeq++
+
 	lb := &vloadbalancer.LoadBalancerInstance{
-		LoadBalancerInstanceNo:         ncloud.String("lb-12345"),
-		LoadBalancerName:               req.LoadBalancerName,
-		LoadBalancerDescription:        req.LoadBalancerDescription,
+		LoadBalancerInstanceNo:  ncloud.String(lbNo),
+		LoadBalancerName:        req.LoadBalancerName,
+		LoadBalancerDescription: req.LoadBalancerDescription,
+		LoadBalancerDomain:      ncloud.String(fmt.Sprintf("%s.mock.ncloud.com", lbNo)),
+		LoadBalancerType: &vloadbalancer.CommonCode{
+			Code:     req.LoadBalancerTypeCode,
+			CodeName: req.LoadBalancerTypeCode,
+		},
+		LoadBalancerNetworkType: &vloadbalancer.CommonCode{
+			Code:     req.LoadBalancerNetworkTypeCode,
+			CodeName: req.LoadBalancerNetworkTypeCode,
+		},
+		SubnetNoList:                   req.SubnetNoList,
+		IdleTimeout:                    req.IdleTimeout,
 		LoadBalancerInstanceStatusName: ncloud.String("CREATING"),
 		LoadBalancerInstanceStatus: &vloadbalancer.CommonCode{
 			Code:     ncloud.String("INIT"),
@@ -78,6 +131,22 @@ func (m *MockClient) CreateLoadBalancerInstance(req *vloadbalancer.CreateLoadBal
 		},
 		CreateDate: ncloud.String("2025-09-26T17:00:00+0900"),
 	}
+	if req.ThroughputTypeCode != nil {
+		lb.ThroughputType = &vloadbalancer.CommonCode{Code: req.ThroughputTypeCode, CodeName: req.ThroughputTypeCode}
+	}
+	// private 로드밸런서는 서브넷의 사설 IP를 할당받음
+	if ncloud.StringValue(req.LoadBalancerNetworkTypeCode) == "PRIVATE" {
+		lb.LoadBalancerIpList = []*string{ncloud.String(fmt.Sprintf("10.0.2.%d", 10+m.lbSeq))}
+	}
+	// 서브넷별로 지정한 공인 IP를 연결
+	for _, subnet := range req.LoadBalancerSubnetList {
+		lb.SubnetNoList = append(lb.SubnetNoList, subnet.SubnetNo)
+		for _, publicIP := range m.PublicIPs {
+			if subnet.PublicIpInstanceNo != nil && ncloud.StringValue(publicIP.PublicIpInstanceNo) == *subnet.PublicIpInstanceNo {
+				lb.LoadBalancerIpList = append(lb.LoadBalancerIpList, publicIP.PublicIp)
+			}
+		}
+	}
 
 	m.LoadBalancers = append(m.LoadBalancers, *lb)
 
@@ -90,7 +159,9 @@ func (m *MockClient) GetLoadBalancerInstanceList(req *vloadbalancer.GetLoadBalan
 	var lbList []*vloadbalancer.LoadBalancerInstance
 
 	for i := range m.LoadBalancers {
-		lbList = append(lbList, &m.LoadBalancers[i])
+		lb := &m.LoadBalancers[i]
+		m.advanceLoadBalancer(lb)
+		lbList = append(lbList, lb)
 	}
 
 	return &vloadbalancer.GetLoadBalancerInstanceListResponse{
@@ -105,11 +176,21 @@ func (m *MockClient) DeleteLoadBalancerInstances(req *vloadbalancer.DeleteLoadBa
 		return nil, fmt.Errorf("mock error: failed to delete load balancer")
 	}
 
-	// 로드밸런서 삭제 (실제로는 상태만 변경)
-	for i := range m.LoadBalancers {
+	// 로드밸런서 삭제 (mock에서는 TERMINATING 상태를 거치지 않고 바로 제거)
+	for i := len(m.LoadBalancers) - 1; i >= 0; i-- {
 		if req.LoadBalancerInstanceNoList != nil && len(req.LoadBalancerInstanceNoList) > 0 {
 			if *m.LoadBalancers[i].LoadBalancerInstanceNo == *req.LoadBalancerInstanceNoList[0] {
-				m.LoadBalancers[i].LoadBalancerInstanceStatusName = ncloud.String("TERMINATING")
+				m.LoadBalancers = append(m.LoadBalancers[:i], m.LoadBalancers[i+1:]...)
+			}
+		}
+	}
+
+	// 로드밸런서와 함께 리스너도 삭제됨
+	if len(req.LoadBalancerInstanceNoList) > 0 && req.LoadBalancerInstanceNoList[0] != nil {
+		lbNo := *req.LoadBalancerInstanceNoList[0]
+		for i := len(m.Listeners) - 1; i >= 0; i-- {
+			if m.Listeners[i].LoadBalancerInstanceNo != nil && *m.Listeners[i].LoadBalancerInstanceNo == lbNo {
+				m.Listeners = append(m.Listeners[:i], m.Listeners[i+1:]...)
 			}
 		}
 	}
@@ -119,6 +200,77 @@ func (m *MockClient) DeleteLoadBalancerInstances(req *vloadbalancer.DeleteLoadBa
 	}, nil
 }
 
+// GetLoadBalancerInstanceDetail은 로드밸런서 상세 정보를 반환합니다.
+// INIT 상태의 로드밸런서는 첫 조회 시 프로비저닝이 완료된 것으로 간주합니다.
+func (m *MockClient) GetLoadBalancerInstanceDetail(req *vloadbalancer.GetLoadBalancerInstanceDetailRequest) (*vloadbalancer.GetLoadBalancerInstanceDetailResponse, error) {
+	m.GetLBDetailCalled++
+
+	var lbList []*vloadbalancer.LoadBalancerInstance
+
+	for i := range m.LoadBalancers {
+		lb := &m.LoadBalancers[i]
+		if req.LoadBalancerInstanceNo == nil || lb.LoadBalancerInstanceNo == nil || *lb.LoadBalancerInstanceNo != *req.LoadBalancerInstanceNo {
+			continue
+		}
+
+		m.advanceLoadBalancer(lb)
+		lbList = append(lbList, lb)
+	}
+
+	return &vloadbalancer.GetLoadBalancerInstanceDetailResponse{
+		LoadBalancerInstanceList: lbList,
+	}, nil
+}
+
+// advanceLoadBalancer는 생성 중인 로드밸런서를 조회 시점에 Running 상태로 전환합니다
+func (m *MockClient) advanceLoadBalancer(lb *vloadbalancer.LoadBalancerInstance) {
+	if lb.LoadBalancerInstanceStatus != nil && lb.LoadBalancerInstanceStatus.Code != nil && *lb.LoadBalancerInstanceStatus.Code == "INIT" {
+		lb.LoadBalancerInstanceStatus = &vloadbalancer.CommonCode{
+			Code:     ncloud.String("USED"),
+			CodeName: ncloud.String("Running"),
+		}
+		lb.LoadBalancerInstanceStatusName = ncloud.String("Running")
+	}
+}
+
+func (m *MockClient) ChangeLoadBalancerInstanceConfiguration(req *vloadbalancer.ChangeLoadBalancerInstanceConfigurationRequest) (*vloadbalancer.ChangeLoadBalancerInstanceConfigurationResponse, error) {
+	m.ChangeLBConfigCalled++
+
+	for i := range m.LoadBalancers {
+		lb := &m.LoadBalancers[i]
+		if ncloud.StringValue(lb.LoadBalancerInstanceNo) != ncloud.StringValue(req.LoadBalancerInstanceNo) {
+			continue
+		}
+		if req.IdleTimeout != nil {
+			lb.IdleTimeout = req.IdleTimeout
+		}
+		if req.ThroughputTypeCode != nil {
+			lb.ThroughputType = &vloadbalancer.CommonCode{Code: req.ThroughputTypeCode, CodeName: req.ThroughputTypeCode}
+		}
+		return &vloadbalancer.ChangeLoadBalancerInstanceConfigurationResponse{
+			LoadBalancerInstanceList: []*vloadbalancer.LoadBalancerInstance{lb},
+		}, nil
+	}
+
+	return nil, fmt.Errorf("mock error: load balancer not found: %s", ncloud.StringValue(req.LoadBalancerInstanceNo))
+}
+
+// SetLoadBalancerDescription은 로드밸런서 설명을 변경합니다.
+func (m *MockClient) SetLoadBalancerDescription(req *vloadbalancer.SetLoadBalancerDescriptionRequest) (*vloadbalancer.SetLoadBalancerDescriptionResponse, error) {
+	for i := range m.LoadBalancers {
+		lb := &m.LoadBalancers[i]
+		if ncloud.StringValue(lb.LoadBalancerInstanceNo) != ncloud.StringValue(req.LoadBalancerInstanceNo) {
+			continue
+		}
+		lb.LoadBalancerDescription = req.LoadBalancerDescription
+		return &vloadbalancer.SetLoadBalancerDescriptionResponse{
+			LoadBalancerInstanceList: []*vloadbalancer.LoadBalancerInstance{lb},
+		}, nil
+	}
+
+	return nil, fmt.Errorf("mock error: load balancer not found: %s", ncloud.StringValue(req.LoadBalancerInstanceNo))
+}
+
 func (m *MockClient) CreateTargetGroup(req *vloadbalancer.CreateTargetGroupRequest) (*vloadbalancer.CreateTargetGroupResponse, error) {
 	m.CreateTGCalled++
 
@@ -126,8 +278,11 @@ func (m *MockClient) CreateTargetGroup(req *vloadbalancer.CreateTargetGroupReque
 		return nil, fmt.Errorf("mock error: failed to create target group")
 	}
 
+	tgNo := fmt.Sprintf("tg-%d", 12345+m.tgSeq)
+	m.tgSeq++
+
 	tg := &vloadbalancer.TargetGroup{
-		TargetGroupNo:          ncloud.String("tg-12345"),
+		TargetGroupNo:          ncloud.String(tgNo),
 		TargetGroupName:        req.TargetGroupName,
 		TargetGroupDescription: req.TargetGroupDescription,
 		VpcNo:                  req.VpcNo,
@@ -136,9 +291,24 @@ func (m *MockClient) CreateTargetGroup(req *vloadbalancer.CreateTargetGroupReque
 			CodeName: req.TargetGroupProtocolTypeCode,
 		},
 		TargetGroupPort: req.TargetGroupPort,
-		CreateDate:      ncloud.String("2025-09-26T17:00:00+0900"),
+		HealthCheckProtocolType: &vloadbalancer.CommonCode{
+			Code:     req.HealthCheckProtocolTypeCode,
+			CodeName: req.HealthCheckProtocolTypeCode,
+		},
+		HealthCheckPort:          req.HealthCheckPort,
+		HealthCheckUrlPath:       req.HealthCheckUrlPath,
+		HealthCheckCycle:         req.HealthCheckCycle,
+		HealthCheckUpThreshold:   req.HealthCheckUpThreshold,
+		HealthCheckDownThreshold: req.HealthCheckDownThreshold,
+		// 생성 API는 알고리즘/세션 유지/Proxy Protocol을 받지 않으므로 기본값으로 생성됨
+		AlgorithmType:    &vloadbalancer.CommonCode{Code: ncloud.String("RR"), CodeName: ncloud.String("Round Robin")},
+		UseStickySession: ncloud.Bool(false),
+		UseProxyProtocol: ncloud.Bool(false),
+		CreateDate:       ncloud.String("2025-09-26T17:00:00+0900"),
+	}
+	if req.HealthCheckHttpMethodTypeCode != nil {
+		tg.HealthCheckHttpMethodType = &vloadbalancer.CommonCode{Code: req.HealthCheckHttpMethodTypeCode, CodeName: req.HealthCheckHttpMethodTypeCode}
 	}
-
 	m.TargetGroups = append(m.TargetGroups, *tg)
 
 	return &vloadbalancer.CreateTargetGroupResponse{
@@ -159,6 +329,7 @@ func (m *MockClient) DeleteTargetGroups(req *vloadbalancer.DeleteTargetGroupsReq
 			for i := len(m.TargetGroups) - 1; i >= 0; i-- {
 				if *m.TargetGroups[i].TargetGroupNo == *tgNo {
 					m.TargetGroups = append(m.TargetGroups[:i], m.TargetGroups[i+1:]...)
+					delete(m.Targets, *tgNo)
 					break
 				}
 			}
@@ -180,6 +351,95 @@ func (m *MockClient) GetTargetGroupList(req *vloadbalancer.GetTargetGroupListReq
 	}, nil
 }
 
+func (m *MockClient) GetTargetGroupDetail(req *vloadbalancer.GetTargetGroupDetailRequest) (*vloadbalancer.GetTargetGroupDetailResponse, error) {
+	var tgList []*vloadbalancer.TargetGroup
+
+	for i := range m.TargetGroups {
+		if req.TargetGroupNo != nil && m.TargetGroups[i].TargetGroupNo != nil && *m.TargetGroups[i].TargetGroupNo == *req.TargetGroupNo {
+			tgList = append(tgList, &m.TargetGroups[i])
+		}
+	}
+
+	return &vloadbalancer.GetTargetGroupDetailResponse{
+		TargetGroupList: tgList,
+	}, nil
+}
+
+func (m *MockClient) ChangeTargetGroupConfiguration(req *vloadbalancer.ChangeTargetGroupConfigurationRequest) (*vloadbalancer.ChangeTargetGroupConfigurationResponse, error) {
+	m.ChangeTGConfigCalled++
+
+	for i := range m.TargetGroups {
+		tg := &m.TargetGroups[i]
+		if ncloud.StringValue(tg.TargetGroupNo) != ncloud.StringValue(req.TargetGroupNo) {
+			continue
+		}
+		if req.AlgorithmTypeCode != nil {
+			tg.AlgorithmType = &vloadbalancer.CommonCode{Code: req.AlgorithmTypeCode, CodeName: req.AlgorithmTypeCode}
+		}
+		if req.UseStickySession != nil {
+			tg.UseStickySession = req.UseStickySession
+		}
+		if req.UseProxyProtocol != nil {
+			tg.UseProxyProtocol = req.UseProxyProtocol
+		}
+		return &vloadbalancer.ChangeTargetGroupConfigurationResponse{
+			TargetGroupList: []*vloadbalancer.TargetGroup{tg},
+		}, nil
+	}
+
+	return nil, fmt.Errorf("mock error: target group not found: %s", ncloud.StringValue(req.TargetGroupNo))
+}
+
+func (m *MockClient) ChangeTargetGroupHealthCheckConfiguration(req *vloadbalancer.ChangeTargetGroupHealthCheckConfigurationRequest) (*vloadbalancer.ChangeTargetGroupHealthCheckConfigurationResponse, error) {
+	m.ChangeHealthCheckCalled++
+
+	for i := range m.TargetGroups {
+		tg := &m.TargetGroups[i]
+		if ncloud.StringValue(tg.TargetGroupNo) != ncloud.StringValue(req.TargetGroupNo) {
+			continue
+		}
+		if req.HealthCheckPort != nil {
+			tg.HealthCheckPort = req.HealthCheckPort
+		}
+		if req.HealthCheckUrlPath != nil {
+			tg.HealthCheckUrlPath = req.HealthCheckUrlPath
+		}
+		if req.HealthCheckHttpMethodTypeCode != nil {
+			tg.HealthCheckHttpMethodType = &vloadbalancer.CommonCode{Code: req.HealthCheckHttpMethodTypeCode, CodeName: req.HealthCheckHttpMethodTypeCode}
+		}
+		if req.HealthCheckCycle != nil {
+			tg.HealthCheckCycle = req.HealthCheckCycle
+		}
+		if req.HealthCheckUpThreshold != nil {
+			tg.HealthCheckUpThreshold = req.HealthCheckUpThreshold
+		}
+		if req.HealthCheckDownThreshold != nil {
+			tg.HealthCheckDownThreshold = req.HealthCheckDownThreshold
+		}
+		return &vloadbalancer.ChangeTargetGroupHealthCheckConfigurationResponse{
+			TargetGroupList: []*vloadbalancer.TargetGroup{tg},
+		}, nil
+	}
+
+	return nil, fmt.Errorf("mock error: target group not found: %s", ncloud.StringValue(req.TargetGroupNo))
+}
+
+// SetTargetGroupDescription은 타겟 그룹 설명을 변경합니다.
+func (m *MockClient) SetTargetGroupDescription(req *vloadbalancer.SetTargetGroupDescriptionRequest) (*vloadbalancer.SetTargetGroupDescriptionResponse, error) {
+	for i := range m.TargetGroups {
+		tg := &m.TargetGroups[i]
+		if ncloud.StringValue(tg.TargetGroupNo) != ncloud.StringValue(req.TargetGroupNo) {
+			continue
+		}
+		tg.TargetGroupDescription = req.TargetGroupDescription
+		return &vloadbalancer.SetTargetGroupDescriptionResponse{
+			TargetGroupList: []*vloadbalancer.TargetGroup{tg},
+		}, nil
+	}
+
+	return nil, fmt.Errorf("mock error: target group not found: %s", ncloud.StringValue(req.TargetGroupNo))
+}
+
 func (m *MockClient) CreateLoadBalancerListener(req *vloadbalancer.CreateLoadBalancerListenerRequest) (*vloadbalancer.CreateLoadBalancerListenerResponse, error) {
 	m.CreateListenerCalled++
 
@@ -187,13 +447,37 @@ func (m *MockClient) CreateLoadBalancerListener(req *vloadbalancer.CreateLoadBal
 		return nil, fmt.Errorf("mock error: failed to create listener")
 	}
 
+	listenerNo := fmt.Sprintf("listener-%d", 12345+m.listenerSeq)
+	m.listenerSeq++
+
 	listener := &vloadbalancer.LoadBalancerListener{
-		LoadBalancerListenerNo: ncloud.String("listener-12345"),
+		LoadBalancerInstanceNo: req.LoadBalancerInstanceNo,
+		LoadBalancerListenerNo: ncloud.String(listenerNo),
 		ProtocolType: &vloadbalancer.CommonCode{
 			Code:     req.ProtocolTypeCode,
 			CodeName: req.ProtocolTypeCode,
 		},
-		Port: req.Port,
+		Port:             req.Port,
+		SslCertificateNo: req.SslCertificateNo,
+		CipherSuiteList:  req.CipherSuiteList,
+	}
+	if req.TlsMinVersionTypeCode != nil {
+		listener.TlsMinVersionType = &vloadbalancer.CommonCode{
+			Code:     req.TlsMinVersionTypeCode,
+			CodeName: req.TlsMinVersionTypeCode,
+		}
+	}
+
+	m.Listeners = append(m.Listeners, *listener)
+	if req.TargetGroupNo != nil {
+		m.ListenerTargets[listenerNo] = *req.TargetGroupNo
+	}
+
+	// 리스너에 연결된 타겟 그룹은 로드밸런서에 연결된 것으로 표시
+	for i := range m.TargetGroups {
+		if req.TargetGroupNo != nil && m.TargetGroups[i].TargetGroupNo != nil && *m.TargetGroups[i].TargetGroupNo == *req.TargetGroupNo {
+			m.TargetGroups[i].LoadBalancerInstanceNo = req.LoadBalancerInstanceNo
+		}
 	}
 
 	return &vloadbalancer.CreateLoadBalancerListenerResponse{
@@ -201,6 +485,73 @@ func (m *MockClient) CreateLoadBalancerListener(req *vloadbalancer.CreateLoadBal
 	}, nil
 }
 
+func (m *MockClient) ChangeLoadBalancerListenerConfiguration(req *vloadbalancer.ChangeLoadBalancerListenerConfigurationRequest) (*vloadbalancer.ChangeLoadBalancerListenerConfigurationResponse, error) {
+	m.ChangeListenerCalled++
+
+	for i := range m.Listeners {
+		listener := &m.Listeners[i]
+		if ncloud.StringValue(listener.LoadBalancerListenerNo) != ncloud.StringValue(req.LoadBalancerListenerNo) {
+			continue
+		}
+		if req.ProtocolTypeCode != nil {
+			listener.ProtocolType = &vloadbalancer.CommonCode{Code: req.ProtocolTypeCode, CodeName: req.ProtocolTypeCode}
+		}
+		if req.Port != nil {
+			listener.Port = req.Port
+		}
+		listener.SslCertificateNo = req.SslCertificateNo
+		listener.CipherSuiteList = req.CipherSuiteList
+		if req.TlsMinVersionTypeCode != nil {
+			listener.TlsMinVersionType = &vloadbalancer.CommonCode{Code: req.TlsMinVersionTypeCode, CodeName: req.TlsMinVersionTypeCode}
+		}
+		return &vloadbalancer.ChangeLoadBalancerListenerConfigurationResponse{
+			LoadBalancerListenerList: []*vloadbalancer.LoadBalancerListener{listener},
+		}, nil
+	}
+
+	return nil, fmt.Errorf("mock error: listener not found: %s", ncloud.StringValue(req.LoadBalancerListenerNo))
+}
+
+func (m *MockClient) GetLoadBalancerListenerList(req *vloadbalancer.GetLoadBalancerListenerListRequest) (*vloadbalancer.GetLoadBalancerListenerListResponse, error) {
+	var listenerList []*vloadbalancer.LoadBalancerListener
+
+	for i := range m.Listeners {
+		listener := &m.Listeners[i]
+		if req.LoadBalancerInstanceNo != nil && (listener.LoadBalancerInstanceNo == nil || *listener.LoadBalancerInstanceNo != *req.LoadBalancerInstanceNo) {
+			continue
+		}
+		listenerList = append(listenerList, listener)
+	}
+
+	return &vloadbalancer.GetLoadBalancerListenerListResponse{
+		LoadBalancerListenerList: listenerList,
+	}, nil
+}
+
+// DeleteLoadBalancerListeners는 리스너를 삭제합니다.
+func (m *MockClient) DeleteLoadBalancerListeners(req *vloadbalancer.DeleteLoadBalancerListenersRequest) (*vloadbalancer.DeleteLoadBalancerListenersResponse, error) {
+	m.DeleteListenerCalled++
+
+	var deleted []*vloadbalancer.LoadBalancerListener
+	for _, listenerNo := range req.LoadBalancerListenerNoList {
+		if listenerNo == nil {
+			continue
+		}
+		for i := len(m.Listeners) - 1; i >= 0; i-- {
+			if m.Listeners[i].LoadBalancerListenerNo != nil && *m.Listeners[i].LoadBalancerListenerNo == *listenerNo {
+				listener := m.Listeners[i]
+				deleted = append(deleted, &listener)
+				m.Listeners = append(m.Listeners[:i], m.Listeners[i+1:]...)
+			}
+		}
+		delete(m.ListenerTargets, *listenerNo)
+	}
+
+	return &vloadbalancer.DeleteLoadBalancerListenersResponse{
+		LoadBalancerListenerList: deleted,
+	}, nil
+}
+
 func (m *MockClient) AddTarget(req *vloadbalancer.AddTargetRequest) (*vloadbalancer.AddTargetResponse, error) {
 	m.AddTargetCalled++
 
@@ -208,9 +559,62 @@ func (m *MockClient) AddTarget(req *vloadbalancer.AddTargetRequest) (*vloadbalan
 		return nil, fmt.Errorf("mock error: failed to add target")
 	}
 
+	if req.TargetGroupNo != nil {
+		tgNo := *req.TargetGroupNo
+		for _, targetNo := range req.TargetNoList {
+			if targetNo == nil || containsTarget(m.Targets[tgNo], *targetNo) {
+				continue
+			}
+			m.Targets[tgNo] = append(m.Targets[tgNo], *targetNo)
+		}
+	}
+
 	return &vloadbalancer.AddTargetResponse{}, nil
 }
 
+// RemoveTarget은 타겟 그룹에서 타겟을 제거합니다.
+func (m *MockClient) RemoveTarget(req *vloadbalancer.RemoveTargetRequest) (*vloadbalancer.RemoveTargetResponse, error) {
+	m.RemoveTargetCalled++
+
+	if req.TargetGroupNo != nil {
+		tgNo := *req.TargetGroupNo
+		for _, targetNo := range req.TargetNoList {
+			if targetNo == nil {
+				continue
+			}
+			var kept []string
+			for _, existing := range m.Targets[tgNo] {
+				if existing != *targetNo {
+					kept = append(kept, existing)
+				}
+			}
+			m.Targets[tgNo] = kept
+		}
+	}
+
+	return &vloadbalancer.RemoveTargetResponse{}, nil
+}
+
+func (m *MockClient) GetTargetList(req *vloadbalancer.GetTargetListRequest) (*vloadbalancer.GetTargetListResponse, error) {
+	var targetList []*vloadbalancer.Target
+
+	if req.TargetGroupNo != nil {
+		for _, targetNo := range m.Targets[*req.TargetGroupNo] {
+			targetList = append(targetList, &vloadbalancer.Target{
+				TargetNo: ncloud.String(targetNo),
+				HealthCheckStatus: &vloadbalancer.CommonCode{
+					Code:     ncloud.String("UP"),
+					CodeName: ncloud.String("UP"),
+				},
+			})
+		}
+	}
+
+	return &vloadbalancer.GetTargetListResponse{
+		TargetList: targetList,
+	}, nil
+}
+
 func (m *MockClient) GetServerInstanceList(req *vserver.GetServerInstanceListRequest) (*vserver.GetServerInstanceListResponse, error) {
 	m.GetServersCalled++
 
@@ -229,6 +633,215 @@ func (m *MockClient) GetServerInstanceList(req *vserver.GetServerInstanceListReq
 	}, nil
 }
 
+func (m *MockClient) GetNetworkInterfaceList(req *vserver.GetNetworkInterfaceListRequest) (*vserver.GetNetworkInterfaceListResponse, error) {
+	var niList []*vserver.NetworkInterface
+
+	for i := range m.NetworkInterfaces {
+		ni := &m.NetworkInterfaces[i]
+		if req.InstanceNo != nil && (ni.InstanceNo == nil || *ni.InstanceNo != *req.InstanceNo) {
+			continue
+		}
+		niList = append(niList, ni)
+	}
+
+	return &vserver.GetNetworkInterfaceListResponse{
+		NetworkInterfaceList: niList,
+	}, nil
+}
+
+func (m *MockClient) GetAccessControlGroupRuleList(req *vserver.GetAccessControlGroupRuleListRequest) (*vserver.GetAccessControlGroupRuleListResponse, error) {
+	var ruleList []*vserver.AccessControlGroupRule
+	rules := m.ACGRules[ncloud.StringValue(req.AccessControlGroupNo)]
+	for i := range rules {
+		ruleList = append(ruleList, &rules[i])
+	}
+
+	return &vserver.GetAccessControlGroupRuleListResponse{
+		AccessControlGroupRuleList: ruleList,
+	}, nil
+}
+
+func (m *MockClient) AddAccessControlGroupInboundRule(req *vserver.AddAccessControlGroupInboundRuleRequest) (*vserver.AddAccessControlGroupInboundRuleResponse, error) {
+	m.AddACGRuleCalled++
+
+	acgNo := ncloud.StringValue(req.AccessControlGroupNo)
+	for _, param := range req.AccessControlGroupRuleList {
+		for _, rule := range m.ACGRules[acgNo] {
+			if sameACGRule(rule, param.ProtocolTypeCode, param.IpBlock, param.PortRange) {
+				return nil, fmt.Errorf("mock error: duplicate access control group rule: %s %s", ncloud.StringValue(param.IpBlock), ncloud.StringValue(param.PortRange))
+			}
+		}
+		m.ACGRules[acgNo] = append(m.ACGRules[acgNo], vserver.AccessControlGroupRule{
+			AccessControlGroupNo:              req.AccessControlGroupNo,
+			ProtocolType:                      &vserver.ProtocolType{Code: param.ProtocolTypeCode, CodeName: param.ProtocolTypeCode},
+			IpBlock:                           param.IpBlock,
+			PortRange:                         param.PortRange,
+			AccessControlGroupRuleType:        &vserver.CommonCode{Code: ncloud.String("INBND"), CodeName: ncloud.String("Inbound")},
+			AccessControlGroupRuleDescription: param.AccessControlGroupRuleDescription,
+		})
+	}
+
+	return &vserver.AddAccessControlGroupInboundRuleResponse{}, nil
+}
+
+func (m *MockClient) RemoveAccessControlGroupInboundRule(req *vserver.RemoveAccessControlGroupInboundRuleRequest) (*vserver.RemoveAccessControlGroupInboundRuleResponse, error) {
+	m.RemoveACGRuleCalled++
+
+	acgNo := ncloud.StringValue(req.AccessControlGroupNo)
+	for _, param := range req.AccessControlGroupRuleList {
+		rules := m.ACGRules[acgNo]
+		for i := range rules {
+			if sameACGRule(rules[i], param.ProtocolTypeCode, param.IpBlock, param.PortRange) {
+				m.ACGRules[acgNo] = append(rules[:i], rules[i+1:]...)
+				break
+			}
+		}
+	}
+
+	return &vserver.RemoveAccessControlGroupInboundRuleResponse{}, nil
+}
+
+func (m *MockClient) GetPublicIpInstanceList(req *vserver.GetPublicIpInstanceListRequest) (*vserver.GetPublicIpInstanceListResponse, error) {
+	var publicIPList []*vserver.PublicIpInstance
+	for i := range m.PublicIPs {
+		publicIP := &m.PublicIPs[i]
+		if req.PublicIp != nil && ncloud.StringValue(publicIP.PublicIp) != *req.PublicIp {
+			continue
+		}
+		if len(req.PublicIpInstanceNoList) > 0 && !containsTarget(ncloud.StringListValue(req.PublicIpInstanceNoList), ncloud.StringValue(publicIP.PublicIpInstanceNo)) {
+			continue
+		}
+		publicIPList = append(publicIPList, publicIP)
+	}
+
+	return &vserver.GetPublicIpInstanceListResponse{
+		PublicIpInstanceList: publicIPList,
+	}, nil
+}
+
+// sameACGRule은 ACG 규칙이 프로토콜, 소스, 포트가 같은지 확인합니다
+func sameACGRule(rule vserver.AccessControlGroupRule, protocol, ipBlock, portRange *string) bool {
+	return rule.ProtocolType != nil && ncloud.StringValue(rule.ProtocolType.Code) == ncloud.StringValue(protocol) &&
+		ncloud.StringValue(rule.IpBlock) == ncloud.StringValue(ipBlock) &&
+		ncloud.StringValue(rule.PortRange) == ncloud.StringValue(portRange)
+}
+
+func (m *MockClient) GetSubnetList(req *vpc.GetSubnetListRequest) (*vpc.GetSubnetListResponse, error) {
+	m.GetSubnetsCalled++
+
+	var subnetList []*vpc.Subnet
+	for i := range m.Subnets {
+		subnet := &m.Subnets[i]
+		if req.VpcNo != nil && ncloud.StringValue(subnet.VpcNo) != *req.VpcNo {
+			continue
+		}
+		if req.ZoneCode != nil && ncloud.StringValue(subnet.ZoneCode) != *req.ZoneCode {
+			continue
+		}
+		if req.UsageTypeCode != nil && ncloud.StringValue(subnet.UsageType.Code) != *req.UsageTypeCode {
+			continue
+		}
+		if len(req.SubnetNoList) > 0 && !containsTarget(ncloud.StringListValue(req.SubnetNoList), ncloud.StringValue(subnet.SubnetNo)) {
+			continue
+		}
+		subnetList = append(subnetList, subnet)
+	}
+
+	return &vpc.GetSubnetListResponse{
+		SubnetList: subnetList,
+	}, nil
+}
+
+func (m *MockClient) GetNetworkAclRuleList(req *vpc.GetNetworkAclRuleListRequest) (*vpc.GetNetworkAclRuleListResponse, error) {
+	var ruleList []*vpc.NetworkAclRule
+	rules := m.NetworkAclRules[ncloud.StringValue(req.NetworkAclNo)]
+	for i := range rules {
+		ruleList = append(ruleList, &rules[i])
+	}
+
+	return &vpc.GetNetworkAclRuleListResponse{
+		NetworkAclRuleList: ruleList,
+	}, nil
+}
+
+func (m *MockClient) AddNetworkAclInboundRule(req *vpc.AddNetworkAclInboundRuleRequest) (*vpc.AddNetworkAclInboundRuleResponse, error) {
+	m.AddACLRuleCalled++
+
+	aclNo := ncloud.StringValue(req.NetworkAclNo)
+	for _, param := range req.NetworkAclRuleList {
+		for _, rule := range m.NetworkAclRules[aclNo] {
+			if ncloud.Int32Value(rule.Priority) == ncloud.Int32Value(param.Priority) {
+				return nil, fmt.Errorf("mock error: duplicate network acl rule priority: %d", ncloud.Int32Value(param.Priority))
+			}
+		}
+		m.NetworkAclRules[aclNo] = append(m.NetworkAclRules[aclNo], vpc.NetworkAclRule{
+			NetworkAclNo:              req.NetworkAclNo,
+			Priority:                  param.Priority,
+			ProtocolType:              &vpc.CommonCode{Code: param.ProtocolTypeCode, CodeName: param.ProtocolTypeCode},
+			IpBlock:                   param.IpBlock,
+			PortRange:                 param.PortRange,
+			RuleAction:                &vpc.CommonCode{Code: param.RuleActionCode, CodeName: param.RuleActionCode},
+			NetworkAclRuleType:        &vpc.CommonCode{Code: ncloud.String("INBND"), CodeName: ncloud.String("Inbound")},
+			NetworkAclRuleDescription: param.NetworkAclRuleDescription,
+		})
+	}
+
+	return &vpc.AddNetworkAclInboundRuleResponse{}, nil
+}
+
+func (m *MockClient) RemoveNetworkAclInboundRule(req *vpc.RemoveNetworkAclInboundRuleRequest) (*vpc.RemoveNetworkAclInboundRuleResponse, error) {
+	m.RemoveACLRuleCalled++
+
+	aclNo := ncloud.StringValue(req.NetworkAclNo)
+	for _, param := range req.NetworkAclRuleList {
+		rules := m.NetworkAclRules[aclNo]
+		for i := range rules {
+			if ncloud.Int32Value(rules[i].Priority) == ncloud.Int32Value(param.Priority) &&
+				ncloud.StringValue(rules[i].IpBlock) == ncloud.StringValue(param.IpBlock) {
+				m.NetworkAclRules[aclNo] = append(rules[:i], rules[i+1:]...)
+				break
+			}
+		}
+	}
+
+	return &vpc.RemoveNetworkAclInboundRuleResponse{}, nil
+}
+
+func (m *MockClient) GetCertificateList() ([]Certificate, error) {
+	return append([]Certificate(nil), m.Certificates...), nil
+}
+
+func (m *MockClient) ImportCertificate(req *ImportCertificateRequest) (*Certificate, error) {
+	m.ImportCertCalled++
+
+	for _, cert := range m.Certificates {
+		if cert.CertificateName == req.CertificateName {
+			return nil, fmt.Errorf("mock error: duplicate certificate name: %s", req.CertificateName)
+		}
+	}
+
+	cert := Certificate{
+		CertificateNo:   fmt.Sprintf("%d", 5000+m.certSeq),
+		CertificateName: req.CertificateName,
+		StatusCode:      "ISSUED",
+	}
+	m.certSeq++
+	m.Certificates = append(m.Certificates, cert)
+	return &cert, nil
+}
+
+func (m *MockClient) DeleteCertificate(certificateNo string) error {
+	m.DeleteCertCalled++
+
+	for i, cert := range m.Certificates {
+		if cert.CertificateNo == certificateNo {
+			m.Certificates = append(m.Certificates[:i], m.Certificates[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("mock error: certificate not found: %s", certificateNo)
+}
+
 // 테스트 헬퍼 메서드들
 func (m *MockClient) AddMockLoadBalancer(lbID, lbName, status string) {
 	lb := vloadbalancer.LoadBalancerInstance{
@@ -254,6 +867,21 @@ func (m *MockClient) AddMockTargetGroup(tgID, tgName string, port int32) {
 	m.TargetGroups = append(m.TargetGroups, tg)
 }
 
+// AddMockListener는 타겟 그룹에 연결된 리스너를 추가합니다.
+func (m *MockClient) AddMockListener(lbID, listenerNo string, port int32, tgID string) {
+	listener := vloadbalancer.LoadBalancerListener{
+		LoadBalancerInstanceNo: ncloud.String(lbID),
+		LoadBalancerListenerNo: ncloud.String(listenerNo),
+		ProtocolType: &vloadbalancer.CommonCode{
+			Code:     ncloud.String("TCP"),
+			CodeName: ncloud.String("TCP"),
+		},
+		Port: ncloud.Int32(port),
+	}
+	m.Listeners = append(m.Listeners, listener)
+	m.ListenerTargets[listenerNo] = tgID
+}
+
 func (m *MockClient) AddMockServer(serverID, serverName, privateIP string) {
 	server := vserver.ServerInstance{
 		ServerInstanceNo: ncloud.String(serverID),
@@ -263,6 +891,28 @@ func (m *MockClient) AddMockServer(serverID, serverName, privateIP string) {
 	m.Servers = append(m.Servers, server)
 }
 
+func (m *MockClient) AddMockNetworkInterface(niID, instanceNo, ip string) {
+	ni := vserver.NetworkInterface{
+		NetworkInterfaceNo: ncloud.String(niID),
+		InstanceNo:         ncloud.String(instanceNo),
+		Ip:                 ncloud.String(ip),
+	}
+	m.NetworkInterfaces = append(m.NetworkInterfaces, ni)
+}
+
+// AddMockSubnet은 VPC 서브넷을 추가합니다. usageType은 GEN(일반), LOADB(로드밸런서 전용) 등입니다.
+func (m *MockClient) AddMockSubnet(subnetNo, vpcNo, zoneCode, subnetType, usageType string) {
+	subnet := vpc.Subnet{
+		SubnetNo:     ncloud.String(subnetNo),
+		VpcNo:        ncloud.String(vpcNo),
+		ZoneCode:     ncloud.String(zoneCode),
+		SubnetType:   &vpc.CommonCode{Code: ncloud.String(subnetType), CodeName: ncloud.String(subnetType)},
+		UsageType:    &vpc.CommonCode{Code: ncloud.String(usageType), CodeName: ncloud.String(usageType)},
+		SubnetStatus: &vpc.CommonCode{Code: ncloud.String("RUN"), CodeName: ncloud.String("RUN")},
+	}
+	m.Subnets = append(m.Subnets, subnet)
+}
+
 func (m *MockClient) Reset() {
 	m.ShouldFailCreateLB = false
 	m.ShouldFailDeleteLB = false
@@ -274,13 +924,50 @@ func (m *MockClient) Reset() {
 
 	m.LoadBalancers = []vloadbalancer.LoadBalancerInstance{}
 	m.TargetGroups = []vloadbalancer.TargetGroup{}
+	m.Listeners = []vloadbalancer.LoadBalancerListener{}
+	m.Targets = map[string][]string{}
+	m.ListenerTargets = map[string]string{}
 	m.Servers = []vserver.ServerInstance{}
+	m.NetworkInterfaces = []vserver.NetworkInterface{}
+	m.ACGRules = map[string][]vserver.AccessControlGroupRule{}
+	m.PublicIPs = []vserver.PublicIpInstance{}
+	m.Subnets = []vpc.Subnet{}
+	m.NetworkAclRules = map[string][]vpc.NetworkAclRule{}
+	m.Certificates = []Certificate{}
 
 	m.CreateLBCalled = 0
 	m.DeleteLBCalled = 0
+	m.GetLBDetailCalled = 0
 	m.CreateTGCalled = 0
 	m.DeleteTGCalled = 0
 	m.CreateListenerCalled = 0
+	m.DeleteListenerCalled = 0
 	m.AddTargetCalled = 0
+	m.RemoveTargetCalled = 0
 	m.GetServersCalled = 0
+	m.GetSubnetsCalled = 0
+	m.ChangeListenerCalled = 0
+	m.ChangeHealthCheckCalled = 0
+	m.ChangeLBConfigCalled = 0
+	m.ChangeTGConfigCalled = 0
+	m.AddACGRuleCalled = 0
+	m.RemoveACGRuleCalled = 0
+	m.AddACLRuleCalled = 0
+	m.RemoveACLRuleCalled = 0
+	m.ImportCertCalled = 0
+	m.DeleteCertCalled = 0
+
+	m.lbSeq = 0
+	m.tgSeq = 0
+	m.listenerSeq = 0
+	m.certSeq = 0
+}
+
+func containsTarget(targets []string, targetNo string) bool {
+	for _, t := range targets {
+		if t == targetNo {
+			return true
+		}
+	}
+	return false
 }
@@ -44,6 +44,10 @@ var (
 	// projectImage is the name of the image which will be build and loaded
 	// with the code source changes to be tested.
 	projectImage = "example.com/kebe-controller01:v0.0.1"
+
+	// fakeNCPImage is the name of the fake Naver Cloud API server image which runs next to
+	// the manager so that load balancers can be reconciled without a real Naver Cloud account.
+	fakeNCPImage = "example.com/fakencp:v0.0.1"
 )
 
 // TestE2E runs the end-to-end (e2e) test suite for the project. These tests execute in an isolated,
@@ -71,6 +75,15 @@ var _ = BeforeSuite(func() {
 	err = utils.LoadImageToKindClusterWithName(projectImage)
 	ExpectWithOffset(1, err).NotTo(HaveOccurred(), "Failed to load the manager(Operator) image into Kind")
 
+	By("building the fake Naver Cloud API image")
+	cmd = exec.Command("make", "docker-build-fake-ncp", fmt.Sprintf("FAKE_NCP_IMG=%s", fakeNCPImage))
+	_, err = utils.Run(cmd)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred(), "Failed to build the fake Naver Cloud API image")
+
+	By("loading the fake Naver Cloud API image on Kind")
+	err = utils.LoadImageToKindClusterWithName(fakeNCPImage)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred(), "Failed to load the fake Naver Cloud API image into Kind")
+
 	// The tests-e2e are intended to run on a temporary cluster that is created and destroyed for testing.
 	// To prevent errors when tests run in environments with Prometheus or CertManager already installed,
 	// we check for their presence before execution.
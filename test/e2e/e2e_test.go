@@ -22,6 +22,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -31,17 +32,33 @@ import (
 )
 
 // namespace where the project is deployed in
-const namespace = "kebe-controller01-system"
+const namespace = "suslmk"
+
+// deploymentName is the name of the controller-manager Deployment
+const deploymentName = "controller-manager"
 
 // serviceAccountName created for the project
-const serviceAccountName = "kebe-controller01-controller-manager"
+const serviceAccountName = "controller-manager"
 
 // metricsServiceName is the name of the metrics service of the project
-const metricsServiceName = "kebe-controller01-controller-manager-metrics-service"
+const metricsServiceName = "controller-manager-metrics-service"
 
 // metricsRoleBindingName is the name of the RBAC that will be created to allow get the metrics data
 const metricsRoleBindingName = "kebe-controller01-metrics-binding"
 
+// loadBalancerServiceName is the name of the LoadBalancer Service reconciled against the fake Naver Cloud API
+const loadBalancerServiceName = "e2e-fake-lb"
+
+// Settings of the fake Naver Cloud API sidecar. The manager reaches it over the Pod's loopback interface.
+const (
+	fakeNCPContainerName = "fake-ncp"
+	fakeNCPAddress       = "127.0.0.1:9480"
+	fakeNCPAccessKey     = "e2e-access-key"
+	fakeNCPSecretKey     = "e2e-secret-key"
+	fakeNCPVpcNo         = "5000"
+	fakeNCPSubnetNo      = "6000"
+)
+
 var _ = Describe("Manager", Ordered, func() {
 	var controllerPodName string
 
@@ -69,6 +86,9 @@ var _ = Describe("Manager", Ordered, func() {
 		cmd = exec.Command("make", "deploy", fmt.Sprintf("IMG=%s", projectImage))
 		_, err = utils.Run(cmd)
 		Expect(err).NotTo(HaveOccurred(), "Failed to deploy the controller-manager")
+
+		By("pointing the controller-manager at the fake Naver Cloud API")
+		Expect(useFakeNaverCloudAPI()).To(Succeed(), "Failed to configure the fake Naver Cloud API")
 	})
 
 	// After all tests have been executed, clean up by undeploying the controller, uninstalling CRDs,
@@ -78,6 +98,11 @@ var _ = Describe("Manager", Ordered, func() {
 		cmd := exec.Command("kubectl", "delete", "pod", "curl-metrics", "-n", namespace)
 		_, _ = utils.Run(cmd)
 
+		By("cleaning up the LoadBalancer Service while the controller can still release it")
+		cmd = exec.Command("kubectl", "delete", "service", loadBalancerServiceName, "-n", "default",
+			"--ignore-not-found", "--timeout=5m")
+		_, _ = utils.Run(cmd)
+
 		By("undeploying the controller-manager")
 		cmd = exec.Command("make", "undeploy")
 		_, _ = utils.Run(cmd)
@@ -105,6 +130,15 @@ var _ = Describe("Manager", Ordered, func() {
 				_, _ = fmt.Fprintf(GinkgoWriter, "Failed to get Controller logs: %s", err)
 			}
 
+			By("Fetching fake Naver Cloud API logs")
+			cmd = exec.Command("kubectl", "logs", controllerPodName, "-c", fakeNCPContainerName, "-n", namespace)
+			fakeNCPLogs, err := utils.Run(cmd)
+			if err == nil {
+				_, _ = fmt.Fprintf(GinkgoWriter, "Fake Naver Cloud API logs:\n %s", fakeNCPLogs)
+			} else {
+				_, _ = fmt.Fprintf(GinkgoWriter, "Failed to get fake Naver Cloud API logs: %s", err)
+			}
+
 			By("Fetching Kubernetes events")
 			cmd = exec.Command("kubectl", "get", "events", "-n", namespace, "--sort-by=.lastTimestamp")
 			eventsOutput, err := utils.Run(cmd)
@@ -173,7 +207,7 @@ var _ = Describe("Manager", Ordered, func() {
 		It("should ensure the metrics endpoint is serving metrics", func() {
 			By("creating a ClusterRoleBinding for the service account to allow access to metrics")
 			cmd := exec.Command("kubectl", "create", "clusterrolebinding", metricsRoleBindingName,
-				"--clusterrole=kebe-controller01-metrics-reader",
+				"--clusterrole=metrics-reader",
 				fmt.Sprintf("--serviceaccount=%s:%s", namespace, serviceAccountName),
 			)
 			_, err := utils.Run(cmd)
@@ -261,6 +295,64 @@ var _ = Describe("Manager", Ordered, func() {
 			))
 		})
 
+		It("should provision and release a load balancer through the fake Naver Cloud API", func() {
+			By("creating a LoadBalancer Service")
+			cmd := exec.Command("kubectl", "apply", "-f", "-")
+			cmd.Stdin = strings.NewReader(fmt.Sprintf(`apiVersion: v1
+kind: Service
+metadata:
+  name: %[1]s
+  namespace: default
+spec:
+  type: LoadBalancer
+  selector:
+    app: %[1]s
+  ports:
+  - name: http
+    port: 80
+    targetPort: 8080
+`, loadBalancerServiceName))
+			_, err := utils.Run(cmd)
+			Expect(err).NotTo(HaveOccurred(), "Failed to create the LoadBalancer Service")
+
+			By("waiting for the NaverLoadBalancer to become Ready")
+			verifyLoadBalancerReady := func(g Gomega) {
+				cmd := exec.Command("kubectl", "get", "naverloadbalancer", loadBalancerServiceName,
+					"-n", "default", "-o", "jsonpath={.status.phase}")
+				output, err := utils.Run(cmd)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(output).To(Equal("Ready"), "NaverLoadBalancer is not ready")
+			}
+			Eventually(verifyLoadBalancerReady, 5*time.Minute).Should(Succeed())
+
+			By("validating that the Service reports the load balancer address")
+			verifyServiceIngress := func(g Gomega) {
+				cmd := exec.Command("kubectl", "get", "service", loadBalancerServiceName,
+					"-n", "default", "-o", "jsonpath={.status.loadBalancer.ingress[0]}")
+				output, err := utils.Run(cmd)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(output).NotTo(BeEmpty(), "Service has no load balancer ingress")
+			}
+			Eventually(verifyServiceIngress).Should(Succeed())
+
+			By("deleting the LoadBalancer Service")
+			cmd = exec.Command("kubectl", "delete", "service", loadBalancerServiceName, "-n", "default", "--wait=false")
+			_, err = utils.Run(cmd)
+			Expect(err).NotTo(HaveOccurred(), "Failed to delete the LoadBalancer Service")
+
+			By("waiting for the controller to release the Service and the NaverLoadBalancer")
+			verifyReleased := func(g Gomega) {
+				for _, kind := range []string{"service", "naverloadbalancer"} {
+					cmd := exec.Command("kubectl", "get", kind, loadBalancerServiceName,
+						"-n", "default", "--ignore-not-found", "-o", "name")
+					output, err := utils.Run(cmd)
+					g.Expect(err).NotTo(HaveOccurred())
+					g.Expect(output).To(BeEmpty(), "%s is still present", kind)
+				}
+			}
+			Eventually(verifyReleased, 5*time.Minute).Should(Succeed())
+		})
+
 		// +kubebuilder:scaffold:e2e-webhooks-checks
 
 		// TODO: Customize the e2e test suite with scenarios specific to your project.
@@ -274,6 +366,71 @@ var _ = Describe("Manager", Ordered, func() {
 	})
 })
 
+// useFakeNaverCloudAPI adds the fake Naver Cloud API server as a sidecar of the controller-manager,
+// seeds it with a load balancer subnet and one server instance per cluster node, and points the
+// manager at it through the NAVER_CLOUD_* environment variables.
+func useFakeNaverCloudAPI() error {
+	cmd := exec.Command("kubectl", "get", "nodes", "-o",
+		`jsonpath={range .items[*]}{.status.addresses[?(@.type=="InternalIP")].address}{"\n"}{end}`)
+	output, err := utils.Run(cmd)
+	if err != nil {
+		return err
+	}
+
+	args := []string{
+		"--bind-address=" + fakeNCPAddress,
+		"--access-key=" + fakeNCPAccessKey,
+		"--secret-key=" + fakeNCPSecretKey,
+		"--vpc-no=" + fakeNCPVpcNo,
+		fmt.Sprintf("--subnet=%s=10.0.100.0/24=7000", fakeNCPSubnetNo),
+	}
+	for i, ip := range utils.GetNonEmptyLines(output) {
+		args = append(args, fmt.Sprintf("--server=%d=%s", 1001+i, ip))
+	}
+
+	patch := map[string]any{
+		"spec": map[string]any{
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []map[string]any{
+						{
+							"name": "manager",
+							"env": []map[string]string{
+								{"name": "NAVER_CLOUD_API_ENDPOINT", "value": "http://" + fakeNCPAddress},
+								{"name": "NAVER_CLOUD_API_KEY", "value": fakeNCPAccessKey},
+								{"name": "NAVER_CLOUD_API_SECRET", "value": fakeNCPSecretKey},
+								{"name": "NAVER_CLOUD_VPC_NO", "value": fakeNCPVpcNo},
+								{"name": "NAVER_CLOUD_SUBNET_NO", "value": fakeNCPSubnetNo},
+							},
+						},
+						{
+							"name":  fakeNCPContainerName,
+							"image": fakeNCPImage,
+							"args":  args,
+							"securityContext": map[string]any{
+								"allowPrivilegeEscalation": false,
+								"capabilities":             map[string]any{"drop": []string{"ALL"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	patchJSON, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+
+	cmd = exec.Command("kubectl", "patch", "deployment", deploymentName, "-n", namespace, "-p", string(patchJSON))
+	if _, err := utils.Run(cmd); err != nil {
+		return err
+	}
+	cmd = exec.Command("kubectl", "rollout", "status", "deployment/"+deploymentName, "-n", namespace, "--timeout=5m")
+	_, err = utils.Run(cmd)
+	return err
+}
+
 // serviceAccountToken returns a token for the specified service account in the given namespace.
 // It uses the Kubernetes TokenRequest API to generate a token by directly sending a request
 // and parsing the resulting token from the API response.